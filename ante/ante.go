@@ -12,6 +12,7 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
+	govkeeper "github.com/atomone-hub/atomone/x/gov/keeper"
 	photonante "github.com/atomone-hub/atomone/x/photon/ante"
 	photonkeeper "github.com/atomone-hub/atomone/x/photon/keeper"
 )
@@ -24,6 +25,7 @@ type HandlerOptions struct {
 	IBCkeeper     *ibckeeper.Keeper
 	StakingKeeper *stakingkeeper.Keeper
 	PhotonKeeper  *photonkeeper.Keeper
+	GovKeeper     *govkeeper.Keeper
 	TxFeeChecker  ante.TxFeeChecker
 }
 
@@ -46,6 +48,9 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.PhotonKeeper == nil {
 		return nil, errorsmod.Wrap(atomoneerrors.ErrNotFound, "photon keeper is required for AnteHandler")
 	}
+	if opts.GovKeeper == nil {
+		return nil, errorsmod.Wrap(atomoneerrors.ErrNotFound, "gov keeper is required for AnteHandler")
+	}
 
 	sigGasConsumer := opts.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -58,7 +63,7 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
-		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
+		NewGovVoteDecorator(opts.Codec, opts.GovKeeper),
 		photonante.NewValidateFeeDecorator(opts.PhotonKeeper),
 		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
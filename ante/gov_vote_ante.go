@@ -2,38 +2,29 @@ package ante
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
-	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
+	govkeeper "github.com/atomone-hub/atomone/x/gov/keeper"
 	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
-var (
-	minStakedTokens       = sdk.NewDec(1000000) // 1_000_000 uatone (or 1 atone)
-	maxDelegationsChecked = 100                 // number of delegation to check for the minStakedTokens
-)
-
-// SetMinStakedTokens sets the minimum amount of staked tokens required to vote
-// Should only be used in testing
-func SetMinStakedTokens(tokens sdk.Dec) {
-	minStakedTokens = tokens
-}
-
+// GovVoteDecorator rejects the vote and deposit messages of accounts that
+// don't have the minimum staked tokens defined in the x/gov params.
 type GovVoteDecorator struct {
-	stakingKeeper *stakingkeeper.Keeper
-	cdc           codec.BinaryCodec
+	govKeeper *govkeeper.Keeper
+	cdc       codec.BinaryCodec
 }
 
-func NewGovVoteDecorator(cdc codec.BinaryCodec, stakingKeeper *stakingkeeper.Keeper) GovVoteDecorator {
+func NewGovVoteDecorator(cdc codec.BinaryCodec, govKeeper *govkeeper.Keeper) GovVoteDecorator {
 	return GovVoteDecorator{
-		stakingKeeper: stakingKeeper,
-		cdc:           cdc,
+		govKeeper: govKeeper,
+		cdc:       cdc,
 	}
 }
 
@@ -54,60 +45,28 @@ func (g GovVoteDecorator) AnteHandle(
 	return next(ctx, tx, simulate)
 }
 
-// ValidateVoteMsgs checks if a voter has enough stake to vote
+// ValidateVoteMsgs checks if a voter or a depositor has enough stake to vote
+// or deposit. The same check is enforced by the x/gov message server, this
+// decorator only rejects such messages before their execution.
 func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
 	validMsg := func(m sdk.Msg) error {
-		var accAddr sdk.AccAddress
-		var err error
-
 		switch msg := m.(type) {
 		case *govv1beta1.MsgVote:
-			accAddr, err = sdk.AccAddressFromBech32(msg.Voter)
-			if err != nil {
-				return err
-			}
+			return g.validateVoter(ctx, msg.Voter)
 		case *govv1.MsgVote:
-			accAddr, err = sdk.AccAddressFromBech32(msg.Voter)
-			if err != nil {
-				return err
-			}
+			return g.validateVoter(ctx, msg.Voter)
+		case *govv1beta1.MsgVoteWeighted:
+			return g.validateVoter(ctx, msg.Voter)
+		case *govv1.MsgVoteWeighted:
+			return g.validateVoter(ctx, msg.Voter)
+		case *govv1beta1.MsgDeposit:
+			return g.validateDepositor(ctx, msg.Depositor)
+		case *govv1.MsgDeposit:
+			return g.validateDepositor(ctx, msg.Depositor)
 		default:
-			// not a vote message - nothing to validate
-			return nil
-		}
-
-		if minStakedTokens.IsZero() {
+			// not a vote or deposit message - nothing to validate
 			return nil
 		}
-
-		enoughStake := false
-		delegationCount := 0
-		stakedTokens := sdk.NewDec(0)
-		g.stakingKeeper.IterateDelegatorDelegations(ctx, accAddr, func(delegation stakingtypes.Delegation) bool {
-			validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-			if err != nil {
-				panic(err) // shouldn't happen
-			}
-			validator, found := g.stakingKeeper.GetValidator(ctx, validatorAddr)
-			if found {
-				shares := delegation.Shares
-				tokens := validator.TokensFromSharesTruncated(shares)
-				stakedTokens = stakedTokens.Add(tokens)
-				if stakedTokens.GTE(minStakedTokens) {
-					enoughStake = true
-					return true // break the iteration
-				}
-			}
-			delegationCount++
-			// break the iteration if maxDelegationsChecked were already checked
-			return delegationCount >= maxDelegationsChecked
-		})
-
-		if !enoughStake {
-			return errorsmod.Wrapf(atomoneerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", minStakedTokens)
-		}
-
-		return nil
 	}
 
 	validAuthz := func(execMsg *authz.MsgExec) error {
@@ -139,3 +98,33 @@ func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) erro
 	}
 	return nil
 }
+
+func (g GovVoteDecorator) validateVoter(ctx sdk.Context, voter string) error {
+	params := g.govKeeper.GetParams(ctx)
+	return g.validateMinStakedTokens(ctx, voter, params.MinVoteStakedTokens, params.MaxDelegationsChecked, "voting")
+}
+
+func (g GovVoteDecorator) validateDepositor(ctx sdk.Context, depositor string) error {
+	params := g.govKeeper.GetParams(ctx)
+	return g.validateMinStakedTokens(ctx, depositor, params.MinDepositStakedTokens, params.MaxDelegationsChecked, "depositing")
+}
+
+// validateMinStakedTokens mirrors the x/gov message server check, but keeps
+// returning atomoneerrors.ErrInsufficientStake for transactions rejected by
+// the ante handler.
+func (g GovVoteDecorator) validateMinStakedTokens(
+	ctx sdk.Context, addr string, minStakedTokensStr string, maxDelegationsChecked uint64, action string,
+) error {
+	minStakedTokens, ok := math.NewIntFromString(minStakedTokensStr)
+	if !ok || minStakedTokens.IsZero() {
+		return nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+	if !g.govKeeper.HasMinStakedTokens(ctx, accAddr, minStakedTokens, maxDelegationsChecked) {
+		return errorsmod.Wrapf(atomoneerrors.ErrInsufficientStake, "insufficient stake for %s - min required %v", action, minStakedTokens)
+	}
+	return nil
+}
@@ -11,10 +11,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/atomone-hub/atomone/ante"
 	"github.com/atomone-hub/atomone/app/helpers"
+	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
 	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
@@ -24,7 +26,7 @@ import (
 func TestVoteSpamDecoratorGovV1Beta1(t *testing.T) {
 	atomoneApp := helpers.Setup(t)
 	ctx := atomoneApp.NewUncachedContext(true, tmproto.Header{})
-	decorator := ante.NewGovVoteDecorator(atomoneApp.AppCodec(), atomoneApp.StakingKeeper)
+	decorator := ante.NewGovVoteDecorator(atomoneApp.AppCodec(), atomoneApp.GovKeeper)
 	stakingKeeper := atomoneApp.StakingKeeper
 
 	// Get validator
@@ -138,7 +140,7 @@ func TestVoteSpamDecoratorGovV1Beta1(t *testing.T) {
 func TestVoteSpamDecoratorGovV1(t *testing.T) {
 	atomoneApp := helpers.Setup(t)
 	ctx := atomoneApp.NewUncachedContext(true, tmproto.Header{})
-	decorator := ante.NewGovVoteDecorator(atomoneApp.AppCodec(), atomoneApp.StakingKeeper)
+	decorator := ante.NewGovVoteDecorator(atomoneApp.AppCodec(), atomoneApp.GovKeeper)
 	stakingKeeper := atomoneApp.StakingKeeper
 
 	// Get validator
@@ -247,3 +249,51 @@ func TestVoteSpamDecoratorGovV1(t *testing.T) {
 		}
 	}
 }
+
+// Test that the GovVoteDecorator applies the minimum staked tokens x/gov params
+// to weighted votes and deposits, including authz-wrapped messages
+func TestVoteSpamDecoratorParams(t *testing.T) {
+	atomoneApp := helpers.Setup(t)
+	ctx := atomoneApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewGovVoteDecorator(atomoneApp.AppCodec(), atomoneApp.GovKeeper)
+	govKeeper := atomoneApp.GovKeeper
+
+	// account without any stake
+	addr := sdk.AccAddress(ed25519.GenPrivKeyFromSecret([]byte("no stake")).PubKey().Address())
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	voteMsgs := []sdk.Msg{
+		govv1.NewMsgVoteWeighted(addr, 0, govv1.NewNonSplitVoteOption(govv1.OptionYes), ""),
+		govv1beta1.NewMsgVoteWeighted(addr, 0, govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)),
+	}
+	depositMsgs := []sdk.Msg{
+		govv1.NewMsgDeposit(addr, 0, deposit),
+		govv1beta1.NewMsgDeposit(addr, 0, deposit),
+	}
+	execMsg := authz.NewMsgExec(addr, voteMsgs)
+
+	// with the default params, votes are checked but deposits are not
+	for _, msg := range voteMsgs {
+		err := decorator.ValidateVoteMsgs(ctx, []sdk.Msg{msg})
+		require.ErrorIs(t, err, atomoneerrors.ErrInsufficientStake)
+	}
+	err := decorator.ValidateVoteMsgs(ctx, []sdk.Msg{&execMsg})
+	require.ErrorIs(t, err, atomoneerrors.ErrInsufficientStake)
+	for _, msg := range depositMsgs {
+		require.NoError(t, decorator.ValidateVoteMsgs(ctx, []sdk.Msg{msg}))
+	}
+
+	// disable the vote check and enable the deposit check
+	params := govKeeper.GetParams(ctx)
+	params.MinVoteStakedTokens = math.ZeroInt().String()
+	params.MinDepositStakedTokens = math.OneInt().String()
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	for _, msg := range voteMsgs {
+		require.NoError(t, decorator.ValidateVoteMsgs(ctx, []sdk.Msg{msg}))
+	}
+	require.NoError(t, decorator.ValidateVoteMsgs(ctx, []sdk.Msg{&execMsg}))
+	for _, msg := range depositMsgs {
+		err := decorator.ValidateVoteMsgs(ctx, []sdk.Msg{msg})
+		require.ErrorIs(t, err, atomoneerrors.ErrInsufficientStake)
+	}
+}
@@ -52,6 +52,7 @@ import (
 	"github.com/atomone-hub/atomone/app/params"
 	"github.com/atomone-hub/atomone/app/upgrades"
 	v2 "github.com/atomone-hub/atomone/app/upgrades/v2"
+	v3 "github.com/atomone-hub/atomone/app/upgrades/v3"
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
 )
 
@@ -59,7 +60,7 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v2.Upgrade}
+	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade}
 )
 
 var (
@@ -223,6 +224,7 @@ func NewAtomOneApp(
 			IBCkeeper:     app.IBCKeeper,
 			StakingKeeper: app.StakingKeeper,
 			PhotonKeeper:  app.PhotonKeeper,
+			GovKeeper:     app.GovKeeper,
 			// If TxFeeChecker is nil the default ante TxFeeChecker is used
 			TxFeeChecker: nil,
 		},
@@ -243,6 +243,8 @@ func orderEndBlockers() []string {
 NOTE: The genutils module must occur after staking so that pools are
 properly initialized with tokens from genesis accounts.
 NOTE: The genutils module must also occur after auth so that it can access the params from auth.
+NOTE: The gov module must occur after staking so that the validator shares
+delegated to governors can be computed from the staking delegations.
 NOTE: Capability module must occur first so that it can initialize any capabilities
 so that other modules that want to create or claim capabilities afterwards in InitChain
 can do so safely.
@@ -253,8 +255,8 @@ func orderInitBlockers() []string {
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
-		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		govtypes.ModuleName,
 		photontypes.ModuleName,
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
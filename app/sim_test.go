@@ -12,8 +12,6 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
 
-	"cosmossdk.io/math"
-
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -23,7 +21,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 
-	"github.com/atomone-hub/atomone/ante"
 	atomone "github.com/atomone-hub/atomone/app"
 	"github.com/atomone-hub/atomone/app/sim"
 )
@@ -98,10 +95,6 @@ func TestAppStateDeterminism(t *testing.T) {
 				baseapp.SetChainID(AppChainID),
 			)
 
-			// NOTE: setting to zero to avoid failing the simulation
-			// due to the minimum staked tokens required to submit a vote
-			ante.SetMinStakedTokens(math.LegacyZeroDec())
-
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
 				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
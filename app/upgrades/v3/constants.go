@@ -0,0 +1,17 @@
+package v3
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/atomone-hub/atomone/app/upgrades"
+)
+
+const (
+	UpgradeName = "v3"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
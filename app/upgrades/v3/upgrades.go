@@ -0,0 +1,38 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/atomone-hub/atomone/app/keepers"
+)
+
+// CreateUpgradeHandler returns a upgrade handler for AtomOne v3
+// which executes the following migrations:
+//   - x/gov: replace the static min deposit and min initial deposit ratio by
+//     the dynamic min deposit and min initial deposit, and initialize the
+//     numbers of active and inactive proposals.
+//   - x/gov: set the params added since v2 (governors, dynamic quorum,
+//     expedited proposals, vote history, minimum staked tokens, execution
+//     delays and limits) to their default values.
+//   - x/gov: initialize the participation EMAs of the dynamic quorum.
+//   - x/gov: record the current constitution as the first version of the
+//     constitution history.
+//   - x/gov: compute the voting power accumulators of the proposals in voting
+//     period.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting module migrations...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+		ctx.Logger().Info("Upgrade complete")
+		return vm, nil
+	}
+}
@@ -0,0 +1,89 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atomonehelpers "github.com/atomone-hub/atomone/app/helpers"
+	govtypes "github.com/atomone-hub/atomone/x/gov/types"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+// TestICAHostVoteMinStakedTokens checks that the minimum stake required to
+// vote applies to votes executed by the ICA host module, which don't go
+// through the ante handler. The e2e chains don't run an ICA controller, so
+// the packet is delivered to the ICA host module of an in-process app rather
+// than relayed by hermes.
+func TestICAHostVoteMinStakedTokens(t *testing.T) {
+	app := atomonehelpers.Setup(t)
+	ctx := app.NewUncachedContext(false, tmproto.Header{Time: time.Now()})
+
+	// setup a proposal in voting period
+	govAcc := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+	contentMsg, err := govv1.NewLegacyContent(govv1beta1.NewTextProposal("Test", "description"), govAcc)
+	require.NoError(t, err)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{contentMsg}, "", "title", "description", sdk.AccAddress("proposer"), false)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// setup an interchain account without any stake, owned by a controller
+	// chain through an open channel
+	var (
+		connectionID   = "connection-0"
+		channelID      = "channel-0"
+		controllerPort = icatypes.ControllerPortPrefix + "owner"
+		icaAddr        = sdk.AccAddress("interchain_account")
+	)
+	metadata := icatypes.NewMetadata(icatypes.Version, connectionID, connectionID, icaAddr.String(), icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, icatypes.HostPortID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(controllerPort, channelID),
+		[]string{connectionID}, string(icatypes.ModuleCdc.MustMarshalJSON(&metadata)),
+	))
+	app.ICAHostKeeper.SetInterchainAccountAddress(ctx, connectionID, controllerPort, icaAddr.String())
+	app.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(true, []string{
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+	}))
+
+	sequence := uint64(0)
+	recvPacket := func(msg proto.Message) error {
+		data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []proto.Message{msg})
+		require.NoError(t, err)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+		sequence++
+		packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, controllerPort, channelID,
+			icatypes.HostPortID, channelID, clienttypes.NewHeight(1, 100), 0)
+		_, err = app.ICAHostKeeper.OnRecvPacket(ctx, packet)
+		return err
+	}
+
+	// votes below the minimum stake are rejected
+	err = recvPacket(govv1.NewMsgVote(icaAddr, proposal.Id, govv1.OptionYes, ""))
+	require.ErrorIs(t, err, govtypes.ErrInsufficientStake)
+	err = recvPacket(govv1.NewMsgVoteWeighted(icaAddr, proposal.Id, govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+	require.ErrorIs(t, err, govtypes.ErrInsufficientStake)
+	_, found := app.GovKeeper.GetVote(ctx, proposal.Id, icaAddr)
+	require.False(t, found)
+
+	// votes are accepted once the minimum stake is disabled
+	params := app.GovKeeper.GetParams(ctx)
+	params.MinVoteStakedTokens = sdk.ZeroInt().String()
+	require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+	err = recvPacket(govv1.NewMsgVote(icaAddr, proposal.Id, govv1.OptionYes, ""))
+	require.NoError(t, err)
+	_, found = app.GovKeeper.GetVote(ctx, proposal.Id, icaAddr)
+	require.True(t, found)
+}
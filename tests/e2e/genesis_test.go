@@ -185,16 +185,31 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, de
 
 	maxDepositPeriod := 10 * time.Minute
 	votingPeriod := 15 * time.Second
+	expeditedVotingPeriod := 10 * time.Second
 
 	govGenState := govv1.NewGenesisState(1,
 		govv1.NewParams(
-			sdk.NewCoins(depositAmount), maxDepositPeriod,
+			maxDepositPeriod,
 			votingPeriod,
 			quorum.String(), threshold.String(),
 			amendmentsQuorum.String(), amendmentsThreshold.String(), lawQuorum.String(), lawThreshold.String(),
-			sdk.ZeroDec().String(),
 			false, false, govv1.DefaultMinDepositRatio.String(),
 			govv1.DefaultQuorumTimeout, govv1.DefaultMaxVotingPeriodExtension, govv1.DefaultQuorumCheckCount,
+			sdk.NewCoins(depositAmount), govv1.DefaultMinDepositUpdatePeriod, govv1.DefaultMinDepositSensitivityTargetDistance,
+			govv1.DefaultMinDepositIncreaseRatio.String(), govv1.DefaultMinDepositDecreaseRatio.String(), govv1.DefaultTargetActiveProposals,
+			sdk.NewCoins(initialDepositAmount), govv1.DefaultMinInitialDepositUpdatePeriod, govv1.DefaultMinInitialDepositSensitivityTargetDistance,
+			govv1.DefaultMinInitialDepositIncreaseRatio.String(), govv1.DefaultMinInitialDepositDecreaseRatio.String(), govv1.DefaultTargetProposalsInDepositPeriod,
+			govv1.DefaultMinGovernorSelfDelegation.String(), govv1.DefaultGovernorStatusChangePeriod,
+			govv1.DefaultDynamicQuorum, govv1.DefaultQuorumRangeMin.String(), govv1.DefaultQuorumRangeMax.String(),
+			govv1.DefaultConstitutionAmendmentQuorumRangeMin.String(), govv1.DefaultConstitutionAmendmentQuorumRangeMax.String(),
+			govv1.DefaultLawQuorumRangeMin.String(), govv1.DefaultLawQuorumRangeMax.String(),
+			govv1.DefaultPersistFinalVotes, govv1.DefaultFinalVotesRetentionPeriod,
+			govv1.DefaultProposalCancelRatio.String(),
+			expeditedVotingPeriod, govv1.DefaultExpeditedThreshold.String(), sdk.NewCoins(depositAmount).MulInt(sdk.NewInt(2)),
+			govv1.DefaultExpeditedAllowedMsgTypeURLs, nil,
+			govv1.DefaultRecordVoteHistory, govv1.DefaultMaxVoteChanges,
+			govv1.DefaultMinVoteStakedTokens.String(), govv1.DefaultMaxDelegationsChecked, govv1.DefaultMinDepositStakedTokens.String(),
+			govv1.DefaultExecutionDelay, nil, govv1.DefaultMaxProposalExecutionGas, govv1.DefaultMaxEndBlockerProposals,
 		),
 	)
 	govGenState.Constitution = "This is a test constitution"
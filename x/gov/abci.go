@@ -1,10 +1,13 @@
 package gov
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cometbft/cometbft/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,15 +16,40 @@ import (
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
+// BeginBlocker called every block, pays the funding streams due.
+func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	keeper.PayFundingStreams(ctx)
+}
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	logger := keeper.Logger(ctx)
 
+	// each queue processes at most MaxEndBlockerProposals proposals per block,
+	// the remaining due proposals are processed in the following blocks.
+	maxProposals := keeper.GetParams(ctx).MaxEndBlockerProposals
+	inactiveQueue := newQueueLimit("inactive", maxProposals)
+	quorumCheckQueue := newQueueLimit("quorum_check", maxProposals)
+	activeQueue := newQueueLimit("active", maxProposals)
+	executionQueue := newQueueLimit("execution", maxProposals)
+	defer func() {
+		for _, queue := range []*queueLimit{inactiveQueue, quorumCheckQueue, activeQueue, executionQueue} {
+			queue.report(logger)
+		}
+		telemetry.SetGauge(float32(keeper.GetInactiveProposalsNumber(ctx)), types.ModuleName, "inactive_proposals")
+		telemetry.SetGauge(float32(keeper.GetActiveProposalsNumber(ctx)), types.ModuleName, "active_proposals")
+	}()
+
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
+		if !inactiveQueue.take() {
+			return true
+		}
 		keeper.DeleteProposal(ctx, proposal.Id)
 
 		params := keeper.GetParams(ctx)
@@ -45,7 +73,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
-			"min_deposit", sdk.NewCoins(params.MinDeposit...).String(),
+			"min_deposit", keeper.GetMinDeposit(ctx).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -55,6 +83,15 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	// fetch proposals that are due to be checked for quorum
 	keeper.IterateQuorumCheckQueue(ctx, ctx.BlockTime(),
 		func(proposal v1.Proposal, endTime time.Time, quorumCheckEntry v1.QuorumCheckQueueEntry) bool {
+			// a quorum check deferred to a later block may happen after the
+			// proposal has been tallied, in which case it is dropped
+			if proposal.Status != v1.StatusVotingPeriod {
+				keeper.RemoveFromQuorumCheckQueue(ctx, proposal.Id, endTime)
+				return false
+			}
+			if !quorumCheckQueue.take() {
+				return true
+			}
 			params := keeper.GetParams(ctx)
 			// remove from queue
 			keeper.RemoveFromQuorumCheckQueue(ctx, proposal.Id, endTime)
@@ -127,9 +164,37 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
+		if !activeQueue.take() {
+			return true
+		}
 		var tagValue, logMsg string
 
+		// an expedited proposal which does not pass is converted to a regular
+		// proposal, keeping its votes and deposits, instead of being tallied.
+		if proposal.Expedited {
+			projection, err := keeper.TallyProjection(ctx, proposal)
+			if err == nil && !projection.Passes {
+				proposal = keeper.ConvertExpeditedProposal(ctx, proposal)
+
+				logger.Info(
+					"expedited proposal converted to regular",
+					"proposal", proposal.Id,
+					"voting_end_time", proposal.VotingEndTime,
+				)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeActiveProposal,
+						sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+						sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+					),
+				)
+				return false
+			}
+		}
+
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		keeper.UpdateParticipationEMA(ctx, proposal, tallyResults)
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
@@ -137,48 +202,26 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
 		}
 
+		var attributes []sdk.Attribute
 		if passes {
-			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err == nil {
-				for idx, msg = range messages {
-					handler := keeper.Router().Handler(msg)
-					var res *sdk.Result
-					res, err = safeExecuteHandler(cacheCtx, msg, handler)
-					if err != nil {
-						break
-					}
-
-					events = append(events, res.GetEvents()...)
-				}
+			// the messages of the proposal are executed once its execution
+			// delay has elapsed
+			typeURLs := make([]string, len(proposal.Messages))
+			for i, msg := range proposal.Messages {
+				typeURLs[i] = msg.TypeUrl
 			}
-
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
-
-				// propagate the msg events to the current context
-				ctx.EventManager().EmitEvents(events)
+			executionTime := ctx.BlockTime().Add(keeper.GetParams(ctx).ExecutionDelayOf(typeURLs))
+			proposal.ExecutionTime = &executionTime
+			if executionTime.After(ctx.BlockTime()) {
+				proposal.Status = v1.StatusPassedPendingExecution
+				keeper.InsertExecutionQueue(ctx, proposal.Id, executionTime)
+				tagValue = types.AttributeValueProposalPendingExecution
+				logMsg = fmt.Sprintf("passed, execution scheduled at %s", executionTime)
+				attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyExecutionTime, executionTime.String()))
 			} else {
-				proposal.Status = v1.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+				var gasUsed uint64
+				tagValue, logMsg, gasUsed = executePassedProposal(ctx, keeper, &proposal)
+				attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)))
 			}
 		} else {
 			proposal.Status = v1.StatusRejected
@@ -190,6 +233,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		keeper.DecrementActiveProposalsNumber(ctx)
 
 		// when proposal become active
 		keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
@@ -203,22 +247,173 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeActiveProposal,
+				append([]sdk.Attribute{
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				}, attributes...)...,
+			),
+		)
+		return false
+	})
+
+	// execute the passed proposals whose execution delay has elapsed
+	keeper.IterateExecutionQueue(ctx, ctx.BlockTime(), func(proposal v1.Proposal) bool {
+		if !executionQueue.take() {
+			return true
+		}
+		keeper.RemoveFromExecutionQueue(ctx, proposal.Id, *proposal.ExecutionTime)
+
+		tagValue, logMsg, gasUsed := executePassedProposal(ctx, keeper, &proposal)
+		keeper.SetProposal(ctx, proposal)
+
+		logger.Info(
+			"proposal executed",
+			"proposal", proposal.Id,
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
 			),
 		)
 		return false
 	})
+
+	// delete the final votes whose retention period has expired
+	keeper.PruneFinalVotes(ctx)
 }
 
-// executes handle(msg) and recovers from panic.
-func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
-) (res *sdk.Result, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", msg, r)
+// queueLimit limits the number of proposals an EndBlocker queue processes
+// per block.
+type queueLimit struct {
+	name      string
+	limit     uint64
+	processed uint64
+	// deferred is true if due proposals were left in the queue because the
+	// limit was reached
+	deferred bool
+}
+
+func newQueueLimit(name string, limit uint64) *queueLimit {
+	return &queueLimit{name: name, limit: limit}
+}
+
+// take returns whether the queue can process one more proposal in the current
+// block, and counts it if so.
+func (q *queueLimit) take() bool {
+	if q.processed >= q.limit {
+		q.deferred = true
+		return false
+	}
+	q.processed++
+	return true
+}
+
+// report records the number of proposals processed by the queue in the
+// current block, and whether some were deferred to the next blocks.
+func (q *queueLimit) report(logger log.Logger) {
+	telemetry.SetGauge(float32(q.processed), types.ModuleName, "end_blocker", q.name, "processed")
+	if q.deferred {
+		telemetry.IncrCounter(1, types.ModuleName, "end_blocker", q.name, "deferred")
+		logger.Info(
+			"maximum number of proposals processed per block reached, remaining proposals deferred to the next block",
+			"queue", q.name,
+			"max_end_blocker_proposals", q.limit,
+		)
+	}
+}
+
+// executePassedProposal executes the messages of a passed proposal, sets its
+// status according to the result of the execution, and returns the result
+// event attribute value, the log message and the gas used by the execution.
+func executePassedProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal *v1.Proposal) (tagValue, logMsg string, gasUsed uint64) {
+	events, gasUsed, err := executeProposal(ctx, keeper, proposal)
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but %s", err), gasUsed
+	}
+
+	proposal.Status = v1.StatusPassed
+	// propagate the msg events to the current context
+	ctx.EventManager().EmitEvents(events)
+	return types.AttributeValueProposalPassed, "passed", gasUsed
+}
+
+// executeProposal executes the messages of a passed proposal according to its
+// execution policy, records the result of each message in the proposal, and
+// returns the events of the executed messages and the gas they used. Messages
+// may mutate state thus we use a cached context, which is written to the
+// underlying multi-store only if the execution does not fail. The returned
+// error is the reason of the failure otherwise. The execution of all the
+// messages is limited to the MaxProposalExecutionGas param, a message running
+// out of gas fails.
+func executeProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal *v1.Proposal) (sdk.Events, uint64, error) {
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	gasMeter := sdk.NewGasMeter(keeper.GetParams(ctx).MaxProposalExecutionGas)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = types.WithExecutedProposalID(cacheCtx.WithGasMeter(gasMeter), proposal.Id)
+
+	bestEffort := proposal.ExecutionPolicy == v1.ExecutionPolicyBestEffort
+	var (
+		events   sdk.Events
+		failures []string
+	)
+	firstResult := len(proposal.MsgResults)
+	for idx, msg := range messages {
+		// with the best effort policy, each message is executed in its own
+		// cached context, so that only the state changes of a failing message
+		// are reverted
+		msgCtx, writeMsg := cacheCtx, func() {}
+		if bestEffort {
+			msgCtx, writeMsg = cacheCtx.CacheContext()
 		}
-	}()
-	res, err = handler(ctx, msg)
-	return
+
+		gasBefore := gasMeter.GasConsumedToLimit()
+		handler := keeper.Router().Handler(msg)
+		res, err := keeper.SafeExecuteHandler(msgCtx, msg, handler)
+		result := v1.ProposalMsgResult{
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			Success:    err == nil,
+			GasUsed:    gasMeter.GasConsumedToLimit() - gasBefore,
+		}
+		if err != nil {
+			result.Error = err.Error()
+			proposal.MsgResults = append(proposal.MsgResults, result)
+			failures = append(failures, fmt.Sprintf("msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err))
+			if !bestEffort {
+				break
+			}
+			continue
+		}
+		proposal.MsgResults = append(proposal.MsgResults, result)
+		writeMsg()
+		events = append(events, res.GetEvents()...)
+	}
+
+	// all-or-nothing proposals fail on the first failing message, best effort
+	// proposals only if all their messages fail
+	if len(failures) > 0 && (!bestEffort || len(failures) == len(messages)) {
+		// the state changes of the messages which succeeded are discarded with
+		// the cached context, so they are not recorded as successful
+		for i := firstResult; i < len(proposal.MsgResults); i++ {
+			if result := &proposal.MsgResults[i]; result.Success {
+				result.Success = false
+				result.Error = fmt.Sprintf("reverted: %s", failures[0])
+			}
+		}
+		return nil, gasMeter.GasConsumedToLimit(), errors.New(strings.Join(failures, "; "))
+	}
+
+	// write state to the underlying multi-store
+	writeCache()
+	return events, gasMeter.GasConsumedToLimit(), nil
 }
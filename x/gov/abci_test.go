@@ -47,7 +47,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		addrs[0].String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	require.NoError(t, err)
 
@@ -103,7 +103,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		addrs[0].String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	require.NoError(t, err)
 
@@ -129,7 +129,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		addrs[0].String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	require.NoError(t, err)
 
@@ -190,7 +190,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		addrs[0].String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	require.NoError(t, err)
 
@@ -244,7 +244,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", "Proposal", "description of proposal")
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", "Proposal", "description of proposal", false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -313,7 +313,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -344,6 +344,371 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestLawProposalPassedEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	lawMsg := v1.NewMsgProposeLaw(authority, "title", "text", nil)
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{lawMsg}, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*suite.GovKeeper.GetParams(ctx).MaxDepositPeriod).Add(*suite.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	law, found := suite.GovKeeper.GetLaw(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposal.Id, law.ProposalId)
+	require.Equal(t, "title", law.Title)
+	require.Equal(t, "text", law.Text)
+	require.Equal(t, ctx.BlockTime(), *law.RatificationTime)
+}
+
+func TestProposalExecutionDelay(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	// law proposals are executed 2 hours after they pass, other proposals
+	// 1 hour after
+	params := suite.GovKeeper.GetParams(ctx)
+	executionDelay := time.Hour
+	params.ExecutionDelay = &executionDelay
+	params.MessageExecutionDelays = []v1.MessageExecutionDelay{
+		{MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgProposeLaw{}), Delay: 2 * time.Hour},
+	}
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	lawMsg := v1.NewMsgProposeLaw(authority, "title", "text", nil)
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{lawMsg}, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	// the proposal passes, its execution is scheduled
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassedPendingExecution, proposal.Status)
+	require.Equal(t, ctx.BlockTime().Add(2*time.Hour), *proposal.ExecutionTime)
+	_, found := suite.GovKeeper.GetLaw(ctx, 1)
+	require.False(t, found)
+
+	// the base execution delay is not enough
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassedPendingExecution, proposal.Status)
+
+	// the proposal is executed once its execution delay has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Len(t, proposal.MsgResults, 1)
+	require.True(t, proposal.MsgResults[0].Success)
+
+	law, found := suite.GovKeeper.GetLaw(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposal.Id, law.ProposalId)
+	require.Equal(t, ctx.BlockTime(), *law.RatificationTime)
+
+	// the proposal is removed from the execution queue
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	_, found = suite.GovKeeper.GetLaw(ctx, 2)
+	require.False(t, found)
+}
+
+func TestProposalExecutionOutOfGas(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	params := suite.GovKeeper.GetParams(ctx)
+	params.MaxProposalExecutionGas = 100
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	lawMsg := v1.NewMsgProposeLaw(authority, "title", "text", nil)
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{lawMsg}, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the execution of the proposal ran out of gas
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Contains(t, proposal.FailedReason, types.ErrProposalExecutionOutOfGas.Error())
+	require.Contains(t, proposal.FailedReason, "gas limit: 100")
+	require.Len(t, proposal.MsgResults, 1)
+	require.Equal(t, uint64(100), proposal.MsgResults[0].GasUsed)
+	_, found := suite.GovKeeper.GetLaw(ctx, 1)
+	require.False(t, found)
+
+	// the gas used is emitted
+	var gasUsed []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeActiveProposal {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyGasUsed {
+				gasUsed = append(gasUsed, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{"100"}, gasUsed)
+}
+
+func TestEndBlockerMaxProposals(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	// a single proposal is processed per block in each queue
+	params := suite.GovKeeper.GetParams(ctx)
+	params.MaxEndBlockerProposals = 1
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	var inactiveProposals, activeProposals []uint64
+	for i := 0; i < 2; i++ {
+		proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		inactiveProposals = append(inactiveProposals, proposal.Id)
+
+		lawMsg := v1.NewMsgProposeLaw(authority, "title", "text", nil)
+		proposal, err = suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{lawMsg}, "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+		require.NoError(t, err)
+		err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+		require.NoError(t, err)
+		activeProposals = append(activeProposals, proposal.Id)
+	}
+	countStatus := func(proposalIDs []uint64, status v1.ProposalStatus) (n int) {
+		for _, proposalID := range proposalIDs {
+			if proposal, ok := suite.GovKeeper.GetProposal(ctx, proposalID); ok && proposal.Status == status {
+				n++
+			}
+		}
+		return n
+	}
+
+	// the proposals in deposit period are deleted one per block
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(*params.MaxDepositPeriod))
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.Equal(t, 1, countStatus(inactiveProposals, v1.StatusDepositPeriod))
+	require.Equal(t, uint64(1), suite.GovKeeper.GetInactiveProposalsNumber(ctx))
+
+	// past its deposit end time, the deferred proposal no longer accepts
+	// deposits
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	_, err := suite.GovKeeper.AddDeposit(ctx, inactiveProposals[1], addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.Equal(t, 0, countStatus(inactiveProposals, v1.StatusDepositPeriod))
+	require.Equal(t, uint64(0), suite.GovKeeper.GetInactiveProposalsNumber(ctx))
+	require.Equal(t, 2, countStatus(activeProposals, v1.StatusVotingPeriod))
+
+	// the proposals in voting period are tallied one per block
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(*params.VotingPeriod))
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.Equal(t, 1, countStatus(activeProposals, v1.StatusVotingPeriod))
+	require.Equal(t, 1, countStatus(activeProposals, v1.StatusPassed))
+	require.Equal(t, uint64(1), suite.GovKeeper.GetActiveProposalsNumber(ctx))
+
+	// past its voting end time, the deferred proposal no longer accepts votes
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	err = suite.GovKeeper.AddVote(ctx, activeProposals[1], addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "")
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.Equal(t, 0, countStatus(activeProposals, v1.StatusVotingPeriod))
+	require.Equal(t, 2, countStatus(activeProposals, v1.StatusPassed))
+	require.Equal(t, uint64(0), suite.GovKeeper.GetActiveProposalsNumber(ctx))
+}
+
+func TestExpeditedProposal(t *testing.T) {
+	testcases := []struct {
+		name         string
+		voteOption   v1.VoteOption
+		expConverted bool
+	}{
+		{
+			name:       "expedited proposal passes",
+			voteOption: v1.OptionYes,
+		},
+		{
+			name:         "expedited proposal is converted to a regular proposal",
+			voteOption:   v1.OptionNo,
+			expConverted: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, suite.StakingKeeper)
+
+			params := suite.GovKeeper.GetParams(ctx)
+			params.ExpeditedAllowedMsgTypeUrls = []string{sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})}
+			minDeposit := suite.GovKeeper.GetMinDeposit(ctx)
+			params.ExpeditedMinDeposit = minDeposit.MulInt(math.NewInt(2))
+			require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+			// only messages of the allowlist can be expedited
+			_, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{&v1.MsgProposeLaw{Authority: authtypes.NewModuleAddress(types.ModuleName).String()}}, "", "title", "summary", addrs[0], true)
+			require.ErrorIs(t, err, types.ErrInvalidExpeditedProposal)
+
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], true)
+			require.NoError(t, err)
+			require.True(t, proposal.Expedited)
+
+			// the regular min deposit is not enough for an expedited proposal
+			votingStarted, err := suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], minDeposit)
+			require.NoError(t, err)
+			require.False(t, votingStarted)
+			votingStarted, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[1], minDeposit)
+			require.NoError(t, err)
+			require.True(t, votingStarted)
+
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(tc.voteOption), "")
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			if !tc.expConverted {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				return
+			}
+
+			// the proposal is now a regular proposal, its votes and deposits are kept
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+			_, found := suite.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+			require.True(t, found)
+			require.NotEmpty(t, suite.GovKeeper.GetDeposits(ctx, proposal.Id))
+
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusRejected, proposal.Status)
+		})
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	suite := createTestSuite(t)
 	app := suite.App
@@ -362,7 +727,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	staking.EndBlocker(ctx, suite.StakingKeeper)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -386,6 +751,120 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
 	require.True(t, ok)
 	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Contains(t, proposal.FailedReason, "msg 0 (/cosmos.bank.v1beta1.MsgSend) failed on execution")
+	require.Len(t, proposal.MsgResults, 1)
+	require.False(t, proposal.MsgResults[0].Success)
+	require.NotEmpty(t, proposal.MsgResults[0].Error)
+}
+
+func TestEndBlockerProposalAllOrNothing(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	// the gov module account doesn't hold enough funds for the bank send
+	msgs := []sdk.Msg{
+		v1.NewMsgProposeLaw(authority, "title", "text", nil),
+		banktypes.NewMsgSend(authority, addrs[1], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))),
+	}
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	require.Equal(t, v1.ExecutionPolicyAllOrNothing, proposal.ExecutionPolicy)
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*suite.GovKeeper.GetParams(ctx).MaxDepositPeriod).Add(*suite.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposal fails and the first message, reverted, is not recorded as
+	// successful
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Contains(t, proposal.FailedReason, "msg 1 (/cosmos.bank.v1beta1.MsgSend) failed on execution")
+	require.Len(t, proposal.MsgResults, 2)
+	require.False(t, proposal.MsgResults[0].Success)
+	require.Contains(t, proposal.MsgResults[0].Error, "reverted: msg 1 (/cosmos.bank.v1beta1.MsgSend) failed on execution")
+	require.False(t, proposal.MsgResults[1].Success)
+	require.NotEmpty(t, proposal.MsgResults[1].Error)
+
+	_, found := suite.GovKeeper.GetLaw(ctx, 1)
+	require.False(t, found)
+}
+
+func TestEndBlockerProposalBestEffort(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	// the gov module account doesn't hold enough funds for the bank send
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(authority, addrs[1], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))),
+		v1.NewMsgProposeLaw(authority, "title", "text", nil),
+	}
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	proposal.ExecutionPolicy = v1.ExecutionPolicyBestEffort
+	suite.GovKeeper.SetProposal(ctx, proposal)
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], suite.GovKeeper.GetMinDeposit(ctx))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*suite.GovKeeper.GetParams(ctx).MaxDepositPeriod).Add(*suite.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposal passes since one of its messages succeeded
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Empty(t, proposal.FailedReason)
+	require.Len(t, proposal.MsgResults, 2)
+	require.False(t, proposal.MsgResults[0].Success)
+	require.NotEmpty(t, proposal.MsgResults[0].Error)
+	require.True(t, proposal.MsgResults[1].Success)
+
+	law, found := suite.GovKeeper.GetLaw(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposal.Id, law.ProposalId)
 }
 
 func TestEndBlockerQuorumCheck(t *testing.T) {
@@ -476,14 +955,14 @@ func TestEndBlockerQuorumCheck(t *testing.T) {
 			newProposalMsg, err := v1.NewMsgSubmitProposal(
 				[]sdk.Msg{mkTestLegacyContent(t)},
 				sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, deposit.RoundInt())},
-				addrs[0].String(), "", "Proposal", "description of proposal",
+				addrs[0].String(), "", "Proposal", "description of proposal", false,
 			)
 			require.NoError(t, err)
 			res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
 			require.NoError(t, err)
 			require.NotNil(t, res)
 			// Activate proposal
-			newDepositMsg := v1.NewMsgDeposit(addrs[1], res.ProposalId, params.MinDeposit)
+			newDepositMsg := v1.NewMsgDeposit(addrs[1], res.ProposalId, suite.GovKeeper.GetMinDeposit(ctx))
 			res1, err := govMsgSvr.Deposit(ctx, newDepositMsg)
 			require.NoError(t, err)
 			require.NotNil(t, res1)
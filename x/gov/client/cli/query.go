@@ -39,7 +39,23 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryTallyProjection(),
 		GetCmdConstitution(),
+		GetCmdQueryConstitutionHistory(),
+		GetCmdQueryStructuredConstitution(),
+		GetCmdQueryConstitutionArticle(),
+		GetCmdQueryMinDeposit(),
+		GetCmdQueryMinInitialDeposit(),
+		GetCmdQueryGovernor(),
+		GetCmdQueryGovernors(),
+		GetCmdQueryGovernanceDelegation(),
+		GetCmdQueryQuorums(),
+		GetCmdQueryFinalVotes(),
+		GetCmdQueryVoteHistory(),
+		GetCmdQueryLaw(),
+		GetCmdQueryLaws(),
+		GetCmdQueryFundingStream(),
+		GetCmdQueryFundingStreams(),
 	)
 
 	return govQueryCmd
@@ -104,7 +120,7 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|PassedPendingExecution|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
 `,
 				version.AppName, version.AppName, version.AppName, version.AppName,
@@ -173,7 +189,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed_pending_execution/passed/rejected")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -510,6 +526,55 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryTallyProjection implements the command to query the tally of a
+// proposal as if its voting period ended now.
+func GetCmdQueryTallyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-projection [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the tally of a proposal in voting period as if its voting period ended now",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of votes on a proposal in voting period, as if its voting
+period ended now. The result includes the voting power of each vote option, the
+participation, the quorum and threshold that apply to the proposal, and whether
+the proposal would pass. You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov tally-projection 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposalTallyProjection(
+				cmd.Context(),
+				&v1.QueryProposalTallyProjectionRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.TallyProjection)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -656,10 +721,21 @@ $ %s query gov proposer 1
 }
 
 func GetCmdConstitution() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "constitution",
 		Short: "Get the constitution",
-		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the constitution currently in force, or the given version of the
+constitution with the proposal and the amendment that produced it.
+
+Example:
+$ %[1]s query gov constitution
+$ %[1]s query gov constitution --version=2
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -667,6 +743,23 @@ func GetCmdConstitution() *cobra.Command {
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
+			if cmd.Flags().Changed(flagVersion) {
+				constitutionVersion, err := cmd.Flags().GetUint64(flagVersion)
+				if err != nil {
+					return err
+				}
+
+				resp, err := queryClient.ConstitutionAtVersion(
+					cmd.Context(),
+					&v1.QueryConstitutionAtVersionRequest{Version: constitutionVersion},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(resp)
+			}
+
 			resp, err := queryClient.Constitution(cmd.Context(), &v1.QueryConstitutionRequest{})
 			if err != nil {
 				return err
@@ -675,4 +768,685 @@ func GetCmdConstitution() *cobra.Command {
 			return clientCtx.PrintProto(resp)
 		},
 	}
+
+	cmd.Flags().Uint64(flagVersion, 0, "(optional) version of the constitution to query, 0 being the genesis constitution")
+
+	return cmd
+}
+
+// GetCmdQueryConstitutionHistory implements the query constitution history
+// command.
+func GetCmdQueryConstitutionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-history",
+		Args:  cobra.NoArgs,
+		Short: "Query all the versions of the constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the versions of the constitution, with the proposal, the height
+and the amendment that produced each of them.
+
+Example:
+$ %[1]s query gov constitution-history
+$ %[1]s query gov constitution-history --page=2 --limit=10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ConstitutionHistory(
+				cmd.Context(),
+				&v1.QueryConstitutionHistoryRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "constitution history")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStructuredConstitution implements the query structured
+// constitution command.
+func GetCmdQueryStructuredConstitution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "structured-constitution",
+		Args:  cobra.NoArgs,
+		Short: "Query the structured constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the articles of the constitution, if the constitution is structured.
+
+Example:
+$ %s query gov structured-constitution
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StructuredConstitution(
+				cmd.Context(),
+				&v1.QueryStructuredConstitutionRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.StructuredConstitution)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConstitutionArticle implements the query constitution article
+// command.
+func GetCmdQueryConstitutionArticle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-article [article-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an article of the structured constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an article, or a section, of the structured constitution by its id,
+along with its sections.
+
+Example:
+$ %s query gov constitution-article article-1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConstitutionArticle(
+				cmd.Context(),
+				&v1.QueryConstitutionArticleRequest{ArticleId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Article)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMinDeposit implements the query min deposit command.
+func GetCmdQueryMinDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-deposit",
+		Args:  cobra.NoArgs,
+		Short: "Query the minimum deposit currently needed for a proposal to enter voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum deposit currently needed for a proposal to enter voting period.
+The minimum deposit is dynamic and depends on the number of proposals in voting period.
+
+Example:
+$ %s query gov min-deposit
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinDeposit(cmd.Context(), &v1.QueryMinDepositRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMinInitialDeposit implements the query min initial deposit command.
+func GetCmdQueryMinInitialDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-initial-deposit",
+		Args:  cobra.NoArgs,
+		Short: "Query the minimum initial deposit currently needed for a proposal to be submitted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum initial deposit currently needed for a proposal to be submitted.
+The minimum initial deposit is dynamic and depends on the number of proposals in deposit period.
+
+Example:
+$ %s query gov min-initial-deposit
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinInitialDeposit(cmd.Context(), &v1.QueryMinInitialDepositRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernor implements the query governor command.
+func GetCmdQueryGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governor [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of a single governor.
+
+Example:
+$ %s query gov governor atone1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			governorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governor(
+				cmd.Context(),
+				&v1.QueryGovernorRequest{GovernorAddress: governorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Governor)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernors implements the query governors command.
+func GetCmdQueryGovernors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governors",
+		Args:  cobra.NoArgs,
+		Short: "Query all governors",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for all governors.
+
+Example:
+$ %s query gov governors
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governors(
+				cmd.Context(),
+				&v1.QueryGovernorsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governors")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceDelegation implements the query governance delegation
+// command.
+func GetCmdQueryGovernanceDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegation [delegator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governor a delegator delegates its governance voting power to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governor a delegator delegates its governance voting power to.
+
+Example:
+$ %s query gov governance-delegation atone1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegation(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQuorums implements the query quorums command.
+func GetCmdQueryQuorums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quorums",
+		Args:  cobra.NoArgs,
+		Short: "Query the quorums currently required for proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the quorums currently required for regular, law and constitution amendment proposals.
+If the dynamic quorum is enabled, the quorums depend on the participation in past proposals.
+
+Example:
+$ %s query gov quorums
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quorums(cmd.Context(), &v1.QueryQuorumsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFinalVotes implements the command to query the final votes
+// recorded for a proposal after its tally.
+func GetCmdQueryFinalVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "final-votes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the final votes of a tallied proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the final votes recorded for a proposal once tallied, including
+the voting power used by each voter. Final votes are only recorded if the
+persist_final_votes param is enabled, and are pruned after the final votes
+retention period.
+
+Example:
+$ %[1]s query gov final-votes 1
+$ %[1]s query gov final-votes 1 --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalVotes(
+				cmd.Context(),
+				&v1.QueryFinalVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "final votes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteHistory implements the command to query the vote history of
+// a proposal.
+func GetCmdQueryVoteHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-history [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote history of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the votes cast or changed on a proposal, in the order they were
+cast. The vote history is only recorded when the record_vote_history param is
+enabled, and is pruned along with the final votes.
+
+Example:
+$ %[1]s query gov vote-history 1
+$ %[1]s query gov vote-history 1 --voter=atone1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			voter, _ := cmd.Flags().GetString(flagVoter)
+			if voter != "" {
+				if _, err := sdk.AccAddressFromBech32(voter); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteHistory(
+				cmd.Context(),
+				&v1.QueryVoteHistoryRequest{ProposalId: proposalID, Voter: voter, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagVoter, "", "(optional) filter by the votes of voter")
+	flags.AddPaginationFlagsToCmd(cmd, "vote history")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLaw implements the query law command.
+func GetCmdQueryLaw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "law [law-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single law",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a law ratified by governance. You can find the
+law-id by running "%s query gov laws".
+
+Example:
+$ %s query gov law 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the law id is a uint
+			lawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("law-id %s not a valid uint, please input a valid law-id", args[0])
+			}
+
+			res, err := queryClient.Law(
+				cmd.Context(),
+				&v1.QueryLawRequest{LawId: lawID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Law)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLaws implements the query laws command.
+func GetCmdQueryLaws() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "laws",
+		Args:  cobra.NoArgs,
+		Short: "Query all the laws ratified by governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for all the laws ratified by governance.
+
+Example:
+$ %[1]s query gov laws
+$ %[1]s query gov laws --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Laws(
+				cmd.Context(),
+				&v1.QueryLawsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "laws")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFundingStream implements the query funding stream command.
+func GetCmdQueryFundingStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single funding stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a funding stream created by governance. You can
+find the stream-id by running "%s query gov funding-streams".
+
+Example:
+$ %s query gov funding-stream 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the stream id is a uint
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := queryClient.FundingStream(
+				cmd.Context(),
+				&v1.QueryFundingStreamRequest{StreamId: streamID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.FundingStream)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFundingStreams implements the query funding streams command.
+func GetCmdQueryFundingStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all the active funding streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for all the active funding streams created by governance.
+
+Example:
+$ %[1]s query gov funding-streams
+$ %[1]s query gov funding-streams --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FundingStreams(
+				cmd.Context(),
+				&v1.QueryFundingStreamsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "funding streams")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
 }
@@ -160,6 +160,41 @@ func (s *CLITestSuite) TestCmdTally() {
 	}
 }
 
+func (s *CLITestSuite) TestCmdTallyProjection() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"with proposal id (json output)",
+			[]string{
+				"2",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"2 --output=json",
+		},
+		{
+			"with proposal id (text output)",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=text", flags.FlagOutput),
+			},
+			"1 --output=text",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryTallyProjection()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
 func (s *CLITestSuite) TestCmdGetProposal() {
 	testCases := []struct {
 		name         string
@@ -387,12 +422,18 @@ func (s *CLITestSuite) TestCmdQueryVote() {
 func (s *CLITestSuite) TestCmdGetConstitution() {
 	testCases := []struct {
 		name      string
+		args      []string
 		expOutput string
 	}{
 		{
 			name:      "get constitution",
 			expOutput: "constitution",
 		},
+		{
+			name:      "get constitution at version",
+			args:      []string{"--version=1"},
+			expOutput: "constitution_version",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -400,9 +441,450 @@ func (s *CLITestSuite) TestCmdGetConstitution() {
 
 		s.Run(tc.name, func() {
 			cmd := cli.GetCmdConstitution()
-			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, []string{})
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
 			s.Require().NoError(err)
 			s.Require().Contains(out.String(), tc.expOutput)
 		})
 	}
 }
+
+func (s *CLITestSuite) TestCmdQueryConstitutionHistory() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"all versions",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--output=json",
+		},
+		{
+			"versions with pagination",
+			[]string{
+				fmt.Sprintf("--%s=2", flags.FlagPage),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--page=2 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryConstitutionHistory()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryConstitutionArticle() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"article with json output",
+			[]string{
+				"article-1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"article-1 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryConstitutionArticle()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryMinDeposit() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			"--output=json",
+		},
+		{
+			"text output",
+			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
+			"--output=text",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryMinDeposit()
+			cmd.SetArgs(tc.args)
+
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryMinInitialDeposit() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			"--output=json",
+		},
+		{
+			"text output",
+			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
+			"--output=text",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryMinInitialDeposit()
+			cmd.SetArgs(tc.args)
+
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryGovernor() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"get governor",
+			[]string{val[0].Address.String()},
+			val[0].Address.String(),
+		},
+		{
+			"get governor (json output)",
+			[]string{
+				val[0].Address.String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			fmt.Sprintf("%s --output=json", val[0].Address.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGovernor()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryGovernors() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"get governors",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			"--output=json",
+		},
+		{
+			"get governors with pagination",
+			[]string{fmt.Sprintf("--%s=2", flags.FlagLimit)},
+			"--limit=2",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGovernors()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryGovernanceDelegation() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"get governance delegation",
+			[]string{val[0].Address.String()},
+			val[0].Address.String(),
+		},
+		{
+			"get governance delegation (json output)",
+			[]string{
+				val[0].Address.String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			fmt.Sprintf("%s --output=json", val[0].Address.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGovernanceDelegation()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryQuorums() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			"--output=json",
+		},
+		{
+			"text output",
+			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
+			"--output=text",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryQuorums()
+			cmd.SetArgs(tc.args)
+
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryFinalVotes() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"final votes of proposal 1",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+		{
+			"final votes of proposal 2 with pagination",
+			[]string{
+				"2",
+				fmt.Sprintf("--%s=2", flags.FlagPage),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"2 --page=2 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFinalVotes()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryVoteHistory() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"vote history of proposal 1",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+		{
+			"vote history of proposal 2 filtered by voter",
+			[]string{
+				"2",
+				fmt.Sprintf("--voter=%s", val[0].Address.String()),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			fmt.Sprintf("2 --voter=%s --output=json", val[0].Address.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryVoteHistory()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryLaw() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"law with id",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLaw()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryLaws() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"all laws",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--output=json",
+		},
+		{
+			"laws with pagination",
+			[]string{
+				fmt.Sprintf("--%s=2", flags.FlagPage),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--page=2 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLaws()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryFundingStream() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"funding stream with id",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFundingStream()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryFundingStreams() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"all funding streams",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--output=json",
+		},
+		{
+			"funding streams with pagination",
+			[]string{
+				fmt.Sprintf("--%s=2", flags.FlagPage),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--page=2 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFundingStreams()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
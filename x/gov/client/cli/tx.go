@@ -31,12 +31,22 @@ const (
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
+	flagVersion      = "version"
 	FlagMetadata     = "metadata"
 	FlagSummary      = "summary"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
 
+// Governor flags
+const (
+	FlagMoniker         = "moniker"
+	FlagIdentity        = "identity"
+	FlagWebsite         = "website"
+	FlagSecurityContact = "security-contact"
+	FlagDetails         = "details"
+)
+
 // ProposalFlags defines the core required fields of a legacy proposal. It is used to
 // verify that these values are not provided in conjunction with a JSON proposal
 // file.
@@ -74,6 +84,11 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdGenerateConstitutionAmendment(),
+		NewCmdCreateGovernor(),
+		NewCmdEditGovernor(),
+		NewCmdDelegateGovernor(),
+		NewCmdUndelegateGovernor(),
+		NewCmdCancelProposal(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -112,9 +127,20 @@ Where proposal.json contains:
   "metadata": "4pIMOgIGx1vZGU=",
   "deposit": "10stake",
   "title": "My proposal",
-  "summary": "A short summary of my proposal"
+  "summary": "A short summary of my proposal",
+  // expedited proposals can only contain the messages allowed by the
+  // expedited_allowed_msg_type_urls param
+  "expedited": false,
+  // optional, PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING (default) reverts all
+  // the messages if one fails, PROPOSAL_EXECUTION_POLICY_BEST_EFFORT only
+  // reverts the failing messages
+  "execution_policy": "PROPOSAL_EXECUTION_POLICY_BEST_EFFORT"
 }
 
+With --dry-run, the transaction is not broadcast. Instead, the execution of the
+messages is simulated against the current state, as the governance module
+account, and the result, events and gas used of each message are printed.
+
 metadata example: 
 {
 	"title": "",
@@ -134,15 +160,36 @@ metadata example:
 				return err
 			}
 
-			msgs, metadata, title, summary, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			proposal, msgs, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			executionPolicy, err := parseExecutionPolicy(proposal.ExecutionPolicy)
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, proposal.Expedited)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.ExecutionPolicy = executionPolicy
+
+			// with --dry-run, simulate the execution of the proposal messages
+			// instead of the transaction, so that they can be fixed before
+			// spending a deposit
+			if clientCtx.Simulate {
+				req, err := v1.NewQuerySimulateProposalRequest(msgs)
+				if err != nil {
+					return err
+				}
+				res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -470,3 +517,225 @@ $ %s tx gov generate-constitution-amendment path/to/updated/constitution.md
 
 	return cmd
 }
+
+// NewCmdCreateGovernor implements creating a new governor command.
+func NewCmdCreateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-governor",
+		Args:  cobra.NoArgs,
+		Short: "Create a new governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new governor, to which other accounts can delegate
+their governance voting power. The governor must have self-delegated at least
+the minimum governor self delegation to bonded validators.
+
+Example:
+$ %s tx gov create-governor --moniker="my governor" --website="https://example.com" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			description, err := governorDescriptionFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgCreateGovernor(clientCtx.GetFromAddress(), description)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addGovernorDescriptionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdEditGovernor implements editing a governor command.
+func NewCmdEditGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-governor [status]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Edit the description and status of a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the description and status of a governor. The description
+is replaced with the values of the flags. The status can be active or inactive,
+and can only be changed once per governor status change period.
+
+Example:
+$ %s tx gov edit-governor inactive --moniker="my governor" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := v1.GovernorStatusFromString(govutils.NormalizeGovernorStatus(args[0]))
+			if err != nil {
+				return err
+			}
+
+			description, err := governorDescriptionFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgEditGovernor(clientCtx.GetFromAddress(), description, status)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addGovernorDescriptionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateGovernor implements delegating governance voting power to a
+// governor command.
+func NewCmdDelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-governor [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate governance voting power to a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate your governance voting power to an active governor.
+The governor votes on your behalf on the proposals you do not vote on yourself.
+
+Example:
+$ %s tx gov delegate-governor atone1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateGovernor(clientCtx.GetFromAddress(), governorAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateGovernor implements undelegating governance voting power
+// from a governor command.
+func NewCmdUndelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-governor",
+		Args:  cobra.NoArgs,
+		Short: "Undelegate governance voting power from your governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Undelegate your governance voting power from your governor.
+
+Example:
+$ %s tx gov undelegate-governor --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovernor(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addGovernorDescriptionFlags adds the governor description flags to a command.
+func addGovernorDescriptionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMoniker, "", "The governor's name")
+	cmd.Flags().String(FlagIdentity, "", "The optional identity signature (ex. UPort or Keybase)")
+	cmd.Flags().String(FlagWebsite, "", "The governor's (optional) website")
+	cmd.Flags().String(FlagSecurityContact, "", "The governor's (optional) security contact email")
+	cmd.Flags().String(FlagDetails, "", "The governor's (optional) details")
+}
+
+// governorDescriptionFromFlags reads the governor description from the flags
+// of a command.
+func governorDescriptionFromFlags(cmd *cobra.Command) (v1.GovernorDescription, error) {
+	var values [5]string
+	for i, flag := range []string{FlagMoniker, FlagIdentity, FlagWebsite, FlagSecurityContact, FlagDetails} {
+		value, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return v1.GovernorDescription{}, err
+		}
+		values[i] = value
+	}
+
+	return v1.NewGovernorDescription(values[0], values[1], values[2], values[3], values[4]), nil
+}
+
+// NewCmdCancelProposal implements the cancel proposal transaction command.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in deposit or voting period, only the proposer can cancel it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in deposit or voting period. Only the proposer of
+the proposal can cancel it. A ratio of the deposits, defined by the
+proposal_cancel_ratio param, is burned and the rest is refunded to the
+depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -88,20 +88,39 @@ type proposal struct {
 	Deposit  string            `json:"deposit"`
 	Title    string            `json:"title"`
 	Summary  string            `json:"summary"`
+	// Expedited defines if the proposal is expedited.
+	Expedited bool `json:"expedited"`
+	// ExecutionPolicy defines how the messages of the proposal are executed,
+	// all-or-nothing if empty.
+	ExecutionPolicy string `json:"execution_policy,omitempty"`
+}
+
+// parseExecutionPolicy returns the execution policy named name, e.g.
+// PROPOSAL_EXECUTION_POLICY_BEST_EFFORT, or the all-or-nothing policy if name
+// is empty.
+func parseExecutionPolicy(name string) (govv1.ProposalExecutionPolicy, error) {
+	if name == "" {
+		return govv1.ExecutionPolicyAllOrNothing, nil
+	}
+	policy, ok := govv1.ProposalExecutionPolicy_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid execution policy %s", name)
+	}
+	return govv1.ProposalExecutionPolicy(policy), nil
 }
 
 // parseSubmitProposal reads and parses the proposal.
-func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, string, string, sdk.Coins, error) {
+func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk.Coins, error) {
 	var proposal proposal
 
 	contents, err := os.ReadFile(path)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	err = json.Unmarshal(contents, &proposal)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	msgs := make([]sdk.Msg, len(proposal.Messages))
@@ -109,7 +128,7 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 		var msg sdk.Msg
 		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
 		if err != nil {
-			return nil, "", "", "", nil, err
+			return proposal, nil, nil, err
 		}
 
 		msgs[i] = msg
@@ -117,10 +136,10 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
-	return msgs, proposal.Metadata, proposal.Title, proposal.Summary, deposit, nil
+	return proposal, msgs, deposit, nil
 }
 
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
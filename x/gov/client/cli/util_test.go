@@ -164,25 +164,27 @@ func TestParseSubmitProposal(t *testing.T) {
 	"metadata": "%s",
 	"title": "My awesome title",
 	"summary": "My awesome summary",
-	"deposit": "1000test"
+	"deposit": "1000test",
+	"expedited": true,
+	"execution_policy": "PROPOSAL_EXECUTION_POLICY_BEST_EFFORT"
 }
 `, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
 
 	badJSON := testutil.WriteToNewTempFile(t, "bad json")
 
 	// nonexistent json
-	_, _, _, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist") //nolint: dogsled
+	_, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist") //nolint: dogsled
 	require.Error(t, err)
 
 	// invalid json
-	_, _, _, _, _, err = parseSubmitProposal(cdc, badJSON.Name()) //nolint: dogsled
+	_, _, _, err = parseSubmitProposal(cdc, badJSON.Name()) //nolint: dogsled
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, title, summary, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	proposal, msgs, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
-	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), metadata)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), proposal.Metadata)
 	require.Len(t, msgs, 3)
 	msg1, ok := msgs[0].(*banktypes.MsgSend)
 	require.True(t, ok)
@@ -201,8 +203,12 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "My awesome title", textProp.Title)
 	require.Equal(t, "My awesome description", textProp.Description)
-	require.Equal(t, "My awesome title", title)
-	require.Equal(t, "My awesome summary", summary)
+	require.Equal(t, "My awesome title", proposal.Title)
+	require.Equal(t, "My awesome summary", proposal.Summary)
+	require.True(t, proposal.Expedited)
+	executionPolicy, err := parseExecutionPolicy(proposal.ExecutionPolicy)
+	require.NoError(t, err)
+	require.Equal(t, v1.ExecutionPolicyBestEffort, executionPolicy)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -210,6 +216,19 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Nil(t, err, "unexpected error")
 }
 
+func TestParseExecutionPolicy(t *testing.T) {
+	policy, err := parseExecutionPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, v1.ExecutionPolicyAllOrNothing, policy)
+
+	policy, err = parseExecutionPolicy("PROPOSAL_EXECUTION_POLICY_BEST_EFFORT")
+	require.NoError(t, err)
+	require.Equal(t, v1.ExecutionPolicyBestEffort, policy)
+
+	_, err = parseExecutionPolicy("BEST_EFFORT")
+	require.Error(t, err)
+}
+
 func getCommandHelp(t *testing.T, cmd *cobra.Command) string {
 	// Create a pipe, so we can capture the help sent to stdout.
 	reader, writer, err := os.Pipe()
@@ -88,6 +88,18 @@ func HasInactiveProposal(ctx sdk.Context, k *keeper.Keeper, id uint64, t time.Ti
 	return false
 }
 
+func HasExecution(ctx sdk.Context, k *keeper.Keeper, id uint64, t time.Time) bool {
+	it := k.ExecutionQueueIterator(ctx, t)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		proposalID, _ := types.SplitExecutionQueueKey(it.Key())
+		if proposalID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func HasQuorumCheck(ctx sdk.Context, k *keeper.Keeper, id uint64, t time.Time) bool {
 	_, ok := GetQuorumCheckQueueEntry(ctx, k, id, t)
 	return ok
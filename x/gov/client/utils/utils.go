@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
@@ -59,6 +60,20 @@ func NormalizeProposalStatus(status string) string {
 		return v1beta1.StatusPassed.String()
 	case "Rejected", "rejected":
 		return v1beta1.StatusRejected.String()
+	case "PassedPendingExecution", "passed_pending_execution":
+		return v1.StatusPassedPendingExecution.String()
+	default:
+		return status
+	}
+}
+
+// NormalizeGovernorStatus - normalize user specified governor status.
+func NormalizeGovernorStatus(status string) string {
+	switch status {
+	case "Active", "active":
+		return v1.GovernorStatusActive.String()
+	case "Inactive", "inactive":
+		return v1.GovernorStatusInactive.String()
 	default:
 		return status
 	}
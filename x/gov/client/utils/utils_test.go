@@ -75,6 +75,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
+		{"PassedPendingExecution", args{"PassedPendingExecution"}, "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION"},
+		{"passed_pending_execution", args{"passed_pending_execution"}, "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -82,3 +84,22 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeGovernorStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   string
+	}{
+		{"invalid", "unknown", "unknown"},
+		{"active", "active", "GOVERNOR_STATUS_ACTIVE"},
+		{"Active", "Active", "GOVERNOR_STATUS_ACTIVE"},
+		{"inactive", "inactive", "GOVERNOR_STATUS_INACTIVE"},
+		{"Inactive", "Inactive", "GOVERNOR_STATUS_INACTIVE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, utils.NormalizeGovernorStatus(tt.status))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
 	_ "github.com/cosmos/cosmos-sdk/x/params"
 	_ "github.com/cosmos/cosmos-sdk/x/staking"
 
@@ -118,6 +119,7 @@ func createTestSuite(t *testing.T) suite {
 			configurator.AuthModule(),
 			configurator.StakingModule(),
 			configurator.BankModule(),
+			configurator.DistributionModule(),
 			configurator.GovModule(),
 			configurator.ConsensusModule(),
 		),
@@ -17,6 +17,28 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
 	}
 	k.SetConstitution(ctx, data.Constitution)
+	// a structured constitution replaces the constitution by its rendering
+	if data.StructuredConstitution != nil {
+		k.SetStructuredConstitution(ctx, *data.StructuredConstitution)
+	}
+	// the genesis constitution is recorded as version 0 when no history is
+	// provided
+	if len(data.ConstitutionHistory) == 0 {
+		k.SetConstitutionVersion(ctx, v1.ConstitutionVersion{
+			Height:       ctx.BlockHeight(),
+			Constitution: k.GetConstitution(ctx),
+		})
+	}
+	for _, constitutionVersion := range data.ConstitutionHistory {
+		k.SetConstitutionVersion(ctx, *constitutionVersion)
+	}
+
+	// the participation EMAs must be set before the proposals, since they are
+	// used to compute the quorum when the dynamic quorum is enabled.
+	k.SetParticipationEMA(ctx, participationEMAOrDefault(data.ParticipationEma, v1.DefaultParticipationEMA))
+	k.SetConstitutionAmendmentParticipationEMA(ctx,
+		participationEMAOrDefault(data.ConstitutionAmendmentParticipationEma, v1.DefaultConstitutionAmendmentParticipationEMA))
+	k.SetLawParticipationEMA(ctx, participationEMAOrDefault(data.LawParticipationEma, v1.DefaultLawParticipationEMA))
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -34,16 +56,43 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, *vote)
 	}
 
+	for _, governor := range data.Governors {
+		k.SetGovernor(ctx, *governor)
+	}
+
+	// the validator shares delegated to governors are not part of the genesis,
+	// they are recomputed from the staking delegations, hence the staking
+	// genesis must be initialized first.
+	for _, delegation := range data.GovernanceDelegations {
+		delAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+		govAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+		k.DelegateToGovernor(ctx, delAddr, govAddr)
+	}
+
+	activeProposalsNumber := uint64(0)
+	inactiveProposalsNumber := uint64(0)
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+			inactiveProposalsNumber++
 		case v1.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			activeProposalsNumber++
+		case v1.StatusPassedPendingExecution:
+			k.InsertExecutionQueue(ctx, proposal.Id, *proposal.ExecutionTime)
 		}
 		k.SetProposal(ctx, *proposal)
 
-		if data.Params.QuorumCheckCount > 0 && proposal.Status == v1.StatusVotingPeriod {
+		// the voting power accumulators are not part of the genesis, they are
+		// recomputed from the votes and the staking and governance delegations
+		if proposal.Status == v1.StatusVotingPeriod {
+			k.ResetVotingPowerAccumulator(ctx, proposal.Id)
+		}
+
+		// expedited proposals are added to the quorum check queue only if they
+		// are converted to regular proposals.
+		if data.Params.QuorumCheckCount > 0 && proposal.Status == v1.StatusVotingPeriod && !proposal.Expedited {
 			quorumTimeoutTime := proposal.VotingStartTime.Add(*data.Params.QuorumTimeout)
 			quorumCheckEntry := v1.NewQuorumCheckQueueEntry(quorumTimeoutTime, data.Params.QuorumCheckCount)
 			quorum := false
@@ -66,6 +115,74 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		}
 
 	}
+	k.SetActiveProposalsNumber(ctx, activeProposalsNumber)
+	k.SetInactiveProposalsNumber(ctx, inactiveProposalsNumber)
+
+	// the final votes prune queue is not part of the genesis, the final votes
+	// and the vote history of a proposal are pruned after the retention period
+	// counted from the end of its voting period.
+	proposalsByID := make(map[uint64]*v1.Proposal, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		proposalsByID[proposal.Id] = proposal
+	}
+	prunedProposals := make(map[uint64]struct{})
+	insertPruneQueue := func(proposalID uint64) {
+		if _, ok := prunedProposals[proposalID]; ok {
+			return
+		}
+		prunedProposals[proposalID] = struct{}{}
+		pruneTime := ctx.BlockTime()
+		if proposal, ok := proposalsByID[proposalID]; ok && proposal.VotingEndTime != nil {
+			pruneTime = *proposal.VotingEndTime
+		}
+		k.InsertFinalVotesPruneQueue(ctx, proposalID, pruneTime.Add(*data.Params.FinalVotesRetentionPeriod))
+	}
+	for _, vote := range data.FinalVotes {
+		k.SetFinalVote(ctx, *vote)
+		insertPruneQueue(vote.ProposalId)
+	}
+	for _, entry := range data.VoteHistory {
+		k.SetVoteHistoryEntry(ctx, *entry)
+		if entry.Sequence >= k.GetVoteHistorySequence(ctx, entry.ProposalId) {
+			k.SetVoteHistorySequence(ctx, entry.ProposalId, entry.Sequence+1)
+		}
+		// the vote history of a proposal in voting period is queued for
+		// pruning once the proposal is tallied
+		if proposal, ok := proposalsByID[entry.ProposalId]; !ok || proposal.Status != v1.StatusVotingPeriod {
+			insertPruneQueue(entry.ProposalId)
+		}
+	}
+
+	lawID := uint64(1)
+	for _, law := range data.Laws {
+		k.SetLaw(ctx, *law)
+		if law.Id >= lawID {
+			lawID = law.Id + 1
+		}
+	}
+	k.SetLawID(ctx, lawID)
+
+	streamID := uint64(1)
+	for _, stream := range data.FundingStreams {
+		k.SetFundingStream(ctx, *stream)
+		k.InsertFundingStreamPayoutQueue(ctx, stream.Id, *stream.NextPayoutTime)
+		if stream.Id >= streamID {
+			streamID = stream.Id + 1
+		}
+	}
+	k.SetFundingStreamID(ctx, streamID)
+
+	if data.LastMinDeposit != nil {
+		k.SetLastMinDeposit(ctx, data.LastMinDeposit.Value, *data.LastMinDeposit.Time)
+	} else {
+		k.SetLastMinDeposit(ctx, data.Params.MinDepositThrottler.FloorValue, ctx.BlockTime())
+	}
+
+	if data.LastMinInitialDeposit != nil {
+		k.SetLastMinInitialDeposit(ctx, data.LastMinInitialDeposit.Value, *data.LastMinInitialDeposit.Time)
+	} else {
+		k.SetLastMinInitialDeposit(ctx, data.Params.MinInitialDepositThrottler.FloorValue, ctx.BlockTime())
+	}
 
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
@@ -79,12 +196,29 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	}
 }
 
+// participationEMAOrDefault returns the participation EMA parsed from ema, or
+// defaultEMA if ema is empty.
+func participationEMAOrDefault(ema string, defaultEMA sdk.Dec) sdk.Dec {
+	if ema == "" {
+		return defaultEMA
+	}
+	return sdk.MustNewDecFromStr(ema)
+}
+
 // ExportGenesis - output genesis parameters
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	startingProposalID, _ := k.GetProposalID(ctx)
 	proposals := k.GetProposals(ctx)
 	params := k.GetParams(ctx)
 	constitution := k.GetConstitution(ctx)
+	var structuredConstitution *v1.StructuredConstitution
+	if structured, found := k.GetStructuredConstitution(ctx); found {
+		structuredConstitution = &structured
+	}
+	lastMinDeposit, lastMinDepositTime := k.GetLastMinDeposit(ctx)
+	lastMinInitialDeposit, lastMinInitialDepositTime := k.GetLastMinInitialDeposit(ctx)
+	governors := k.GetAllGovernors(ctx)
+	governanceDelegations := k.GetAllGovernanceDelegations(ctx)
 
 	var proposalsDeposits v1.Deposits
 	var proposalsVotes v1.Votes
@@ -103,5 +237,24 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		LastMinDeposit: &v1.LastMinDeposit{
+			Value: lastMinDeposit,
+			Time:  &lastMinDepositTime,
+		},
+		LastMinInitialDeposit: &v1.LastMinDeposit{
+			Value: lastMinInitialDeposit,
+			Time:  &lastMinInitialDepositTime,
+		},
+		Governors:                             governors,
+		GovernanceDelegations:                 governanceDelegations,
+		ParticipationEma:                      k.GetParticipationEMA(ctx).String(),
+		ConstitutionAmendmentParticipationEma: k.GetConstitutionAmendmentParticipationEMA(ctx).String(),
+		LawParticipationEma:                   k.GetLawParticipationEMA(ctx).String(),
+		FinalVotes:                            k.GetAllFinalVotes(ctx),
+		Laws:                                  k.GetLaws(ctx),
+		VoteHistory:                           k.GetAllVoteHistory(ctx),
+		FundingStreams:                        k.GetFundingStreams(ctx),
+		ConstitutionHistory:                   k.GetConstitutionHistory(ctx),
+		StructuredConstitution:                structuredConstitution,
 	}
 }
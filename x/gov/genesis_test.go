@@ -15,6 +15,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/atomone-hub/atomone/x/gov"
 	"github.com/atomone-hub/atomone/x/gov/client/testutil"
@@ -44,21 +46,57 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	})
 	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, v1.DefaultGenesisState())
 	genState := gov.ExportGenesis(ctx, suite.GovKeeper)
-	require.Equal(t, genState, v1.DefaultGenesisState())
+	expectedGenState := v1.DefaultGenesisState()
+	blockTime := ctx.BlockTime()
+	expectedGenState.LastMinDeposit = &v1.LastMinDeposit{
+		Value: expectedGenState.Params.MinDepositThrottler.FloorValue,
+		Time:  &blockTime,
+	}
+	expectedGenState.LastMinInitialDeposit = &v1.LastMinDeposit{
+		Value: expectedGenState.Params.MinInitialDepositThrottler.FloorValue,
+		Time:  &blockTime,
+	}
+	// the genesis constitution is recorded as version 0
+	expectedGenState.ConstitutionHistory = []*v1.ConstitutionVersion{{
+		Height:       ctx.BlockHeight(),
+		Constitution: expectedGenState.Constitution,
+	}}
+	require.Equal(t, genState, expectedGenState)
 }
 
 func TestInitGenesis(t *testing.T) {
 	var (
 		testAddrs = simtestutil.CreateRandomAccounts(2)
 		params    = &v1.Params{
-			MinDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(42))),
+			MinDepositThrottler: &v1.MinDepositThrottler{
+				FloorValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(42))),
+			},
+			MinInitialDepositThrottler: &v1.MinInitialDepositThrottler{
+				FloorValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(4))),
+			},
 		}
 		quorumTimeout                = time.Hour * 20
 		paramsWithQuorumCheckEnabled = &v1.Params{
-			MinDeposit:       sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(42))),
+			MinDepositThrottler: &v1.MinDepositThrottler{
+				FloorValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(42))),
+			},
+			MinInitialDepositThrottler: &v1.MinInitialDepositThrottler{
+				FloorValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(4))),
+			},
 			QuorumCheckCount: 10,
 			QuorumTimeout:    &quorumTimeout,
 		}
+		finalVotesRetention  = time.Hour * 24
+		paramsWithFinalVotes = &v1.Params{
+			MinDepositThrottler: &v1.MinDepositThrottler{
+				FloorValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(42))),
+			},
+			MinInitialDepositThrottler: &v1.MinInitialDepositThrottler{
+				FloorValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(4))),
+			},
+			PersistFinalVotes:         true,
+			FinalVotesRetentionPeriod: &finalVotesRetention,
+		}
 
 		depositAmount = sdk.Coins{
 			sdk.NewCoin(
@@ -90,10 +128,14 @@ func TestInitGenesis(t *testing.T) {
 				Options:    v1.NewNonSplitVoteOption(v1.OptionNo),
 			},
 		}
-		depositEndTime  = time.Now().Add(time.Hour * 8)
-		votingStartTime = time.Now()
-		votingEndTime   = time.Now().Add(time.Hour * 24)
-		proposals       = []*v1.Proposal{
+		fundingStreamInterval   = time.Hour
+		fundingStreamPayoutTime = time.Now().Add(time.Hour)
+		fundingStreamEndTime    = time.Now().Add(time.Hour * 24)
+		depositEndTime          = time.Now().Add(time.Hour * 8)
+		votingStartTime         = time.Now()
+		votingEndTime           = time.Now().Add(time.Hour * 24)
+		executionTime           = time.Now().Add(time.Hour * 48)
+		proposals               = []*v1.Proposal{
 			{
 				Id:              1234,
 				Status:          v1.StatusVotingPeriod,
@@ -115,6 +157,14 @@ func TestInitGenesis(t *testing.T) {
 				VotingStartTime: &votingStartTime,
 				VotingEndTime:   &votingEndTime,
 			},
+			{
+				Id:              1234567,
+				Status:          v1.StatusPassedPendingExecution,
+				DepositEndTime:  &depositEndTime,
+				VotingStartTime: &votingStartTime,
+				VotingEndTime:   &votingEndTime,
+				ExecutionTime:   &executionTime,
+			},
 		}
 		assertProposals = func(t *testing.T, ctx sdk.Context, s suite, expectedProposals []*v1.Proposal) {
 			t.Helper()
@@ -132,11 +182,15 @@ func TestInitGenesis(t *testing.T) {
 					assert.True(testutil.HasActiveProposal(ctx, s.GovKeeper, p.Id, *p.VotingEndTime))
 					assert.False(testutil.HasInactiveProposal(ctx, s.GovKeeper, p.Id, *p.DepositEndTime))
 					if params.QuorumCheckCount > 0 {
-						assert.True(testutil.HasQuorumCheck(ctx, s.GovKeeper, p.Id, p.VotingStartTime.Add(*params.QuorumTimeout)))
+						// expedited proposals have no quorum check
+						assert.Equal(!p.Expedited, testutil.HasQuorumCheck(ctx, s.GovKeeper, p.Id, p.VotingStartTime.Add(*params.QuorumTimeout)))
 					}
 				case v1.StatusDepositPeriod:
 					assert.False(testutil.HasActiveProposal(ctx, s.GovKeeper, p.Id, *p.VotingEndTime))
 					assert.True(testutil.HasInactiveProposal(ctx, s.GovKeeper, p.Id, *p.DepositEndTime))
+				case v1.StatusPassedPendingExecution:
+					assert.False(testutil.HasActiveProposal(ctx, s.GovKeeper, p.Id, *p.VotingEndTime))
+					assert.True(testutil.HasExecution(ctx, s.GovKeeper, p.Id, *p.ExecutionTime))
 				}
 			}
 		}
@@ -215,6 +269,167 @@ func TestInitGenesis(t *testing.T) {
 				assertProposals(t, ctx, s, proposals)
 			},
 		},
+		{
+			name: "ok: genesis with participation EMAs",
+			genesis: v1.GenesisState{
+				Params:              params,
+				ParticipationEma:    "0.5",
+				LawParticipationEma: "0.2",
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5"), s.GovKeeper.GetParticipationEMA(ctx))
+				assert.Equal(t, v1.DefaultConstitutionAmendmentParticipationEMA, s.GovKeeper.GetConstitutionAmendmentParticipationEMA(ctx))
+				assert.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.2"), s.GovKeeper.GetLawParticipationEMA(ctx))
+			},
+		},
+		{
+			name: "ok: genesis with final votes",
+			genesis: v1.GenesisState{
+				Params: paramsWithFinalVotes,
+				FinalVotes: []*v1.FinalVote{
+					{ProposalId: 1234, Voter: testAddrs[0].String(), Options: v1.NewNonSplitVoteOption(v1.OptionYes), VotingPower: "10"},
+					{ProposalId: 1234, Voter: testAddrs[1].String(), Options: v1.NewNonSplitVoteOption(v1.OptionNo), VotingPower: "5"},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Len(t, s.GovKeeper.GetFinalVotes(ctx, 1234), 2)
+				// the final votes are pruned after the retention period
+				var pruneTimes []time.Time
+				s.GovKeeper.IterateFinalVotesPruneQueue(ctx, ctx.BlockTime().Add(finalVotesRetention),
+					func(proposalID uint64, pruneTime time.Time) bool {
+						assert.Equal(t, uint64(1234), proposalID)
+						pruneTimes = append(pruneTimes, pruneTime)
+						return false
+					})
+				assert.Equal(t, []time.Time{ctx.BlockTime().Add(finalVotesRetention)}, pruneTimes)
+			},
+		},
+		{
+			name: "ok: genesis with vote history",
+			genesis: v1.GenesisState{
+				Params: paramsWithFinalVotes,
+				VoteHistory: []*v1.VoteHistoryEntry{
+					{ProposalId: 1234, Sequence: 1, Voter: testAddrs[0].String(), Options: v1.NewNonSplitVoteOption(v1.OptionYes), Height: 10},
+					{ProposalId: 1234, Sequence: 2, Voter: testAddrs[0].String(), Options: v1.NewNonSplitVoteOption(v1.OptionNo), Height: 12},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Len(t, s.GovKeeper.GetVoteHistory(ctx, 1234), 2)
+				// the next entry follows the highest sequence
+				assert.Equal(t, uint64(3), s.GovKeeper.GetVoteHistorySequence(ctx, 1234))
+				// the vote history is pruned after the retention period
+				var proposalIDs []uint64
+				s.GovKeeper.IterateFinalVotesPruneQueue(ctx, ctx.BlockTime().Add(finalVotesRetention),
+					func(proposalID uint64, _ time.Time) bool {
+						proposalIDs = append(proposalIDs, proposalID)
+						return false
+					})
+				assert.Equal(t, []uint64{1234}, proposalIDs)
+			},
+		},
+		{
+			name: "ok: genesis with laws",
+			genesis: v1.GenesisState{
+				Params: params,
+				Laws: []*v1.Law{
+					{Id: 1, ProposalId: 1234, Title: "title", Text: "text"},
+					{Id: 3, ProposalId: 1235, Title: "title", Text: "text", Supersedes: []uint64{1}},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Len(t, s.GovKeeper.GetLaws(ctx), 2)
+				law, found := s.GovKeeper.GetLaw(ctx, 3)
+				require.True(t, found)
+				assert.Equal(t, []uint64{1}, law.Supersedes)
+				// the next law id follows the highest law id
+				assert.Equal(t, uint64(4), s.GovKeeper.GetLawID(ctx))
+			},
+		},
+		{
+			name: "ok: genesis with funding streams",
+			genesis: v1.GenesisState{
+				Params: params,
+				FundingStreams: []*v1.FundingStream{
+					{
+						Id: 2, ProposalId: 1234, Recipient: testAddrs[0].String(),
+						Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+						Interval: &fundingStreamInterval, NextPayoutTime: &fundingStreamPayoutTime, EndTime: &fundingStreamEndTime,
+					},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Len(t, s.GovKeeper.GetFundingStreams(ctx), 1)
+				// the next funding stream id follows the highest funding stream id
+				assert.Equal(t, uint64(3), s.GovKeeper.GetFundingStreamID(ctx))
+				// the funding stream is queued for its next payout
+				var streamIDs []uint64
+				s.GovKeeper.IterateFundingStreamPayoutQueue(ctx, fundingStreamPayoutTime,
+					func(stream v1.FundingStream) bool {
+						streamIDs = append(streamIDs, stream.Id)
+						return false
+					})
+				assert.Equal(t, []uint64{2}, streamIDs)
+			},
+		},
+		{
+			name: "ok: genesis with constitution history",
+			genesis: v1.GenesisState{
+				Params:       params,
+				Constitution: "Hi World",
+				ConstitutionHistory: []*v1.ConstitutionVersion{
+					{Version: 0, Constitution: "Hello World"},
+					{Version: 1, ProposalId: 1234, Height: 10, Amendment: "@@ -1 +1 @@\n-Hello World\n+Hi World", Constitution: "Hi World"},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Equal(t, "Hi World", s.GovKeeper.GetConstitution(ctx))
+				assert.Len(t, s.GovKeeper.GetConstitutionHistory(ctx), 2)
+				latest, found := s.GovKeeper.GetLatestConstitutionVersion(ctx)
+				require.True(t, found)
+				assert.Equal(t, uint64(1), latest.Version)
+				assert.Equal(t, uint64(1234), latest.ProposalId)
+			},
+		},
+		{
+			name: "ok: genesis without constitution history",
+			genesis: v1.GenesisState{
+				Params:       params,
+				Constitution: "Hello World",
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				// the genesis constitution is recorded as version 0
+				history := s.GovKeeper.GetConstitutionHistory(ctx)
+				require.Len(t, history, 1)
+				assert.Equal(t, uint64(0), history[0].Version)
+				assert.Equal(t, "Hello World", history[0].Constitution)
+			},
+		},
+		{
+			name: "ok: genesis with structured constitution",
+			genesis: v1.GenesisState{
+				Params: params,
+				StructuredConstitution: &v1.StructuredConstitution{
+					Articles: []*v1.ConstitutionArticle{{Id: "art-1", Title: "Article 1", Text: "Hello World"}},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				// the constitution is the rendering of the structured constitution
+				assert.Equal(t, "# Article 1\n\nHello World\n", s.GovKeeper.GetConstitution(ctx))
+				_, found := s.GovKeeper.GetStructuredConstitution(ctx)
+				assert.True(t, found)
+				history := s.GovKeeper.GetConstitutionHistory(ctx)
+				require.Len(t, history, 1)
+				assert.Equal(t, "# Article 1\n\nHello World\n", history[0].Constitution)
+			},
+		},
 		{
 			name: "ok: genesis with proposals and quorum check enabled",
 			genesis: v1.GenesisState{
@@ -228,6 +443,29 @@ func TestInitGenesis(t *testing.T) {
 				assertProposals(t, ctx, s, proposals)
 			},
 		},
+		{
+			name: "ok: genesis with expedited proposal and quorum check enabled",
+			genesis: v1.GenesisState{
+				Params: paramsWithQuorumCheckEnabled,
+				Proposals: []*v1.Proposal{
+					{
+						Id:              1234,
+						Status:          v1.StatusVotingPeriod,
+						DepositEndTime:  &depositEndTime,
+						VotingStartTime: &votingStartTime,
+						VotingEndTime:   &votingEndTime,
+						Expedited:       true,
+					},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				proposal, found := s.GovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				assertProposals(t, ctx, s, []*v1.Proposal{&proposal})
+				assert.False(t, testutil.HasQuorumCheck(ctx, s.GovKeeper, proposal.Id, proposal.VotingStartTime.Add(quorumTimeout)))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -256,3 +494,53 @@ func TestInitGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestInitGenesisGovernors(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	valAddr := sdk.ValAddress(addrs[0])
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	govAddr, delAddr := addrs[0], addrs[1]
+	_, err := stakingMsgSvr.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(
+		delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+	))
+	require.NoError(t, err)
+
+	genState := v1.DefaultGenesisState()
+	governor := v1.NewGovernor(govAddr, v1.NewGovernorDescription("moniker", "", "", "", ""), ctx.BlockTime())
+	genState.Governors = []*v1.Governor{&governor}
+	genState.GovernanceDelegations = []*v1.GovernanceDelegation{
+		{DelegatorAddress: govAddr.String(), GovernorAddress: govAddr.String()},
+		{DelegatorAddress: delAddr.String(), GovernorAddress: govAddr.String()},
+	}
+
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState)
+
+	// governor shares are computed from the staking delegations
+	expectedShares := sdkmath.LegacyZeroDec()
+	for _, d := range suite.StakingKeeper.GetValidatorDelegations(ctx, valAddr) {
+		expectedShares = expectedShares.Add(d.Shares)
+	}
+	valShares, found := suite.GovKeeper.GetGovernorValShares(ctx, govAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, expectedShares, valShares.Shares)
+
+	// governor shares are kept in sync through the staking hooks
+	_, err = stakingMsgSvr.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(
+		delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+	))
+	require.NoError(t, err)
+	delegation, found := suite.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	valShares, found = suite.GovKeeper.GetGovernorValShares(ctx, govAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, expectedShares.Add(delegation.Shares.QuoInt64(2)), valShares.Shares)
+
+	exported := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.Len(t, exported.Governors, 1)
+	require.Equal(t, governor.GovernorAddress, exported.Governors[0].GovernorAddress)
+	require.ElementsMatch(t, genState.GovernanceDelegations, exported.GovernanceDelegations)
+}
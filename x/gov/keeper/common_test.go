@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -19,6 +20,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/atomone-hub/atomone/x/gov/keeper"
 	govtestutil "github.com/atomone-hub/atomone/x/gov/testutil"
@@ -61,13 +63,11 @@ func getTestConstitutionAmendmentProposal() []sdk.Msg {
 
 // getTestLawProposal creates and returns a test law proposal message.
 func getTestLawProposal() []sdk.Msg {
-	proposalMsg := v1.MsgProposeLaw{
-		Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
-	}
+	proposalMsg := v1.NewMsgProposeLaw(authtypes.NewModuleAddress(types.ModuleName), "title", "text", nil)
 
 	return []sdk.Msg{
 		banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))),
-		&proposalMsg,
+		proposalMsg,
 	}
 }
 
@@ -75,6 +75,8 @@ type mocks struct {
 	acctKeeper    *govtestutil.MockAccountKeeper
 	bankKeeper    *govtestutil.MockBankKeeper
 	stakingKeeper *govtestutil.MockStakingKeeper
+	photonKeeper  *govtestutil.MockPhotonKeeper
+	distrKeeper   *govtestutil.MockDistributionKeeper
 }
 
 func mockAccountKeeperExpectations(ctx sdk.Context, m mocks) {
@@ -89,12 +91,93 @@ func mockDefaultExpectations(ctx sdk.Context, m mocks) {
 		return sdk.TokensFromConsensusPower(power, math.NewIntFromUint64(1000000))
 	}).AnyTimes()
 
-	m.stakingKeeper.EXPECT().BondDenom(ctx).Return("stake").AnyTimes()
+	m.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake").AnyTimes()
 	m.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000)).AnyTimes()
 }
 
+// mockStakingState holds a minimal staking state, made of bonded validators
+// and delegations, which backs the staking keeper mock.
+type mockStakingState struct {
+	validators  map[string]stakingtypes.Validator
+	delegations []stakingtypes.Delegation
+}
+
+func newMockStakingState() *mockStakingState {
+	return &mockStakingState{validators: make(map[string]stakingtypes.Validator)}
+}
+
+// delegate delegates amount tokens from delegator to validator, creating the
+// bonded validator if it doesn't exist yet.
+func (st *mockStakingState) delegate(delegator sdk.AccAddress, validator sdk.ValAddress, amount int64) {
+	val, found := st.validators[validator.String()]
+	if !found {
+		val = stakingtypes.Validator{
+			OperatorAddress: validator.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          math.ZeroInt(),
+			DelegatorShares: math.LegacyZeroDec(),
+		}
+	}
+	var shares math.LegacyDec
+	val, shares = val.AddTokensFromDel(math.NewInt(amount))
+	st.validators[validator.String()] = val
+	for i, d := range st.delegations {
+		if d.DelegatorAddress == delegator.String() && d.ValidatorAddress == validator.String() {
+			st.delegations[i].Shares = d.Shares.Add(shares)
+			return
+		}
+	}
+	st.delegations = append(st.delegations, stakingtypes.NewDelegation(delegator, validator, shares))
+}
+
+// mockStakingStateExpectations returns the default mock expectations, with
+// the staking keeper delegations and validators backed by st.
+func mockStakingStateExpectations(st *mockStakingState) func(sdk.Context, mocks) {
+	return func(ctx sdk.Context, m mocks) {
+		mockAccountKeeperExpectations(ctx, m)
+		trackMockBalances(m.bankKeeper)
+		m.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake").AnyTimes()
+		m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000)).AnyTimes()
+		m.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+				for i, d := range st.delegations {
+					if d.DelegatorAddress == delegator.String() && fn(int64(i), d) {
+						return
+					}
+				}
+			}).AnyTimes()
+		m.stakingKeeper.EXPECT().GetDelegation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (stakingtypes.Delegation, bool) {
+				for _, d := range st.delegations {
+					if d.DelegatorAddress == delegator.String() && d.ValidatorAddress == validator.String() {
+						return d, true
+					}
+				}
+				return stakingtypes.Delegation{}, false
+			}).AnyTimes()
+		m.stakingKeeper.EXPECT().GetValidator(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, validator sdk.ValAddress) (stakingtypes.Validator, bool) {
+				val, found := st.validators[validator.String()]
+				return val, found
+			}).AnyTimes()
+		m.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, fn func(int64, stakingtypes.ValidatorI) bool) {
+				valAddrs := make([]string, 0, len(st.validators))
+				for valAddr := range st.validators {
+					valAddrs = append(valAddrs, valAddr)
+				}
+				sort.Strings(valAddrs)
+				for i, valAddr := range valAddrs {
+					if fn(int64(i), st.validators[valAddr]) {
+						return
+					}
+				}
+			}).AnyTimes()
+	}
+}
+
 // setupGovKeeper creates a govKeeper as well as all its dependencies.
 func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 	*keeper.Keeper,
@@ -120,6 +203,8 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 		acctKeeper:    govtestutil.NewMockAccountKeeper(ctrl),
 		bankKeeper:    govtestutil.NewMockBankKeeper(ctrl),
 		stakingKeeper: govtestutil.NewMockStakingKeeper(ctrl),
+		photonKeeper:  govtestutil.NewMockPhotonKeeper(ctrl),
+		distrKeeper:   govtestutil.NewMockDistributionKeeper(ctrl),
 	}
 	if len(expectations) == 0 {
 		mockDefaultExpectations(ctx, m)
@@ -130,7 +215,7 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 	}
 
 	// Gov keeper initializations
-	govKeeper := keeper.NewKeeper(encCfg.Codec, key, m.acctKeeper, m.bankKeeper, m.stakingKeeper, msr, types.DefaultConfig(), govAcct.String())
+	govKeeper := keeper.NewKeeper(encCfg.Codec, key, m.acctKeeper, m.bankKeeper, m.stakingKeeper, m.photonKeeper, m.distrKeeper, msr, types.DefaultConfig(), govAcct.String())
 	govKeeper.SetProposalID(ctx, 1)
 
 	govRouter := v1beta1.NewRouter() // Also register legacy gov handlers to test them too.
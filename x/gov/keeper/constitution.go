@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
 func (keeper Keeper) GetConstitution(ctx sdk.Context) (constitution string) {
@@ -19,17 +24,320 @@ func (keeper Keeper) SetConstitution(ctx sdk.Context, constitution string) {
 }
 
 // ApplyConstitutionAmendment applies the amendment as a patch against the current constitution
-// and returns the updated constitution. If the amendment cannot be applied cleanly, an error is returned.
-func (k Keeper) ApplyConstitutionAmendment(ctx sdk.Context, amendment string) (updatedConstitution string, err error) {
+// and returns the updated constitution, along with the offset and fuzz used to apply each hunk.
+// If the amendment cannot be applied within the MaxAmendmentOffset and MaxAmendmentFuzz of the
+// keeper config, an error is returned.
+func (k Keeper) ApplyConstitutionAmendment(ctx sdk.Context, amendment string) (updatedConstitution string, hunkResults []types.HunkResult, err error) {
 	if amendment == "" {
-		return "", types.ErrInvalidConstitutionAmendment.Wrap("amendment cannot be empty")
+		return "", nil, types.ErrInvalidConstitutionAmendment.Wrap("amendment cannot be empty")
+	}
+	if _, found := k.GetStructuredConstitution(ctx); found {
+		return "", nil, types.ErrInvalidConstitutionAmendment.Wrap("the constitution is structured, it must be amended by article")
+	}
+
+	return k.applyConstitutionAmendment(k.GetConstitution(ctx), amendment)
+}
+
+// GetStructuredConstitution gets the structured constitution from store, if
+// the constitution is structured.
+func (keeper Keeper) GetStructuredConstitution(ctx sdk.Context) (v1.StructuredConstitution, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.KeyStructuredConstitution)
+	if bz == nil {
+		return v1.StructuredConstitution{}, false
+	}
+
+	var structured v1.StructuredConstitution
+	keeper.cdc.MustUnmarshal(bz, &structured)
+	return structured, true
+}
+
+// SetStructuredConstitution sets the structured constitution to store, along
+// with its markdown rendering as the constitution.
+func (keeper Keeper) SetStructuredConstitution(ctx sdk.Context, structured v1.StructuredConstitution) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.KeyStructuredConstitution, keeper.cdc.MustMarshal(&structured))
+	keeper.SetConstitution(ctx, structured.Render())
+}
+
+// ApplyStructuredConstitutionAmendment applies the article operations to the
+// structured constitution and returns the result. The constitution must be
+// structured already, otherwise the rendering of the amended articles would
+// silently replace the free-form constitution.
+func (keeper Keeper) ApplyStructuredConstitutionAmendment(ctx sdk.Context, operations []*v1.ArticleOperation) (v1.StructuredConstitution, error) {
+	structured, found := keeper.GetStructuredConstitution(ctx)
+	if !found {
+		return v1.StructuredConstitution{}, types.ErrInvalidConstitutionAmendment.Wrap("the constitution is not structured, it must be amended with a diff")
+	}
+	return applyArticleOperations(structured, operations)
+}
+
+// applyArticleOperations applies the article operations to the structured
+// constitution.
+func applyArticleOperations(structured v1.StructuredConstitution, operations []*v1.ArticleOperation) (v1.StructuredConstitution, error) {
+	amended, err := structured.ApplyOperations(operations)
+	if err != nil {
+		return v1.StructuredConstitution{}, types.ErrInvalidConstitutionAmendment.Wrapf("failed to apply amendment: %v", err)
 	}
+	return amended, nil
+}
+
+// articleOperations returns the article operations of the
+// MsgProposeStructuredConstitutionAmendment messages of a proposal, in
+// message order.
+func articleOperations(messages []sdk.Msg) (operations []*v1.ArticleOperation) {
+	for _, msg := range messages {
+		if msg, ok := msg.(*v1.MsgProposeStructuredConstitutionAmendment); ok {
+			operations = append(operations, msg.Operations...)
+		}
+	}
+	return
+}
+
+// constitutionAmendments returns the amendments of the
+// MsgProposeConstitutionAmendment messages of a proposal, in message order.
+func constitutionAmendments(messages []sdk.Msg) (amendments []string) {
+	for _, msg := range messages {
+		if msg, ok := msg.(*v1.MsgProposeConstitutionAmendment); ok {
+			amendments = append(amendments, msg.Amendment)
+		}
+	}
+	return
+}
 
-	currentConstitution := k.GetConstitution(ctx)
-	updatedConstitution, err = types.ApplyUnifiedDiff(currentConstitution, amendment)
+// applyConstitutionAmendment applies the amendment to the constitution, with
+// the offset and fuzz allowed by the keeper config.
+func (keeper Keeper) applyConstitutionAmendment(constitution, amendment string) (string, []types.HunkResult, error) {
+	updatedConstitution, hunkResults, err := types.ApplyUnifiedDiffWithOptions(constitution, amendment, types.PatchOptions{
+		MaxOffset: int(keeper.config.MaxAmendmentOffset),
+		MaxFuzz:   int(keeper.config.MaxAmendmentFuzz),
+	})
 	if err != nil {
-		return "", types.ErrInvalidConstitutionAmendment.Wrapf("failed to apply amendment: %v", err)
+		return "", nil, types.ErrInvalidConstitutionAmendment.Wrapf("failed to apply amendment: %v", err)
+	}
+	return updatedConstitution, hunkResults, nil
+}
+
+// applyConstitutionAmendments applies the amendments in order to the
+// constitution and returns the updated constitution.
+func (keeper Keeper) applyConstitutionAmendments(constitution string, amendments []string) (string, error) {
+	for _, amendment := range amendments {
+		var err error
+		constitution, _, err = keeper.applyConstitutionAmendment(constitution, amendment)
+		if err != nil {
+			return "", err
+		}
+	}
+	return constitution, nil
+}
+
+// ConflictingAmendmentProposals returns the ids of the proposals in deposit
+// or voting period whose constitution amendments conflict with amendments,
+// i.e. after which amendments do not apply anymore. Gas is charged for each
+// pending amendment re-applied.
+func (keeper Keeper) ConflictingAmendmentProposals(ctx sdk.Context, amendments []string) (proposalIDs []uint64) {
+	constitution := keeper.GetConstitution(ctx)
+	keeper.iteratePendingProposals(ctx, func(proposal v1.Proposal) bool {
+		messages, err := proposal.GetMsgs()
+		if err != nil {
+			return false
+		}
+		proposalAmendments := constitutionAmendments(messages)
+		if len(proposalAmendments) == 0 {
+			return false
+		}
+		consumeAmendmentGas(ctx, constitution, proposalAmendments)
+		// a pending amendment which does not apply anymore can not conflict
+		rebased, err := keeper.applyConstitutionAmendments(constitution, proposalAmendments)
+		if err != nil {
+			return false
+		}
+		consumeAmendmentGas(ctx, rebased, amendments)
+		if _, err := keeper.applyConstitutionAmendments(rebased, amendments); err != nil {
+			proposalIDs = append(proposalIDs, proposal.Id)
+		}
+		return false
+	})
+	return
+}
+
+// MarkConflictingAmendmentProposals marks the proposals in deposit or voting
+// period whose constitution amendments do not apply anymore to the current
+// constitution as conflicting with the proposal proposalID, which amended it.
+// Gas is charged for each pending amendment re-applied.
+func (keeper Keeper) MarkConflictingAmendmentProposals(ctx sdk.Context, proposalID uint64) {
+	constitution := keeper.GetConstitution(ctx)
+	var conflicting []v1.Proposal
+	keeper.iteratePendingProposals(ctx, func(proposal v1.Proposal) bool {
+		if proposal.Id == proposalID || slices.Contains(proposal.ConflictingProposalIds, proposalID) {
+			return false
+		}
+		messages, err := proposal.GetMsgs()
+		if err != nil {
+			return false
+		}
+		amendments := constitutionAmendments(messages)
+		if len(amendments) == 0 {
+			return false
+		}
+		consumeAmendmentGas(ctx, constitution, amendments)
+		if _, err := keeper.applyConstitutionAmendments(constitution, amendments); err != nil {
+			conflicting = append(conflicting, proposal)
+		}
+		return false
+	})
+
+	for _, proposal := range conflicting {
+		proposal.ConflictingProposalIds = append(proposal.ConflictingProposalIds, proposalID)
+		keeper.SetProposal(ctx, proposal)
+		emitConstitutionAmendmentConflictEvent(ctx, proposal.Id, proposalID)
+	}
+}
+
+// consumeAmendmentGas charges the gas of applying amendments to the
+// constitution, which grows with the size of both.
+func consumeAmendmentGas(ctx sdk.Context, constitution string, amendments []string) {
+	size := len(constitution)
+	for _, amendment := range amendments {
+		size += len(amendment)
 	}
+	ctx.GasMeter().ConsumeGas(
+		ctx.KVGasConfig().ReadCostPerByte*uint64(size),
+		"constitution amendment conflict check",
+	)
+}
+
+// iteratePendingProposals iterates over the proposals in deposit or voting
+// period and performs a callback function.
+func (keeper Keeper) iteratePendingProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{types.InactiveProposalQueuePrefix, types.ActiveProposalQueuePrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			proposalID := types.GetProposalIDFromBytes(iterator.Value())
+			proposal, found := keeper.GetProposal(ctx, proposalID)
+			if !found {
+				panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+			}
+
+			if cb(proposal) {
+				iterator.Close()
+				return
+			}
+		}
+		iterator.Close()
+	}
+}
+
+func emitConstitutionAmendmentConflictEvent(ctx sdk.Context, proposalID, conflictingProposalID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConstitutionAmendmentConflict,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyConflictingProposalID, fmt.Sprintf("%d", conflictingProposalID)),
+		),
+	)
+}
+
+// AmendConstitution sets the constitution resulting from the amendment of the
+// proposal proposalID, and records it as a new version of the constitution.
+func (keeper Keeper) AmendConstitution(ctx sdk.Context, proposalID uint64, amendment, constitution string) v1.ConstitutionVersion {
+	constitutionVersion := keeper.newConstitutionVersion(ctx, proposalID, constitution)
+	constitutionVersion.Amendment = amendment
+	keeper.SetConstitutionVersion(ctx, constitutionVersion)
+	keeper.SetConstitution(ctx, constitution)
+	return constitutionVersion
+}
+
+// AmendStructuredConstitution sets the structured constitution resulting from
+// the article operations of the proposal proposalID, and records its
+// rendering as a new version of the constitution.
+func (keeper Keeper) AmendStructuredConstitution(ctx sdk.Context, proposalID uint64, operations []*v1.ArticleOperation,
+	structured v1.StructuredConstitution,
+) v1.ConstitutionVersion {
+	constitutionVersion := keeper.newConstitutionVersion(ctx, proposalID, structured.Render())
+	constitutionVersion.Operations = operations
+	keeper.SetConstitutionVersion(ctx, constitutionVersion)
+	keeper.SetStructuredConstitution(ctx, structured)
+	return constitutionVersion
+}
+
+// newConstitutionVersion returns the next version of the constitution.
+func (keeper Keeper) newConstitutionVersion(ctx sdk.Context, proposalID uint64, constitution string) v1.ConstitutionVersion {
+	version := uint64(0)
+	if latest, found := keeper.GetLatestConstitutionVersion(ctx); found {
+		version = latest.Version + 1
+	}
+	return v1.ConstitutionVersion{
+		Version:      version,
+		ProposalId:   proposalID,
+		Height:       ctx.BlockHeight(),
+		Constitution: constitution,
+	}
+}
+
+// GetConstitutionVersion gets a version of the constitution from store.
+// Panics if can't unmarshal the constitution version.
+func (keeper Keeper) GetConstitutionVersion(ctx sdk.Context, version uint64) (v1.ConstitutionVersion, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.ConstitutionVersionKey(version))
+	if bz == nil {
+		return v1.ConstitutionVersion{}, false
+	}
+
+	var constitutionVersion v1.ConstitutionVersion
+	keeper.cdc.MustUnmarshal(bz, &constitutionVersion)
+	return constitutionVersion, true
+}
+
+// GetLatestConstitutionVersion gets the latest version of the constitution
+// from store, i.e. the version currently in force.
+func (keeper Keeper) GetLatestConstitutionVersion(ctx sdk.Context) (v1.ConstitutionVersion, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ConstitutionHistoryKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return v1.ConstitutionVersion{}, false
+	}
+	var constitutionVersion v1.ConstitutionVersion
+	keeper.cdc.MustUnmarshal(iterator.Value(), &constitutionVersion)
+	return constitutionVersion, true
+}
+
+// SetConstitutionVersion sets a version of the constitution to store.
+func (keeper Keeper) SetConstitutionVersion(ctx sdk.Context, constitutionVersion v1.ConstitutionVersion) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&constitutionVersion)
+	store.Set(types.ConstitutionVersionKey(constitutionVersion.Version), bz)
+}
+
+// IterateConstitutionHistory iterates over all the versions of the
+// constitution, in version order, and performs a callback function.
+func (keeper Keeper) IterateConstitutionHistory(ctx sdk.Context, cb func(constitutionVersion v1.ConstitutionVersion) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConstitutionHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var constitutionVersion v1.ConstitutionVersion
+		keeper.cdc.MustUnmarshal(iterator.Value(), &constitutionVersion)
+
+		if cb(constitutionVersion) {
+			break
+		}
+	}
+}
 
-	return updatedConstitution, nil
+// GetConstitutionHistory returns all the versions of the constitution from
+// store, in version order.
+func (keeper Keeper) GetConstitutionHistory(ctx sdk.Context) (history []*v1.ConstitutionVersion) {
+	keeper.IterateConstitutionHistory(ctx, func(constitutionVersion v1.ConstitutionVersion) bool {
+		history = append(history, &constitutionVersion)
+		return false
+	})
+	return
 }
@@ -1,9 +1,17 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
 func TestApplyConstitutionAmendment(t *testing.T) {
@@ -41,7 +49,7 @@ func TestApplyConstitutionAmendment(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			govKeeper.SetConstitution(ctx, tt.initialConstitution)
-			updatedConstitution, err := govKeeper.ApplyConstitutionAmendment(ctx, tt.amendment)
+			updatedConstitution, _, err := govKeeper.ApplyConstitutionAmendment(ctx, tt.amendment)
 			if tt.expectError {
 				require.Error(t, err)
 			} else {
@@ -51,3 +59,193 @@ func TestApplyConstitutionAmendment(t *testing.T) {
 		})
 	}
 }
+
+func TestConstitutionHistory(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+
+	_, found := govKeeper.GetLatestConstitutionVersion(ctx)
+	require.False(t, found)
+
+	// the first recorded version is version 0
+	genesisVersion := govKeeper.AmendConstitution(ctx, 0, "", "Hello World")
+	require.Equal(t, uint64(0), genesisVersion.Version)
+	require.Equal(t, "Hello World", govKeeper.GetConstitution(ctx))
+
+	ctx = ctx.WithBlockHeight(10)
+	amendment := "@@ -1 +1 @@\n-Hello World\n+Hi World"
+	constitution, _, err := govKeeper.ApplyConstitutionAmendment(ctx, amendment)
+	require.NoError(t, err)
+	amendedVersion := govKeeper.AmendConstitution(ctx, 2, amendment, constitution)
+	require.Equal(t, uint64(1), amendedVersion.Version)
+	require.Equal(t, uint64(2), amendedVersion.ProposalId)
+	require.Equal(t, int64(10), amendedVersion.Height)
+	require.Equal(t, "Hi World", govKeeper.GetConstitution(ctx))
+
+	latest, found := govKeeper.GetLatestConstitutionVersion(ctx)
+	require.True(t, found)
+	require.Equal(t, amendedVersion, latest)
+
+	// earlier versions are still retrievable
+	got, found := govKeeper.GetConstitutionVersion(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, genesisVersion, got)
+	_, found = govKeeper.GetConstitutionVersion(ctx, 2)
+	require.False(t, found)
+
+	history := govKeeper.GetConstitutionHistory(ctx)
+	require.Len(t, history, 2)
+	require.Equal(t, genesisVersion, *history[0])
+	require.Equal(t, amendedVersion, *history[1])
+}
+
+func TestStructuredConstitution(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	govKeeper.SetConstitution(ctx, "Hello World")
+
+	_, found := govKeeper.GetStructuredConstitution(ctx)
+	require.False(t, found)
+	proposer := sdk.AccAddress("proposer")
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+
+	// a free-form constitution cannot be amended by article, neither at
+	// submission nor at execution
+	operations := []*v1.ArticleOperation{{
+		Type:    v1.ArticleOperationInsert,
+		Article: &v1.ConstitutionArticle{Id: "art-2", Title: "Article 2", Text: "Hi"},
+	}}
+	_, err := govKeeper.ApplyStructuredConstitutionAmendment(ctx, operations)
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+	insertMsg := v1.NewMsgProposeStructuredConstitutionAmendment(authority, operations)
+	_, err = govKeeper.SubmitProposal(ctx, []sdk.Msg{insertMsg}, "", "amendment", "summary", proposer, false)
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+	_, err = keeper.NewMsgServerImpl(govKeeper).ProposeStructuredConstitutionAmendment(
+		sdk.WrapSDKContext(types.WithExecutedProposalID(ctx, 2)), insertMsg)
+	require.Error(t, err)
+	require.Equal(t, "Hello World", govKeeper.GetConstitution(ctx))
+
+	// once structured, the constitution is amended by article
+	govKeeper.SetStructuredConstitution(ctx, v1.StructuredConstitution{
+		Articles: []*v1.ConstitutionArticle{{Id: "art-1", Title: "Article 1", Text: "Hello World"}},
+	})
+	structured, err := govKeeper.ApplyStructuredConstitutionAmendment(ctx, operations)
+	require.NoError(t, err)
+	version := govKeeper.AmendStructuredConstitution(ctx, 2, operations, structured)
+	require.Equal(t, uint64(0), version.Version)
+	require.Equal(t, operations, version.Operations)
+
+	got, found := govKeeper.GetStructuredConstitution(ctx)
+	require.True(t, found)
+	require.Equal(t, structured, got)
+	require.Equal(t, "# Article 2\n\nHi\n\n# Article 1\n\nHello World\n", govKeeper.GetConstitution(ctx))
+
+	// the text of a structured constitution can no longer be amended with a
+	// diff, neither at submission nor at execution
+	_, _, err = govKeeper.ApplyConstitutionAmendment(ctx, "@@ -3 +3 @@\n-Hello World\n+Hi World")
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+	msg := v1.NewMsgProposeConstitutionAmendment(authority, "@@ -3 +3 @@\n-Hello World\n+Hi World")
+	_, err = govKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "amendment", "summary", proposer, false)
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+
+	// structured amendments are dry-run at submission
+	deleteMsg := v1.NewMsgProposeStructuredConstitutionAmendment(authority, []*v1.ArticleOperation{{
+		Type:      v1.ArticleOperationDelete,
+		ArticleId: "art-3",
+	}})
+	_, err = govKeeper.SubmitProposal(ctx, []sdk.Msg{deleteMsg}, "", "amendment", "summary", proposer, false)
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+	deleteMsg.Operations[0].ArticleId = "art-1"
+	_, err = govKeeper.SubmitProposal(ctx, []sdk.Msg{deleteMsg}, "", "amendment", "summary", proposer, false)
+	require.NoError(t, err)
+}
+
+func TestSubmitConstitutionAmendment(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	govKeeper.SetConstitution(ctx, "Line one\nLine two\nLine three")
+	proposer := sdk.AccAddress("proposer")
+	submit := func(amendment string) (v1.Proposal, error) {
+		msg := v1.NewMsgProposeConstitutionAmendment(authtypes.NewModuleAddress(types.ModuleName), amendment)
+		return govKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "amendment", "summary", proposer, false)
+	}
+
+	// an amendment which does not apply to the current constitution is
+	// rejected at submission
+	_, err := submit("@@ -1 +1 @@\n-Line zero\n+Line 0")
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+
+	p1, err := submit("@@ -1 +1 @@\n-Line one\n+Line 1")
+	require.NoError(t, err)
+	require.Empty(t, p1.ConflictingProposalIds)
+
+	// amendments of distinct lines do not conflict
+	p2, err := submit("@@ -3 +3 @@\n-Line three\n+Line 3")
+	require.NoError(t, err)
+	require.Empty(t, p2.ConflictingProposalIds)
+
+	// a later amendment of the same line conflicts with p1
+	p3, err := submit("@@ -1 +1 @@\n-Line one\n+Line uno")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{p1.Id}, p3.ConflictingProposalIds)
+
+	// once p3 is executed, p1 does not apply anymore and is marked as
+	// conflicting with p3, while p2 still applies
+	msgServer := keeper.NewMsgServerImpl(govKeeper)
+	_, err = msgServer.ProposeConstitutionAmendment(sdk.WrapSDKContext(types.WithExecutedProposalID(ctx, p3.Id)),
+		v1.NewMsgProposeConstitutionAmendment(authtypes.NewModuleAddress(types.ModuleName), "@@ -1 +1 @@\n-Line one\n+Line uno"))
+	require.NoError(t, err)
+	p1, _ = govKeeper.GetProposal(ctx, p1.Id)
+	require.Equal(t, []uint64{p3.Id}, p1.ConflictingProposalIds)
+	p2, _ = govKeeper.GetProposal(ctx, p2.Id)
+	require.Empty(t, p2.ConflictingProposalIds)
+}
+
+func TestConflictingAmendmentProposalsGas(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	// a long constitution makes the cost of re-applying the pending amendments
+	// stand out from the cost of reading them
+	constitution := "Line one\nLine two\nLine three\n" + strings.Repeat("Line\n", 2000)
+	govKeeper.SetConstitution(ctx, constitution)
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	minGas := ctx.KVGasConfig().ReadCostPerByte * uint64(len(constitution))
+	gasUsed := func() (conflictingGas, markGas uint64) {
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		govKeeper.ConflictingAmendmentProposals(gasCtx, []string{"@@ -2 +2 @@\n-Line two\n+Line 2"})
+		conflictingGas = gasCtx.GasMeter().GasConsumed()
+		gasCtx, _ = ctx.CacheContext()
+		gasCtx = gasCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		govKeeper.MarkConflictingAmendmentProposals(gasCtx, 100)
+		return conflictingGas, gasCtx.GasMeter().GasConsumed()
+	}
+
+	// each pending amendment re-applied is charged
+	conflictingGas, markGas := gasUsed()
+	for i := 0; i < 2; i++ {
+		msg := v1.NewMsgProposeConstitutionAmendment(authority, "@@ -1 +1 @@\n-Line one\n+Line 1")
+		_, err := govKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "amendment", "summary", sdk.AccAddress("proposer"), false)
+		require.NoError(t, err)
+
+		prevConflictingGas, prevMarkGas := conflictingGas, markGas
+		conflictingGas, markGas = gasUsed()
+		require.GreaterOrEqual(t, conflictingGas-prevConflictingGas, 2*minGas)
+		require.GreaterOrEqual(t, markGas-prevMarkGas, minGas)
+	}
+}
+
+func TestApplyConstitutionAmendmentWithOffset(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	govKeeper.SetConstitution(ctx, "Article 1\nLine one\nLine two\nArticle 2\nLine three\nLine four")
+
+	// both amendments are written against the same constitution
+	preamble := "@@ -1,2 +1,4 @@\n+Preamble\n+\n Article 1\n Line one"
+	article2 := "@@ -4,3 +4,3 @@\n Article 2\n-Line three\n+Line 3\n Line four"
+
+	constitution, hunkResults, err := govKeeper.ApplyConstitutionAmendment(ctx, preamble)
+	require.NoError(t, err)
+	require.Equal(t, []types.HunkResult{{}}, hunkResults)
+	govKeeper.SetConstitution(ctx, constitution)
+
+	// the amendment of article 2 still applies, 2 lines further
+	constitution, hunkResults, err = govKeeper.ApplyConstitutionAmendment(ctx, article2)
+	require.NoError(t, err)
+	require.Equal(t, []types.HunkResult{{Offset: 2}}, hunkResults)
+	require.Equal(t, "Preamble\n\nArticle 1\nLine one\nLine two\nArticle 2\nLine 3\nLine four", constitution)
+}
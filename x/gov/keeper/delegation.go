@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetGovernanceDelegation gets the governance delegation of a delegator
+func (keeper Keeper) GetGovernanceDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) (delegation v1.GovernanceDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernanceDelegationKey(delegatorAddr))
+	if bz == nil {
+		return delegation, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// SetGovernanceDelegation sets a governance delegation in the store, along
+// with its governor index entry.
+func (keeper Keeper) SetGovernanceDelegation(ctx sdk.Context, delegation v1.GovernanceDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	delAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+	govAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+	bz := keeper.cdc.MustMarshal(&delegation)
+	store.Set(types.GovernanceDelegationKey(delAddr), bz)
+	store.Set(types.GovernanceDelegationByGovernorKey(govAddr, delAddr), []byte{1})
+}
+
+// RemoveGovernanceDelegation removes the governance delegation of a
+// delegator, along with its governor index entry.
+func (keeper Keeper) RemoveGovernanceDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
+	delegation, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	govAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+	store.Delete(types.GovernanceDelegationKey(delegatorAddr))
+	store.Delete(types.GovernanceDelegationByGovernorKey(govAddr, delegatorAddr))
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations from the
+// store
+func (keeper Keeper) GetAllGovernanceDelegations(ctx sdk.Context) (delegations []*v1.GovernanceDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovernanceDelegationKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation v1.GovernanceDelegation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, &delegation)
+	}
+	return
+}
+
+// IterateGovernorDelegations iterates over the governance delegations of a
+// governor and performs a callback function
+func (keeper Keeper) IterateGovernorDelegations(ctx sdk.Context, governorAddr sdk.AccAddress, cb func(delegation v1.GovernanceDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.GovernanceDelegationsByGovernorKey(governorAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is prefix | governorAddrLen | governorAddr | delegatorAddrLen | delegatorAddr
+		delAddr := sdk.AccAddress(iterator.Key()[len(prefix)+1:])
+		delegation, found := keeper.GetGovernanceDelegation(ctx, delAddr)
+		if !found {
+			panic(fmt.Sprintf("governance delegation of %s does not exist", delAddr))
+		}
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetGovernorValShares gets the validator shares delegated to a governor
+func (keeper Keeper) GetGovernorValShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (shares v1.GovernorValShares, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernorValSharesKey(governorAddr, validatorAddr))
+	if bz == nil {
+		return shares, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &shares)
+	return shares, true
+}
+
+// SetGovernorValShares sets the validator shares delegated to a governor
+func (keeper Keeper) SetGovernorValShares(ctx sdk.Context, shares v1.GovernorValShares) {
+	store := ctx.KVStore(keeper.storeKey)
+	govAddr := sdk.MustAccAddressFromBech32(shares.GovernorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(shares.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	bz := keeper.cdc.MustMarshal(&shares)
+	store.Set(types.GovernorValSharesKey(govAddr, valAddr), bz)
+}
+
+// RemoveGovernorValShares removes the validator shares delegated to a governor
+func (keeper Keeper) RemoveGovernorValShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GovernorValSharesKey(governorAddr, validatorAddr))
+}
+
+// GetAllGovernorValShares returns all the validator shares delegated to a
+// governor
+func (keeper Keeper) GetAllGovernorValShares(ctx sdk.Context, governorAddr sdk.AccAddress) (shares []v1.GovernorValShares) {
+	keeper.IterateGovernorValShares(ctx, governorAddr, func(s v1.GovernorValShares) bool {
+		shares = append(shares, s)
+		return false
+	})
+	return
+}
+
+// IterateGovernorValShares iterates over the validator shares delegated to a
+// governor and performs a callback function
+func (keeper Keeper) IterateGovernorValShares(ctx sdk.Context, governorAddr sdk.AccAddress, cb func(shares v1.GovernorValShares) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovernorValSharesByGovernorKey(governorAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var shares v1.GovernorValShares
+		keeper.cdc.MustUnmarshal(iterator.Value(), &shares)
+
+		if cb(shares) {
+			break
+		}
+	}
+}
+
+// IncreaseGovernorShares increases the validator shares delegated to a
+// governor
+func (keeper Keeper) IncreaseGovernorShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+	valShares, found := keeper.GetGovernorValShares(ctx, governorAddr, validatorAddr)
+	if found {
+		valShares.Shares = valShares.Shares.Add(shares)
+	} else {
+		valShares = v1.NewGovernorValShares(governorAddr, validatorAddr, shares)
+	}
+	keeper.SetGovernorValShares(ctx, valShares)
+}
+
+// DecreaseGovernorShares decreases the validator shares delegated to a
+// governor, and removes the entry if no shares are left.
+func (keeper Keeper) DecreaseGovernorShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+	valShares, found := keeper.GetGovernorValShares(ctx, governorAddr, validatorAddr)
+	if !found {
+		panic(fmt.Sprintf("governor %s has no shares of validator %s", governorAddr, validatorAddr))
+	}
+	valShares.Shares = valShares.Shares.Sub(shares)
+	if valShares.Shares.IsNegative() {
+		panic(fmt.Sprintf("governor %s shares of validator %s should never be negative", governorAddr, validatorAddr))
+	}
+	if valShares.Shares.IsZero() {
+		keeper.RemoveGovernorValShares(ctx, governorAddr, validatorAddr)
+		return
+	}
+	keeper.SetGovernorValShares(ctx, valShares)
+}
+
+// DelegateToGovernor delegates the governance voting power of a delegator to
+// a governor, adding all the delegator's validator shares to the governor's.
+// If the delegator voted on proposals in voting period, its shares are
+// deducted from the governor's on these proposals.
+func (keeper Keeper) DelegateToGovernor(ctx sdk.Context, delegatorAddr, governorAddr sdk.AccAddress) {
+	keeper.SetGovernanceDelegation(ctx, v1.NewGovernanceDelegation(delegatorAddr, governorAddr))
+	keeper.updateVoterGovernorDeductions(ctx, delegatorAddr, governorAddr, true)
+	keeper.sk.IterateDelegations(ctx, delegatorAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		keeper.IncreaseGovernorShares(ctx, governorAddr, delegation.GetValidatorAddr(), delegation.GetShares())
+		return false
+	})
+}
+
+// UndelegateFromGovernor undelegates the governance voting power of a
+// delegator from its governor, removing all the delegator's validator shares
+// from the governor's.
+func (keeper Keeper) UndelegateFromGovernor(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
+	delegation, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return
+	}
+	governorAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+	keeper.sk.IterateDelegations(ctx, delegatorAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		keeper.DecreaseGovernorShares(ctx, governorAddr, delegation.GetValidatorAddr(), delegation.GetShares())
+		return false
+	})
+	keeper.updateVoterGovernorDeductions(ctx, delegatorAddr, governorAddr, false)
+	keeper.RemoveGovernanceDelegation(ctx, delegatorAddr)
+}
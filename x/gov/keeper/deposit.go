@@ -11,6 +11,7 @@ import (
 
 	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
 )
 
 // GetDeposit gets the deposit of a specific depositor on a specific proposal
@@ -121,13 +122,22 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	if (proposal.Status != v1.StatusDepositPeriod) && (proposal.Status != v1.StatusVotingPeriod) {
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
+	// the proposal may still be in deposit period after its deposit end time
+	// if its deletion was deferred to a later block by the EndBlocker
+	if proposal.Status == v1.StatusDepositPeriod && proposal.DepositEndTime != nil &&
+		ctx.BlockTime().After(*proposal.DepositEndTime) {
+		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "deposit period of proposal %d has already ended", proposalID)
+	}
+
+	if err := keeper.validateDepositDenoms(ctx, depositAmount); err != nil {
+		return false, err
+	}
 
 	// Check coins to be deposited match the proposal's deposit params
 	params := keeper.GetParams(ctx)
 
 	// NOTE: backported from v50
-	// v47 does not have expedited proposals so we always use params.MinDeposit
-	minDepositAmount := params.MinDeposit
+	minDepositAmount := keeper.GetProposalMinDeposit(ctx, proposal)
 	minDepositRatio, err := sdk.NewDecFromStr(params.GetMinDepositRatio())
 	if err != nil {
 		return false, err
@@ -136,6 +146,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// If minDepositRatio is set, the deposit must be equal or greater than minDepositAmount*minDepositRatio
 	// for at least one denom. If minDepositRatio is zero we skip this check.
 	if !minDepositRatio.IsZero() {
+		depositValue := keeper.GetDepositValue(ctx, depositAmount)
 		var (
 			depositThresholdMet bool
 			thresholds          []string
@@ -145,7 +156,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 			threshold := sdk.NewCoin(minDep.GetDenom(), minDep.Amount.ToLegacyDec().Mul(minDepositRatio).TruncateInt())
 			thresholds = append(thresholds, threshold.String())
 
-			found, deposit := depositAmount.Find(minDep.Denom)
+			found, deposit := depositValue.Find(minDep.Denom)
 			if !found { // if not found, continue, as we know the deposit contains at least 1 valid denom
 				continue
 			}
@@ -177,7 +188,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).MinDeposit) {
+	// Photons deposited are valued in bond denom for this check.
+	if proposal.Status == v1.StatusDepositPeriod && keeper.GetDepositValue(ctx, proposal.TotalDeposit).IsAllGTE(minDepositAmount) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -225,28 +237,103 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// ChargeAndDeleteDeposits burns the given ratio of all the deposits on a
+// specific proposal, refunds the remaining deposits and deletes them. It
+// returns the amount burned.
+func (keeper Keeper) ChargeAndDeleteDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) (sdk.Coins, error) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var (
+		deposits []v1.Deposit
+		burned   sdk.Coins
+	)
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	for _, deposit := range deposits {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		var refund sdk.Coins
+		for _, coin := range deposit.Amount {
+			burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(burnRatio).TruncateInt()
+			burned = burned.Add(sdk.NewCoin(coin.Denom, burnAmount))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(burnAmount)))
+		}
+
+		if !refund.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if !burned.IsZero() {
+		if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return nil, err
+		}
+	}
+
+	return burned, nil
+}
+
+// GetDepositValue returns the value of a deposit amount, where photons are
+// replaced by their bond denom equivalent using the current x/photon
+// conversion rate. Coins of any other denom are kept as is.
+//
+// Photons have no value if the conversion rate is zero or if no photon keeper
+// was provided.
+func (keeper Keeper) GetDepositValue(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
+	value := sdk.NewCoins(amount...)
+	found, photons := value.Find(photontypes.Denom)
+	if !found {
+		return value
+	}
+	value = value.Sub(photons)
+	if keeper.pk == nil {
+		return value
+	}
+
+	// the conversion rate is the amount of photons minted for one bond denom
+	conversionRate := keeper.pk.GetConversionRate(ctx)
+	if !conversionRate.IsPositive() {
+		return value
+	}
+	bondDenomValue := photons.Amount.ToLegacyDec().Quo(conversionRate).TruncateInt()
+	return value.Add(sdk.NewCoin(keeper.sk.BondDenom(ctx), bondDenomValue))
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
+// the dynamic min initial deposit. Returns nil on success, error otherwise.
 func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return sdkerrors.Wrapf(sdkerrors1.ErrInvalidCoins, initialDeposit.String())
 	}
 
-	params := keeper.GetParams(ctx)
-	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
-	if err != nil {
+	if err := keeper.validateDepositDenoms(ctx, initialDeposit); err != nil {
 		return err
 	}
-	if minInitialDepositRatio.IsZero() {
-		return nil
-	}
-	minDepositCoins := params.MinDeposit
-	for i := range minDepositCoins {
-		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
+
+	minInitialDeposit := keeper.GetMinInitialDeposit(ctx)
+	if !keeper.GetDepositValue(ctx, initialDeposit).IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minInitialDeposit)
 	}
-	if !initialDeposit.IsAllGTE(minDepositCoins) {
-		return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
+	return nil
+}
+
+// validateDepositDenoms checks that a deposit amount only contains the bond
+// denom and photons, the only denoms a deposit can be valued in.
+func (keeper Keeper) validateDepositDenoms(ctx sdk.Context, amount sdk.Coins) error {
+	bondDenom := keeper.sk.BondDenom(ctx)
+	for _, coin := range amount {
+		if coin.Denom != bondDenom && coin.Denom != photontypes.Denom {
+			return sdkerrors.Wrapf(types.ErrInvalidDepositDenom, "received %s but only %s and %s are accepted", amount, bondDenom, photontypes.Denom)
+		}
 	}
 	return nil
 }
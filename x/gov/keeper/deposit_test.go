@@ -7,8 +7,11 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
 )
 
 const (
@@ -23,7 +26,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -107,7 +110,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -120,86 +123,77 @@ func TestDeposits(t *testing.T) {
 
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
-		minDeposit               sdk.Coins
-		minInitialDepositPercent int64
-		initialDeposit           sdk.Coins
+		minInitialDeposit sdk.Coins
+		initialDeposit    sdk.Coins
 
 		expectError bool
+		expectedErr error
 	}{
-		"min deposit * initial percent == initial deposit: success": {
-			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositPercent: baseDepositTestPercent,
-			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+		"min initial deposit == initial deposit: success": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
 		},
-		"min deposit * initial percent < initial deposit: success": {
-			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositPercent: baseDepositTestPercent,
-			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100+1))),
+		"min initial deposit < initial deposit: success": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100+1))),
 		},
-		"min deposit * initial percent > initial deposit: error": {
-			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositPercent: baseDepositTestPercent,
-			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1))),
+		"min initial deposit > initial deposit: error": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1))),
 
 			expectError: true,
 		},
-		"min deposit * initial percent == initial deposit (non-base values and denom): success": {
-			minDeposit:               sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(56912))),
-			minInitialDepositPercent: 50,
-			initialDeposit:           sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(56912/2+10))),
+		"initial deposit of an invalid denom: error": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(56912/2))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(56912/2+10))),
+
+			expectError: true,
+			expectedErr: types.ErrInvalidDepositDenom,
 		},
-		"min deposit * initial percent == initial deposit but different denoms: error": {
-			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositPercent: baseDepositTestPercent,
-			initialDeposit:           sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+		"min initial deposit == initial deposit but different denoms: error": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
 
 			expectError: true,
+			expectedErr: types.ErrInvalidDepositDenom,
 		},
-		"min deposit * initial percent == initial deposit (multiple coins): success": {
-			minDeposit: sdk.NewCoins(
-				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount)),
-				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*2))),
-			minInitialDepositPercent: baseDepositTestPercent,
+		"min initial deposit == initial deposit (bond denom and photons): success": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
 			initialDeposit: sdk.NewCoins(
-				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100)),
-				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100)),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-10)),
+				sdk.NewCoin(photontypes.Denom, sdk.NewInt(100)),
 			),
 		},
-		"min deposit * initial percent > initial deposit (multiple coins): error": {
-			minDeposit: sdk.NewCoins(
-				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount)),
-				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*2))),
-			minInitialDepositPercent: baseDepositTestPercent,
+		"min initial deposit > initial deposit (bond denom and photons): error": {
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
 			initialDeposit: sdk.NewCoins(
-				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100)),
-				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100-1)),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-10)),
+				sdk.NewCoin(photontypes.Denom, sdk.NewInt(99)),
 			),
 
 			expectError: true,
 		},
-		"min deposit * initial percent < initial deposit (multiple coins - coin not required by min deposit): success": {
-			minDeposit: sdk.NewCoins(
-				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositPercent: baseDepositTestPercent,
+		"min initial deposit < initial deposit (coin not accepted as deposit): error": {
+			minInitialDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
 			initialDeposit: sdk.NewCoins(
 				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100)),
 				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
 			),
-		},
-		"0 initial percent: success": {
-			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositPercent: 0,
-			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+
+			expectError: true,
+			expectedErr: types.ErrInvalidDepositDenom,
 		},
 	}
 
 	for name, tc := range testcases {
 		t.Run(name, func(t *testing.T) {
-			govKeeper, _, _, ctx := setupGovKeeper(t)
+			govKeeper, mocks, _, ctx := setupGovKeeper(t)
+			// 10uphoton are worth 1stake
+			mocks.photonKeeper.EXPECT().GetConversionRate(ctx).Return(sdk.NewDec(10)).AnyTimes()
 
 			params := v1.DefaultParams()
-			params.MinDeposit = tc.minDeposit
-			params.MinInitialDepositRatio = sdk.NewDec(tc.minInitialDepositPercent).Quo(sdk.NewDec(100)).String()
+			params.MinInitialDepositThrottler.FloorValue = tc.minInitialDeposit
 
 			govKeeper.SetParams(ctx, params)
 
@@ -207,6 +201,9 @@ func TestValidateInitialDeposit(t *testing.T) {
 
 			if tc.expectError {
 				require.Error(t, err)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+				}
 				return
 			}
 			require.NoError(t, err)
@@ -227,8 +224,8 @@ func TestDepositAmount(t *testing.T) {
 			minDepositRatio: "0.001",
 		},
 		{
-			name:            "good amount and denoms but not enough balance for zcoin",
-			deposit:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10000), sdk.NewInt64Coin("zcoin", 1)),
+			name:            "good amount and denoms but not enough balance for photons",
+			deposit:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10000), sdk.NewInt64Coin(photontypes.Denom, 1)),
 			minDepositRatio: "0.001",
 			err:             "not enough balance",
 		},
@@ -239,22 +236,22 @@ func TestDepositAmount(t *testing.T) {
 			err:             "received 10stake but need at least one of the following: 10000stake,10zcoin: minimum deposit is too small",
 		},
 		{
-			name:            "too small amount with another coin",
-			deposit:         sdk.NewCoins(sdk.NewInt64Coin("zcoin", 1)),
+			name:            "too small amount with photons",
+			deposit:         sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 10)),
 			minDepositRatio: "0.001",
-			err:             "received 1zcoin but need at least one of the following: 10000stake,10zcoin: minimum deposit is too small",
+			err:             "received 10uphoton but need at least one of the following: 10000stake,10zcoin: minimum deposit is too small",
 		},
 		{
 			name:            "bad denom",
 			deposit:         sdk.NewCoins(sdk.NewInt64Coin("euro", 10000)),
 			minDepositRatio: "0.001",
-			err:             "received 10000euro but need at least one of the following: 10000stake,10zcoin: minimum deposit is too small",
+			err:             "received 10000euro but only stake and uphoton are accepted: invalid deposit denom",
 		},
 		{
 			name:            "mix containing bad and good denom",
 			deposit:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10000), sdk.NewInt64Coin("euro", 10000)),
 			minDepositRatio: "0.001",
-			err:             "not enough balance",
+			err:             "received 10000euro,10000stake but only stake and uphoton are accepted: invalid deposit denom",
 		},
 		{
 			name:            "minDepositRatio is zero",
@@ -268,17 +265,19 @@ func TestDepositAmount(t *testing.T) {
 			govKeeper, mocks, _, ctx := setupGovKeeper(t)
 			bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
 			trackMockBalances(bankKeeper)
+			// 10uphoton are worth 1stake
+			mocks.photonKeeper.EXPECT().GetConversionRate(ctx).Return(sdk.NewDec(10)).AnyTimes()
 
 			testAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000000000000000))
 
 			params := v1.DefaultParams()
 			params.MinDepositRatio = tc.minDepositRatio
-			params.MinDeposit = sdk.NewCoins(params.MinDeposit...).Add(sdk.NewCoin("zcoin", sdk.NewInt(10000))) // coins must be sorted by denom
+			params.MinDepositThrottler.FloorValue = sdk.NewCoins(params.MinDepositThrottler.FloorValue...).Add(sdk.NewCoin("zcoin", sdk.NewInt(10000))) // coins must be sorted by denom
 			err := govKeeper.SetParams(ctx, params)
 			require.NoError(t, err)
 
 			tp := TestProposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", testAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", testAddrs[0], false)
 			require.NoError(t, err)
 			proposalID := proposal.Id
 
@@ -292,3 +291,107 @@ func TestDepositAmount(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDepositValue(t *testing.T) {
+	testcases := []struct {
+		name           string
+		amount         sdk.Coins
+		conversionRate sdk.Dec
+		expectedValue  sdk.Coins
+	}{
+		{
+			name:          "no photons",
+			amount:        sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("zcoin", 10)),
+			expectedValue: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("zcoin", 10)),
+		},
+		{
+			name:           "photons only",
+			amount:         sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 1000)),
+			conversionRate: sdk.NewDec(10),
+			expectedValue:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			name:           "photons and bond denom",
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin(photontypes.Denom, 1000)),
+			conversionRate: sdk.NewDec(10),
+			expectedValue:  sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+		},
+		{
+			name:           "photons value is truncated",
+			amount:         sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 1009), sdk.NewInt64Coin("zcoin", 10)),
+			conversionRate: sdk.NewDec(10),
+			expectedValue:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("zcoin", 10)),
+		},
+		{
+			name:           "zero conversion rate",
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin(photontypes.Denom, 1000)),
+			conversionRate: sdk.ZeroDec(),
+			expectedValue:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t)
+			mocks.photonKeeper.EXPECT().GetConversionRate(ctx).Return(tc.conversionRate).AnyTimes()
+
+			value := govKeeper.GetDepositValue(ctx, tc.amount)
+
+			require.Equal(t, tc.expectedValue, value)
+		})
+	}
+}
+
+func TestDepositsWithPhotons(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	// 10uphoton are worth 1stake
+	mocks.photonKeeper.EXPECT().GetConversionRate(ctx).Return(sdk.NewDec(10)).AnyTimes()
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
+	photons := sdk.NewCoins(sdk.NewCoin(photontypes.Denom, v1.DefaultMinDepositTokens.MulRaw(10)))
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, TestAddrs[0], photons))
+
+	tp := TestProposal
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.Id
+
+	halfPhotons := sdk.NewCoins(sdk.NewCoin(photontypes.Denom, v1.DefaultMinDepositTokens.MulRaw(5)))
+	halfStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.QuoRaw(2)))
+	addr0Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+	addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+
+	// Half of the min deposit paid in photons doesn't activate the proposal
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], halfPhotons)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	// The other half paid in bond denom activates it
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], halfStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+	proposal, ok := govKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, halfPhotons.Add(halfStake...), sdk.NewCoins(proposal.TotalDeposit...))
+
+	// Refunds return the deposits in their original denoms
+	govKeeper.RefundAndDeleteDeposits(ctx, proposalID)
+	require.Equal(t, addr0Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+
+	// Charges burn the deposits in their original denoms
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], false)
+	require.NoError(t, err)
+	proposalID = proposal.Id
+	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], halfPhotons.Add(halfStake...))
+	require.NoError(t, err)
+	burned, err := govKeeper.ChargeAndDeleteDeposits(ctx, proposalID, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	expectedBurned := sdk.NewCoins(
+		sdk.NewCoin(photontypes.Denom, v1.DefaultMinDepositTokens.QuoRaw(2)),
+		sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.QuoRaw(20)),
+	)
+	require.Equal(t, expectedBurned, burned)
+	require.Equal(t, addr0Initial.Sub(expectedBurned...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+}
@@ -1,9 +1,24 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDynamicDepositAmount is the bound of the amount of each denom of the
+// dynamic deposits, exported for tests.
+var MaxDynamicDepositAmount = maxDynamicDepositAmount
 
 // ValidateInitialDeposit is a helper function used only in deposit tests which returns the same
 // functionality of validateInitialDeposit private function.
 func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
 	return k.validateInitialDeposit(ctx, initialDeposit)
 }
+
+// GetVotingPower is a helper function used only in voting power tests which
+// returns the voting power of a proposal read from its voting power
+// accumulator.
+func (k Keeper) GetVotingPower(ctx sdk.Context, proposalID uint64) math.LegacyDec {
+	return k.getVotingPower(ctx, proposalID)
+}
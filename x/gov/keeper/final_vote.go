@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetFinalVote gets the final vote from an address on a specific proposal
+func (keeper Keeper) GetFinalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote v1.FinalVote, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.FinalVoteKey(proposalID, voterAddr))
+	if bz == nil {
+		return vote, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &vote)
+
+	return vote, true
+}
+
+// SetFinalVote sets a FinalVote to the gov store
+func (keeper Keeper) SetFinalVote(ctx sdk.Context, vote v1.FinalVote) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&vote)
+	addr := sdk.MustAccAddressFromBech32(vote.Voter)
+
+	store.Set(types.FinalVoteKey(vote.ProposalId, addr), bz)
+}
+
+// GetFinalVotes returns all the final votes of a proposal
+func (keeper Keeper) GetFinalVotes(ctx sdk.Context, proposalID uint64) (votes []*v1.FinalVote) {
+	keeper.IterateFinalVotes(ctx, proposalID, func(vote v1.FinalVote) bool {
+		votes = append(votes, &vote)
+		return false
+	})
+	return
+}
+
+// GetAllFinalVotes returns all the final votes from the store
+func (keeper Keeper) GetAllFinalVotes(ctx sdk.Context) (votes []*v1.FinalVote) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FinalVotesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote v1.FinalVote
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, &vote)
+	}
+	return
+}
+
+// IterateFinalVotes iterates over the final votes of a proposal and performs
+// a callback function
+func (keeper Keeper) IterateFinalVotes(ctx sdk.Context, proposalID uint64, cb func(vote v1.FinalVote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FinalVotesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote v1.FinalVote
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// DeleteFinalVotes deletes all the final votes of a proposal
+func (keeper Keeper) DeleteFinalVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FinalVotesKey(proposalID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// InsertFinalVotesPruneQueue inserts a proposalID into the final votes prune
+// queue at pruneTime
+func (keeper Keeper) InsertFinalVotesPruneQueue(ctx sdk.Context, proposalID uint64, pruneTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.FinalVotesPruneQueueKey(proposalID, pruneTime), bz)
+}
+
+// RemoveFromFinalVotesPruneQueue removes a proposalID from the final votes
+// prune queue
+func (keeper Keeper) RemoveFromFinalVotesPruneQueue(ctx sdk.Context, proposalID uint64, pruneTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.FinalVotesPruneQueueKey(proposalID, pruneTime))
+}
+
+// IterateFinalVotesPruneQueue iterates over the proposal ids in the final
+// votes prune queue that are due by pruneTime and performs a callback function
+func (keeper Keeper) IterateFinalVotesPruneQueue(ctx sdk.Context, pruneTime time.Time, cb func(proposalID uint64, pruneTime time.Time) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.FinalVotesPruneQueuePrefix, sdk.PrefixEndBytes(types.FinalVotesPruneQueueByTimeKey(pruneTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, t := types.SplitFinalVotesPruneQueueKey(iterator.Key())
+		if cb(proposalID, t) {
+			break
+		}
+	}
+}
+
+// PruneFinalVotes deletes the final votes and the vote history of the
+// proposals whose retention period has expired.
+func (keeper Keeper) PruneFinalVotes(ctx sdk.Context) {
+	type entry struct {
+		proposalID uint64
+		pruneTime  time.Time
+	}
+	var expired []entry
+	keeper.IterateFinalVotesPruneQueue(ctx, ctx.BlockTime(), func(proposalID uint64, pruneTime time.Time) bool {
+		expired = append(expired, entry{proposalID, pruneTime})
+		return false
+	})
+
+	for _, e := range expired {
+		keeper.DeleteFinalVotes(ctx, e.proposalID)
+		keeper.DeleteVoteHistory(ctx, e.proposalID)
+		keeper.RemoveFromFinalVotesPruneQueue(ctx, e.proposalID, e.pruneTime)
+	}
+}
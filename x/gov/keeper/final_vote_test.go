@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestFinalVotes(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.CreateRandomAccounts(2)
+
+	_, found := govKeeper.GetFinalVote(ctx, 1, addrs[0])
+	require.False(t, found)
+
+	finalVote := v1.NewFinalVote(1, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), sdk.NewDec(10))
+	govKeeper.SetFinalVote(ctx, finalVote)
+	govKeeper.SetFinalVote(ctx, v1.NewFinalVote(1, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), sdk.NewDec(5)))
+	govKeeper.SetFinalVote(ctx, v1.NewFinalVote(2, addrs[0], v1.NewNonSplitVoteOption(v1.OptionAbstain), sdk.NewDec(10)))
+	got, found := govKeeper.GetFinalVote(ctx, 1, addrs[0])
+	require.True(t, found)
+	assert.Equal(t, finalVote, got)
+	assert.Len(t, govKeeper.GetFinalVotes(ctx, 1), 2)
+	assert.Len(t, govKeeper.GetAllFinalVotes(ctx), 3)
+
+	// proposals are pruned in the order of their prune time
+	now := ctx.BlockTime()
+	govKeeper.InsertFinalVotesPruneQueue(ctx, 1, now.Add(time.Hour))
+	govKeeper.InsertFinalVotesPruneQueue(ctx, 2, now.Add(2*time.Hour))
+
+	govKeeper.PruneFinalVotes(ctx)
+	assert.Len(t, govKeeper.GetAllFinalVotes(ctx), 3)
+
+	govKeeper.PruneFinalVotes(ctx.WithBlockTime(now.Add(time.Hour)))
+	assert.Empty(t, govKeeper.GetFinalVotes(ctx, 1))
+	assert.Len(t, govKeeper.GetFinalVotes(ctx, 2), 1)
+
+	govKeeper.PruneFinalVotes(ctx.WithBlockTime(now.Add(2 * time.Hour)))
+	assert.Empty(t, govKeeper.GetAllFinalVotes(ctx))
+	govKeeper.IterateFinalVotesPruneQueue(ctx, now.Add(3*time.Hour), func(proposalID uint64, _ time.Time) bool {
+		t.Fatalf("unexpected prune queue entry for proposal %d", proposalID)
+		return false
+	})
+}
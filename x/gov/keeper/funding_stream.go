@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// CreateFundingStream stores a new funding stream created by the proposal
+// proposalID and returns it. The first payout happens one interval after the
+// current block time, and must not be after endTime.
+func (keeper Keeper) CreateFundingStream(ctx sdk.Context, proposalID uint64, recipient sdk.AccAddress,
+	amount sdk.Coins, interval time.Duration, endTime time.Time,
+) (v1.FundingStream, error) {
+	nextPayoutTime := ctx.BlockTime().Add(interval)
+	if nextPayoutTime.After(endTime) {
+		return v1.FundingStream{}, sdkerrors.Wrapf(types.ErrInvalidFundingStream,
+			"end time %s is before the first payout time %s", endTime, nextPayoutTime)
+	}
+
+	streamID := keeper.GetFundingStreamID(ctx)
+	stream := v1.FundingStream{
+		Id:             streamID,
+		ProposalId:     proposalID,
+		Recipient:      recipient.String(),
+		Amount:         amount,
+		Interval:       &interval,
+		NextPayoutTime: &nextPayoutTime,
+		EndTime:        &endTime,
+	}
+	keeper.SetFundingStream(ctx, stream)
+	keeper.InsertFundingStreamPayoutQueue(ctx, streamID, nextPayoutTime)
+	keeper.SetFundingStreamID(ctx, streamID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateFundingStream,
+			sdk.NewAttribute(types.AttributeKeyFundingStreamID, fmt.Sprintf("%d", streamID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return stream, nil
+}
+
+// CancelFundingStream deletes a funding stream, no more payouts are made.
+func (keeper Keeper) CancelFundingStream(ctx sdk.Context, streamID uint64) error {
+	stream, found := keeper.GetFundingStream(ctx, streamID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFundingStream, "%d", streamID)
+	}
+	keeper.DeleteFundingStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelFundingStream,
+			sdk.NewAttribute(types.AttributeKeyFundingStreamID, fmt.Sprintf("%d", streamID)),
+		),
+	)
+
+	return nil
+}
+
+// PayFundingStreams pays the funding streams whose next payout is due by the
+// current block time from the community pool. Each stream is paid at most
+// once per block, and is deleted once its next payout would be after its end
+// time. A payout that fails, e.g. because the community pool is short of
+// funds, is skipped.
+func (keeper Keeper) PayFundingStreams(ctx sdk.Context) {
+	var due []v1.FundingStream
+	keeper.IterateFundingStreamPayoutQueue(ctx, ctx.BlockTime(), func(stream v1.FundingStream) bool {
+		due = append(due, stream)
+		return false
+	})
+
+	logger := keeper.Logger(ctx)
+	for _, stream := range due {
+		keeper.RemoveFromFundingStreamPayoutQueue(ctx, stream.Id, *stream.NextPayoutTime)
+
+		recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+		amount := sdk.NewCoins(stream.Amount...)
+
+		// use a cached context to avoid partial payouts
+		cacheCtx, writeCache := ctx.CacheContext()
+		result := types.AttributeValuePayoutSucceeded
+		if err := keeper.dk.DistributeFromFeePool(cacheCtx, amount, recipient); err != nil {
+			result = types.AttributeValuePayoutFailed
+			logger.Info(
+				"funding stream payout failed",
+				"funding_stream", stream.Id,
+				"recipient", stream.Recipient,
+				"amount", amount.String(),
+				"err", err,
+			)
+		} else {
+			writeCache()
+			stream.TotalPaid = sdk.NewCoins(stream.TotalPaid...).Add(amount...)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundingStreamPayout,
+				sdk.NewAttribute(types.AttributeKeyFundingStreamID, fmt.Sprintf("%d", stream.Id)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyPayoutResult, result),
+			),
+		)
+
+		nextPayoutTime := stream.NextPayoutTime.Add(*stream.Interval)
+		if nextPayoutTime.After(*stream.EndTime) {
+			keeper.DeleteFundingStream(ctx, stream)
+			continue
+		}
+		stream.NextPayoutTime = &nextPayoutTime
+		keeper.SetFundingStream(ctx, stream)
+		keeper.InsertFundingStreamPayoutQueue(ctx, stream.Id, nextPayoutTime)
+	}
+}
+
+// GetFundingStream gets a funding stream from store by streamID.
+// Panics if can't unmarshal the funding stream.
+func (keeper Keeper) GetFundingStream(ctx sdk.Context, streamID uint64) (v1.FundingStream, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.FundingStreamKey(streamID))
+	if bz == nil {
+		return v1.FundingStream{}, false
+	}
+
+	var stream v1.FundingStream
+	keeper.cdc.MustUnmarshal(bz, &stream)
+	return stream, true
+}
+
+// SetFundingStream sets a funding stream to store.
+func (keeper Keeper) SetFundingStream(ctx sdk.Context, stream v1.FundingStream) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&stream)
+	store.Set(types.FundingStreamKey(stream.Id), bz)
+}
+
+// DeleteFundingStream deletes a funding stream from store, along with its
+// entry in the payout queue.
+func (keeper Keeper) DeleteFundingStream(ctx sdk.Context, stream v1.FundingStream) {
+	store := ctx.KVStore(keeper.storeKey)
+	keeper.RemoveFromFundingStreamPayoutQueue(ctx, stream.Id, *stream.NextPayoutTime)
+	store.Delete(types.FundingStreamKey(stream.Id))
+}
+
+// IterateFundingStreams iterates over all the funding streams and performs a
+// callback function.
+func (keeper Keeper) IterateFundingStreams(ctx sdk.Context, cb func(stream v1.FundingStream) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FundingStreamsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream v1.FundingStream
+		keeper.cdc.MustUnmarshal(iterator.Value(), &stream)
+
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// GetFundingStreams returns all the funding streams from store
+func (keeper Keeper) GetFundingStreams(ctx sdk.Context) (streams v1.FundingStreams) {
+	keeper.IterateFundingStreams(ctx, func(stream v1.FundingStream) bool {
+		streams = append(streams, &stream)
+		return false
+	})
+	return
+}
+
+// GetFundingStreamID gets the ID of the next funding stream, funding streams
+// IDs start at 1.
+func (keeper Keeper) GetFundingStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.FundingStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetFundingStreamID sets the ID of the next funding stream to the store
+func (keeper Keeper) SetFundingStreamID(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.FundingStreamIDKey, sdk.Uint64ToBigEndian(streamID))
+}
+
+// InsertFundingStreamPayoutQueue inserts a streamID into the funding stream
+// payout queue at payoutTime
+func (keeper Keeper) InsertFundingStreamPayoutQueue(ctx sdk.Context, streamID uint64, payoutTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.FundingStreamPayoutQueueKey(streamID, payoutTime), sdk.Uint64ToBigEndian(streamID))
+}
+
+// RemoveFromFundingStreamPayoutQueue removes a streamID from the funding
+// stream payout queue
+func (keeper Keeper) RemoveFromFundingStreamPayoutQueue(ctx sdk.Context, streamID uint64, payoutTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.FundingStreamPayoutQueueKey(streamID, payoutTime))
+}
+
+// IterateFundingStreamPayoutQueue iterates over the funding streams whose
+// next payout is due by payoutTime and performs a callback function
+func (keeper Keeper) IterateFundingStreamPayoutQueue(ctx sdk.Context, payoutTime time.Time, cb func(stream v1.FundingStream) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.FundingStreamPayoutQueuePrefix, sdk.PrefixEndBytes(types.FundingStreamPayoutQueueByTimeKey(payoutTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		streamID, _ := types.SplitFundingStreamPayoutQueueKey(iterator.Key())
+		stream, found := keeper.GetFundingStream(ctx, streamID)
+		if !found {
+			panic(fmt.Sprintf("funding stream %d does not exist", streamID))
+		}
+
+		if cb(stream) {
+			break
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+func TestFundingStreams(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	recipient := sdk.AccAddress("recipient")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	interval := time.Hour
+	startTime := ctx.BlockTime()
+
+	_, found := govKeeper.GetFundingStream(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, uint64(1), govKeeper.GetFundingStreamID(ctx))
+
+	// the end time must leave room for at least one payout
+	_, err := govKeeper.CreateFundingStream(ctx, 2, recipient, amount, interval, startTime.Add(interval-time.Second))
+	require.ErrorIs(t, err, types.ErrInvalidFundingStream)
+
+	// 3 payouts, at startTime+1h, +2h and +3h
+	stream, err := govKeeper.CreateFundingStream(ctx, 2, recipient, amount, interval, startTime.Add(3*interval+time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stream.Id)
+	assert.Equal(t, uint64(2), stream.ProposalId)
+	assert.Equal(t, startTime.Add(interval), *stream.NextPayoutTime)
+	got, found := govKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	assert.Equal(t, stream, got)
+	assert.Equal(t, uint64(2), govKeeper.GetFundingStreamID(ctx))
+
+	// no payout before the next payout time
+	govKeeper.PayFundingStreams(ctx)
+
+	// first payout
+	ctx = ctx.WithBlockTime(startTime.Add(interval))
+	mocks.distrKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), amount, recipient).Return(nil)
+	govKeeper.PayFundingStreams(ctx)
+	stream, found = govKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	assert.Equal(t, startTime.Add(2*interval), *stream.NextPayoutTime)
+	assert.Equal(t, amount, sdk.NewCoins(stream.TotalPaid...))
+
+	// a failed payout is skipped
+	ctx = ctx.WithBlockTime(startTime.Add(2 * interval))
+	mocks.distrKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), amount, recipient).Return(errors.New("insufficient funds"))
+	govKeeper.PayFundingStreams(ctx)
+	stream, found = govKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	assert.Equal(t, startTime.Add(3*interval), *stream.NextPayoutTime)
+	assert.Equal(t, amount, sdk.NewCoins(stream.TotalPaid...))
+
+	// the last payout deletes the stream
+	ctx = ctx.WithBlockTime(startTime.Add(3 * interval))
+	mocks.distrKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), amount, recipient).Return(nil)
+	govKeeper.PayFundingStreams(ctx)
+	_, found = govKeeper.GetFundingStream(ctx, 1)
+	require.False(t, found)
+	assert.Empty(t, govKeeper.GetFundingStreams(ctx))
+
+	// canceled streams are not paid anymore
+	stream, err = govKeeper.CreateFundingStream(ctx, 3, recipient, amount, interval, ctx.BlockTime().Add(10*interval))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), stream.Id)
+	require.NoError(t, govKeeper.CancelFundingStream(ctx, stream.Id))
+	_, found = govKeeper.GetFundingStream(ctx, stream.Id)
+	require.False(t, found)
+	govKeeper.PayFundingStreams(ctx.WithBlockTime(ctx.BlockTime().Add(interval)))
+
+	err = govKeeper.CancelFundingStream(ctx, stream.Id)
+	require.ErrorIs(t, err, types.ErrUnknownFundingStream)
+}
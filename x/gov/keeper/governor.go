@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetGovernor gets a governor from store by address
+func (keeper Keeper) GetGovernor(ctx sdk.Context, addr sdk.AccAddress) (governor v1.Governor, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernorKey(addr))
+	if bz == nil {
+		return governor, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &governor)
+	return governor, true
+}
+
+// SetGovernor sets a governor to the gov store
+func (keeper Keeper) SetGovernor(ctx sdk.Context, governor v1.Governor) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&governor)
+	store.Set(types.GovernorKey(governor.GetAddress()), bz)
+}
+
+// GetAllGovernors returns all the governors from the store
+func (keeper Keeper) GetAllGovernors(ctx sdk.Context) (governors []*v1.Governor) {
+	keeper.IterateGovernors(ctx, func(governor v1.Governor) bool {
+		governors = append(governors, &governor)
+		return false
+	})
+	return
+}
+
+// IterateGovernors iterates over all the governors and performs a callback
+// function
+func (keeper Keeper) IterateGovernors(ctx sdk.Context, cb func(governor v1.Governor) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovernorKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var governor v1.Governor
+		keeper.cdc.MustUnmarshal(iterator.Value(), &governor)
+
+		if cb(governor) {
+			break
+		}
+	}
+}
+
+// GetGovernorBondedTokens returns the amount of tokens the governor has
+// self-delegated to bonded validators.
+func (keeper Keeper) GetGovernorBondedTokens(ctx sdk.Context, governorAddr sdk.AccAddress) math.Int {
+	bondedTokens := math.ZeroInt()
+	keeper.sk.IterateDelegations(ctx, governorAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		validator, found := keeper.sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found && validator.IsBonded() {
+			bondedTokens = bondedTokens.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		}
+		return false
+	})
+	return bondedTokens
+}
+
+// ValidateGovernorMinSelfDelegation returns true if the governor has
+// self-delegated at least the MinGovernorSelfDelegation param to bonded
+// validators.
+func (keeper Keeper) ValidateGovernorMinSelfDelegation(ctx sdk.Context, governor v1.Governor) bool {
+	minGovernorSelfDelegation, _ := math.NewIntFromString(keeper.GetParams(ctx).MinGovernorSelfDelegation)
+	return keeper.GetGovernorBondedTokens(ctx, governor.GetAddress()).GTE(minGovernorSelfDelegation)
+}
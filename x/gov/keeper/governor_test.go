@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestGovernors(t *testing.T) {
+	st := newMockStakingState()
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockStakingStateExpectations(st))
+	addrs := simtestutil.CreateRandomAccounts(3)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+
+	_, found := govKeeper.GetGovernor(ctx, addrs[0])
+	require.False(t, found)
+
+	governor := v1.NewGovernor(addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), ctx.BlockTime())
+	govKeeper.SetGovernor(ctx, governor)
+	got, found := govKeeper.GetGovernor(ctx, addrs[0])
+	require.True(t, found)
+	assert.Equal(t, governor.GovernorAddress, got.GovernorAddress)
+	assert.Equal(t, governor.Description, got.Description)
+	assert.True(t, got.IsActive())
+	assert.Len(t, govKeeper.GetAllGovernors(ctx), 1)
+
+	// governor bonded tokens
+	params := govKeeper.GetParams(ctx)
+	params.MinGovernorSelfDelegation = "10"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	assert.True(t, govKeeper.GetGovernorBondedTokens(ctx, addrs[0]).IsZero())
+	assert.False(t, govKeeper.ValidateGovernorMinSelfDelegation(ctx, governor))
+	st.delegate(addrs[0], valAddrs[0], 6)
+	st.delegate(addrs[0], valAddrs[1], 4)
+	assert.Equal(t, math.NewInt(10), govKeeper.GetGovernorBondedTokens(ctx, addrs[0]))
+	assert.True(t, govKeeper.ValidateGovernorMinSelfDelegation(ctx, governor))
+}
+
+func TestGovernanceDelegations(t *testing.T) {
+	st := newMockStakingState()
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockStakingStateExpectations(st))
+	addrs := simtestutil.CreateRandomAccounts(4)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+	gov1, gov2, delAddr := addrs[0], addrs[1], addrs[2]
+	govKeeper.SetGovernor(ctx, v1.NewGovernor(gov1, v1.GovernorDescription{}, ctx.BlockTime()))
+	govKeeper.SetGovernor(ctx, v1.NewGovernor(gov2, v1.GovernorDescription{}, ctx.BlockTime()))
+
+	st.delegate(delAddr, valAddrs[0], 2)
+	st.delegate(delAddr, valAddrs[1], 3)
+
+	// delegate to gov1
+	govKeeper.DelegateToGovernor(ctx, delAddr, gov1)
+	delegation, found := govKeeper.GetGovernanceDelegation(ctx, delAddr)
+	require.True(t, found)
+	assert.Equal(t, gov1.String(), delegation.GovernorAddress)
+	shares := govKeeper.GetAllGovernorValShares(ctx, gov1)
+	require.Len(t, shares, 2)
+	valShares, found := govKeeper.GetGovernorValShares(ctx, gov1, valAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, math.LegacyNewDec(2), valShares.Shares)
+	valShares, found = govKeeper.GetGovernorValShares(ctx, gov1, valAddrs[1])
+	require.True(t, found)
+	assert.Equal(t, math.LegacyNewDec(3), valShares.Shares)
+	var delegators []string
+	govKeeper.IterateGovernorDelegations(ctx, gov1, func(d v1.GovernanceDelegation) bool {
+		delegators = append(delegators, d.DelegatorAddress)
+		return false
+	})
+	assert.Equal(t, []string{delAddr.String()}, delegators)
+
+	// redelegate to gov2
+	govKeeper.UndelegateFromGovernor(ctx, delAddr)
+	govKeeper.DelegateToGovernor(ctx, delAddr, gov2)
+	assert.Empty(t, govKeeper.GetAllGovernorValShares(ctx, gov1))
+	assert.Len(t, govKeeper.GetAllGovernorValShares(ctx, gov2), 2)
+	govKeeper.IterateGovernorDelegations(ctx, gov1, func(d v1.GovernanceDelegation) bool {
+		t.Fatalf("unexpected delegation to gov1: %v", d)
+		return false
+	})
+	assert.Len(t, govKeeper.GetAllGovernanceDelegations(ctx), 1)
+
+	// undelegate
+	govKeeper.UndelegateFromGovernor(ctx, delAddr)
+	_, found = govKeeper.GetGovernanceDelegation(ctx, delAddr)
+	assert.False(t, found)
+	assert.Empty(t, govKeeper.GetAllGovernorValShares(ctx, gov2))
+	assert.Empty(t, govKeeper.GetAllGovernanceDelegations(ctx))
+}
+
+func TestStakingHooks(t *testing.T) {
+	st := newMockStakingState()
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockStakingStateExpectations(st))
+	addrs := simtestutil.CreateRandomAccounts(3)
+	valAddr := sdk.ValAddress(addrs[0])
+	govAddr, delAddr := addrs[1], addrs[2]
+	hooks := govKeeper.StakingHooks()
+	govKeeper.SetGovernor(ctx, v1.NewGovernor(govAddr, v1.GovernorDescription{}, ctx.BlockTime()))
+
+	// delegation without governance delegation doesn't change governor shares
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr))
+	st.delegate(delAddr, valAddr, 2)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delAddr, valAddr))
+	assert.Empty(t, govKeeper.GetAllGovernorValShares(ctx, govAddr))
+
+	govKeeper.DelegateToGovernor(ctx, delAddr, govAddr)
+	valShares, found := govKeeper.GetGovernorValShares(ctx, govAddr, valAddr)
+	require.True(t, found)
+	assert.Equal(t, math.LegacyNewDec(2), valShares.Shares)
+
+	// increase the delegation, the governor shares are updated
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr))
+	st.delegate(delAddr, valAddr, 3)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delAddr, valAddr))
+	valShares, found = govKeeper.GetGovernorValShares(ctx, govAddr, valAddr)
+	require.True(t, found)
+	assert.Equal(t, math.LegacyNewDec(5), valShares.Shares)
+
+	// remove the delegation, the governor shares are removed
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr))
+	st.delegations = nil
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr))
+	_, found = govKeeper.GetGovernorValShares(ctx, govAddr, valAddr)
+	assert.False(t, found)
+}
@@ -25,6 +25,107 @@ func (q Keeper) Constitution(c context.Context, req *v1.QueryConstitutionRequest
 	return &v1.QueryConstitutionResponse{Constitution: constitution}, nil
 }
 
+// ConstitutionAtVersion returns a version of the constitution
+func (q Keeper) ConstitutionAtVersion(c context.Context, req *v1.QueryConstitutionAtVersionRequest) (*v1.QueryConstitutionAtVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	constitutionVersion, found := q.GetConstitutionVersion(ctx, req.Version)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "constitution version %d doesn't exist", req.Version)
+	}
+
+	return &v1.QueryConstitutionAtVersionResponse{ConstitutionVersion: &constitutionVersion}, nil
+}
+
+// ConstitutionHistory returns all the versions of the constitution
+func (q Keeper) ConstitutionHistory(c context.Context, req *v1.QueryConstitutionHistoryRequest) (*v1.QueryConstitutionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var versions []*v1.ConstitutionVersion
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	historyStore := prefix.NewStore(store, types.ConstitutionHistoryKeyPrefix)
+
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key []byte, value []byte) error {
+		var constitutionVersion v1.ConstitutionVersion
+		if err := q.cdc.Unmarshal(value, &constitutionVersion); err != nil {
+			return err
+		}
+
+		versions = append(versions, &constitutionVersion)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConstitutionHistoryResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// StructuredConstitution returns the structured constitution
+func (q Keeper) StructuredConstitution(c context.Context, req *v1.QueryStructuredConstitutionRequest) (*v1.QueryStructuredConstitutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	structured, found := q.GetStructuredConstitution(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "the constitution is not structured")
+	}
+
+	return &v1.QueryStructuredConstitutionResponse{StructuredConstitution: &structured}, nil
+}
+
+// SimulateProposal simulates the execution of the messages of a proposal
+func (q Keeper) SimulateProposal(c context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	messages, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal messages can not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	results, gasUsed, err := q.SimulateProposalMsgs(ctx, messages)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v1.QuerySimulateProposalResponse{Results: results, GasUsed: gasUsed}, nil
+}
+
+// ConstitutionArticle returns an article of the structured constitution
+func (q Keeper) ConstitutionArticle(c context.Context, req *v1.QueryConstitutionArticleRequest) (*v1.QueryConstitutionArticleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ArticleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "article id can not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	structured, _ := q.GetStructuredConstitution(ctx)
+	article, found := structured.FindArticle(req.ArticleId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "article %s doesn't exist", req.ArticleId)
+	}
+
+	return &v1.QueryConstitutionArticleResponse{Article: article}, nil
+}
+
 // Proposal returns proposal details based on ProposalID
 func (q Keeper) Proposal(c context.Context, req *v1.QueryProposalRequest) (*v1.QueryProposalResponse, error) {
 	if req == nil {
@@ -159,6 +260,86 @@ func (q Keeper) Votes(c context.Context, req *v1.QueryVotesRequest) (*v1.QueryVo
 	return &v1.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+// FinalVotes returns the final votes recorded for a proposal after its tally
+func (q Keeper) FinalVotes(c context.Context, req *v1.QueryFinalVotesRequest) (*v1.QueryFinalVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var votes []*v1.FinalVote
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	votesStore := prefix.NewStore(store, types.FinalVotesKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+		var vote v1.FinalVote
+		if err := q.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryFinalVotesResponse{FinalVotes: votes, Pagination: pageRes}, nil
+}
+
+// VoteHistory returns the vote history of a proposal, optionally filtered by
+// voter
+func (q Keeper) VoteHistory(c context.Context, req *v1.QueryVoteHistoryRequest) (*v1.QueryVoteHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var voter sdk.AccAddress
+	if req.Voter != "" {
+		var err error
+		voter, err = sdk.AccAddressFromBech32(req.Voter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var entries []*v1.VoteHistoryEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	historyStore := prefix.NewStore(store, types.VoteHistoryKey(req.ProposalId))
+
+	pageRes, err := query.FilteredPaginate(historyStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var entry v1.VoteHistoryEntry
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if voter != nil && entry.Voter != voter.String() {
+			return false, nil
+		}
+
+		if accumulate {
+			entries = append(entries, &entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 // Params queries all params
 func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
 	if req == nil {
@@ -173,7 +354,7 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 	//nolint:staticcheck
 	switch req.ParamsType {
 	case v1.ParamDeposit:
-		depositParams := v1.NewDepositParams(params.MinDeposit, params.MaxDepositPeriod)
+		depositParams := v1.NewDepositParams(q.GetMinDeposit(ctx), params.MaxDepositPeriod)
 		response.DepositParams = &depositParams
 
 	case v1.ParamVoting:
@@ -284,12 +465,262 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = q.Tally(ctx, proposal)
+		projection, err := q.TallyProjection(ctx, proposal)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		tallyResult = *projection.TallyResult
 	}
 
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ProposalTallyProjection returns the tally of a proposal in voting period as
+// if its voting period ended now
+func (q Keeper) ProposalTallyProjection(c context.Context, req *v1.QueryProposalTallyProjectionRequest) (*v1.QueryProposalTallyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	projection, err := q.TallyProjection(ctx, proposal)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalTallyProjectionResponse{TallyProjection: &projection}, nil
+}
+
+// MinDeposit returns the minimum deposit currently required for a proposal to enter voting period
+func (q Keeper) MinDeposit(c context.Context, req *v1.QueryMinDepositRequest) (*v1.QueryMinDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minDeposit := q.GetMinDeposit(ctx)
+
+	return &v1.QueryMinDepositResponse{MinDeposit: minDeposit}, nil
+}
+
+// MinInitialDeposit returns the minimum initial deposit currently required for
+// a proposal to be submitted
+func (q Keeper) MinInitialDeposit(c context.Context, req *v1.QueryMinInitialDepositRequest) (*v1.QueryMinInitialDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minInitialDeposit := q.GetMinInitialDeposit(ctx)
+
+	return &v1.QueryMinInitialDepositResponse{MinInitialDeposit: minInitialDeposit}, nil
+}
+
+// Governor queries governor information based on governor address
+func (q Keeper) Governor(c context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	govAddr, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+	governor, found := q.GetGovernor(ctx, govAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor}, nil
+}
+
+// Governors queries all governors
+func (q Keeper) Governors(c context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var governors []*v1.Governor
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	governorStore := prefix.NewStore(store, types.GovernorKeyPrefix)
+
+	pageRes, err := query.Paginate(governorStore, req.Pagination, func(key []byte, value []byte) error {
+		var governor v1.Governor
+		if err := q.cdc.Unmarshal(value, &governor); err != nil {
+			return err
+		}
+
+		governors = append(governors, &governor)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernanceDelegation queries the governor a delegator has delegated its
+// governance voting power to
+func (q Keeper) GovernanceDelegation(c context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegation, found := q.GetGovernanceDelegation(ctx, delAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "governance delegation for %s doesn't exist", req.DelegatorAddress)
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{GovernorAddress: delegation.GovernorAddress}, nil
+}
+
+// Quorums queries the quorums currently required for proposals
+func (q Keeper) Quorums(c context.Context, req *v1.QueryQuorumsRequest) (*v1.QueryQuorumsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	quorum, amendmentQuorum, lawQuorum, err := q.GetQuorums(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryQuorumsResponse{
+		Quorum:                      quorum.String(),
+		ConstitutionAmendmentQuorum: amendmentQuorum.String(),
+		LawQuorum:                   lawQuorum.String(),
+	}, nil
+}
+
+// Law returns law details based on LawID
+func (q Keeper) Law(c context.Context, req *v1.QueryLawRequest) (*v1.QueryLawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.LawId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "law id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	law, found := q.GetLaw(ctx, req.LawId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "law %d doesn't exist", req.LawId)
+	}
+
+	return &v1.QueryLawResponse{Law: &law}, nil
+}
+
+// Laws returns all the laws ratified by governance
+func (q Keeper) Laws(c context.Context, req *v1.QueryLawsRequest) (*v1.QueryLawsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var laws []*v1.Law
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	lawStore := prefix.NewStore(store, types.LawsKeyPrefix)
+
+	pageRes, err := query.Paginate(lawStore, req.Pagination, func(key []byte, value []byte) error {
+		var law v1.Law
+		if err := q.cdc.Unmarshal(value, &law); err != nil {
+			return err
+		}
+
+		laws = append(laws, &law)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryLawsResponse{Laws: laws, Pagination: pageRes}, nil
+}
+
+// FundingStream returns funding stream details based on StreamID
+func (q Keeper) FundingStream(c context.Context, req *v1.QueryFundingStreamRequest) (*v1.QueryFundingStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.StreamId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "funding stream id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stream, found := q.GetFundingStream(ctx, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "funding stream %d doesn't exist", req.StreamId)
+	}
+
+	return &v1.QueryFundingStreamResponse{FundingStream: &stream}, nil
+}
+
+// FundingStreams returns all the active funding streams
+func (q Keeper) FundingStreams(c context.Context, req *v1.QueryFundingStreamsRequest) (*v1.QueryFundingStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var streams []*v1.FundingStream
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	streamStore := prefix.NewStore(store, types.FundingStreamsKeyPrefix)
+
+	pageRes, err := query.Paginate(streamStore, req.Pagination, func(key []byte, value []byte) error {
+		var stream v1.FundingStream
+		if err := q.cdc.Unmarshal(value, &stream); err != nil {
+			return err
+		}
+
+		streams = append(streams, &stream)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryFundingStreamsResponse{FundingStreams: streams, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
@@ -10,8 +10,10 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	v3 "github.com/atomone-hub/atomone/x/gov/migrations/v3"
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
@@ -58,7 +60,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -133,7 +135,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -199,7 +201,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -337,7 +339,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -418,7 +420,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -532,7 +534,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -638,7 +640,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -740,7 +742,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -824,7 +826,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			"deposit params request",
 			func() {
 				req = &v1.QueryParamsRequest{ParamsType: v1.ParamDeposit}
-				depositParams := v1.NewDepositParams(params.MinDeposit, params.MaxDepositPeriod)
+				depositParams := v1.NewDepositParams(suite.govKeeper.GetMinDeposit(suite.ctx), params.MaxDepositPeriod)
 				expRes = &v1.QueryParamsResponse{
 					DepositParams: &depositParams,
 					Params:        &params,
@@ -1035,7 +1037,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1136,7 +1138,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1226,7 +1228,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1320,7 +1322,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -1534,6 +1536,34 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryProposalTallyProjection() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.ProposalTallyProjection(gocontext.Background(), &v1.QueryProposalTallyProjectionRequest{})
+	suite.Require().ErrorContains(err, "proposal id can not be 0")
+	_, err = queryClient.ProposalTallyProjection(gocontext.Background(), &v1.QueryProposalTallyProjectionRequest{ProposalId: 1})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	suite.Require().NoError(err)
+	_, err = queryClient.ProposalTallyProjection(gocontext.Background(), &v1.QueryProposalTallyProjectionRequest{ProposalId: proposal.Id})
+	suite.Require().ErrorContains(err, "not in voting period")
+
+	suite.govKeeper.ActivateVotingPeriod(ctx, proposal)
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	res, err := queryClient.ProposalTallyProjection(gocontext.Background(), &v1.QueryProposalTallyProjectionRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.EmptyTallyResult(), *res.TallyProjection.TallyResult)
+	suite.Require().Equal(v1.DefaultQuorum.String(), res.TallyProjection.Quorum)
+	suite.Require().Equal(v1.DefaultThreshold.String(), res.TallyProjection.Threshold)
+	suite.Require().False(res.TallyProjection.QuorumReached)
+	suite.Require().False(res.TallyProjection.Passes)
+	// the vote is still there
+	_, found := suite.govKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	suite.Require().True(found)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
@@ -1694,3 +1724,378 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryMinDeposit() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	res, err := queryClient.MinDeposit(gocontext.Background(), &v1.QueryMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.DefaultMinDepositFloor, sdk.Coins(res.MinDeposit))
+
+	for i := 0; i < 3; i++ {
+		suite.govKeeper.IncrementActiveProposalsNumber(ctx)
+	}
+	res, err = queryClient.MinDeposit(gocontext.Background(), &v1.QueryMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.govKeeper.GetMinDeposit(ctx), sdk.Coins(res.MinDeposit))
+	suite.Require().True(sdk.Coins(res.MinDeposit).IsAllGT(v1.DefaultMinDepositFloor))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMinInitialDeposit() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	res, err := queryClient.MinInitialDeposit(gocontext.Background(), &v1.QueryMinInitialDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.DefaultMinInitialDepositFloor, sdk.Coins(res.MinInitialDeposit))
+
+	for i := uint64(0); i <= v1.DefaultTargetProposalsInDepositPeriod; i++ {
+		suite.govKeeper.IncrementInactiveProposalsNumber(ctx)
+	}
+	res, err = queryClient.MinInitialDeposit(gocontext.Background(), &v1.QueryMinInitialDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.govKeeper.GetMinInitialDeposit(ctx), sdk.Coins(res.MinInitialDeposit))
+	suite.Require().True(sdk.Coins(res.MinInitialDeposit).IsAllGT(v1.DefaultMinInitialDepositFloor))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernor() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: addrs[0].String()})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	governor := v1.NewGovernor(addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), ctx.BlockTime())
+	suite.govKeeper.SetGovernor(ctx, governor)
+	res, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(governor.GovernorAddress, res.Governor.GovernorAddress)
+	suite.Require().Equal(governor.Description, res.Governor.Description)
+	suite.Require().Equal(governor.Status, res.Governor.Status)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernors() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	res, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Governors)
+
+	for _, addr := range addrs {
+		suite.govKeeper.SetGovernor(ctx, v1.NewGovernor(addr, v1.GovernorDescription{}, ctx.BlockTime()))
+	}
+	res, err = queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Governors, len(addrs))
+
+	res, err = queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Governors, 2)
+	suite.Require().Equal(uint64(len(addrs)), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernanceDelegation() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addrs[1].String()})
+	suite.Require().Error(err)
+
+	suite.govKeeper.SetGovernor(ctx, v1.NewGovernor(addrs[0], v1.GovernorDescription{}, ctx.BlockTime()))
+	suite.govKeeper.SetGovernanceDelegation(ctx, v1.NewGovernanceDelegation(addrs[1], addrs[0]))
+	res, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[0].String(), res.GovernorAddress)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryQuorums() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// fixed quorums
+	res, err := queryClient.Quorums(gocontext.Background(), &v1.QueryQuorumsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.DefaultQuorum.String(), res.Quorum)
+	suite.Require().Equal(v1.DefaultConstitutionAmendmentQuorum.String(), res.ConstitutionAmendmentQuorum)
+	suite.Require().Equal(v1.DefaultLawQuorum.String(), res.LawQuorum)
+
+	// dynamic quorums
+	params := suite.govKeeper.GetParams(ctx)
+	params.DynamicQuorum = true
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+	suite.govKeeper.SetParticipationEMA(ctx, sdk.ZeroDec())
+	suite.govKeeper.SetConstitutionAmendmentParticipationEMA(ctx, sdk.OneDec())
+	res, err = queryClient.Quorums(gocontext.Background(), &v1.QueryQuorumsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.DefaultQuorumRangeMin.String(), res.Quorum)
+	suite.Require().Equal(v1.DefaultConstitutionAmendmentQuorumRangeMax.String(), res.ConstitutionAmendmentQuorum)
+	suite.Require().Equal(v1.DefaultLawQuorum.String(), res.LawQuorum)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryFinalVotes() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	addrs := simtestutil.CreateRandomAccounts(3)
+
+	_, err := queryClient.FinalVotes(gocontext.Background(), nil)
+	suite.Require().Error(err)
+	_, err = queryClient.FinalVotes(gocontext.Background(), &v1.QueryFinalVotesRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.FinalVotes(gocontext.Background(), &v1.QueryFinalVotesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.FinalVotes)
+
+	var finalVotes []*v1.FinalVote
+	for i, addr := range addrs {
+		finalVote := v1.NewFinalVote(1, addr, v1.NewNonSplitVoteOption(v1.OptionYes), sdk.NewDec(int64(i+1)))
+		suite.govKeeper.SetFinalVote(ctx, finalVote)
+		finalVotes = append(finalVotes, &finalVote)
+	}
+	// final votes of another proposal are not returned
+	suite.govKeeper.SetFinalVote(ctx, v1.NewFinalVote(2, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), sdk.OneDec()))
+
+	res, err = queryClient.FinalVotes(gocontext.Background(), &v1.QueryFinalVotesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(finalVotes, res.FinalVotes)
+
+	// paginated
+	res, err = queryClient.FinalVotes(gocontext.Background(), &v1.QueryFinalVotesRequest{
+		ProposalId: 1,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FinalVotes, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryVoteHistory() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	addrs := simtestutil.CreateRandomAccounts(2)
+
+	_, err := queryClient.VoteHistory(gocontext.Background(), &v1.QueryVoteHistoryRequest{})
+	suite.Require().ErrorContains(err, "proposal id can not be 0")
+	_, err = queryClient.VoteHistory(gocontext.Background(), &v1.QueryVoteHistoryRequest{ProposalId: 1, Voter: "invalid"})
+	suite.Require().Error(err)
+
+	res, err := queryClient.VoteHistory(gocontext.Background(), &v1.QueryVoteHistoryRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+
+	entry1 := suite.govKeeper.AppendVoteHistory(ctx, 1, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes))
+	entry2 := suite.govKeeper.AppendVoteHistory(ctx, 1, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo))
+	entry3 := suite.govKeeper.AppendVoteHistory(ctx, 1, addrs[0], v1.NewNonSplitVoteOption(v1.OptionAbstain))
+	// the history of another proposal is not returned
+	suite.govKeeper.AppendVoteHistory(ctx, 2, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo))
+
+	res, err = queryClient.VoteHistory(gocontext.Background(), &v1.QueryVoteHistoryRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteHistoryEntry{&entry1, &entry2, &entry3}, res.Entries)
+
+	// filtered by voter
+	res, err = queryClient.VoteHistory(gocontext.Background(), &v1.QueryVoteHistoryRequest{
+		ProposalId: 1,
+		Voter:      addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteHistoryEntry{&entry1, &entry3}, res.Entries)
+
+	// paginated
+	res, err = queryClient.VoteHistory(gocontext.Background(), &v1.QueryVoteHistoryRequest{
+		ProposalId: 1,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteHistoryEntry{&entry1, &entry2}, res.Entries)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryLaw() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.Law(gocontext.Background(), &v1.QueryLawRequest{})
+	suite.Require().ErrorContains(err, "law id can not be 0")
+	_, err = queryClient.Law(gocontext.Background(), &v1.QueryLawRequest{LawId: 1})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	law, err := suite.govKeeper.RatifyLaw(ctx, 1, "title", "text", nil)
+	suite.Require().NoError(err)
+	res, err := queryClient.Law(gocontext.Background(), &v1.QueryLawRequest{LawId: law.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(law.Id, res.Law.Id)
+	suite.Require().Equal(law.ProposalId, res.Law.ProposalId)
+	suite.Require().Equal(law.Title, res.Law.Title)
+	suite.Require().Equal(law.Text, res.Law.Text)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryLaws() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	res, err := queryClient.Laws(gocontext.Background(), &v1.QueryLawsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Laws)
+
+	for i := uint64(1); i <= 3; i++ {
+		_, err := suite.govKeeper.RatifyLaw(ctx, i, "title", "text", nil)
+		suite.Require().NoError(err)
+	}
+	res, err = queryClient.Laws(gocontext.Background(), &v1.QueryLawsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Laws, 3)
+
+	// paginated
+	res, err = queryClient.Laws(gocontext.Background(), &v1.QueryLawsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Laws, 2)
+	suite.Require().Equal(uint64(1), res.Laws[0].Id)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitutionAtVersion() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.ConstitutionAtVersion(gocontext.Background(), &v1.QueryConstitutionAtVersionRequest{})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	genesisVersion := suite.govKeeper.AmendConstitution(ctx, 0, "", "Hello World")
+	amendedVersion := suite.govKeeper.AmendConstitution(ctx, 1, "@@ -1 +1 @@\n-Hello World\n+Hi World", "Hi World")
+
+	res, err := queryClient.ConstitutionAtVersion(gocontext.Background(), &v1.QueryConstitutionAtVersionRequest{Version: 0})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&genesisVersion, res.ConstitutionVersion)
+	res, err = queryClient.ConstitutionAtVersion(gocontext.Background(), &v1.QueryConstitutionAtVersionRequest{Version: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&amendedVersion, res.ConstitutionVersion)
+	_, err = queryClient.ConstitutionAtVersion(gocontext.Background(), &v1.QueryConstitutionAtVersionRequest{Version: 2})
+	suite.Require().ErrorContains(err, "doesn't exist")
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitutionHistory() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	res, err := queryClient.ConstitutionHistory(gocontext.Background(), &v1.QueryConstitutionHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Versions)
+
+	for i := uint64(0); i < 3; i++ {
+		suite.govKeeper.AmendConstitution(ctx, i, "", fmt.Sprintf("constitution %d", i))
+	}
+	res, err = queryClient.ConstitutionHistory(gocontext.Background(), &v1.QueryConstitutionHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Versions, 3)
+
+	// paginated
+	res, err = queryClient.ConstitutionHistory(gocontext.Background(), &v1.QueryConstitutionHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Versions, 2)
+	suite.Require().Equal(uint64(0), res.Versions[0].Version)
+	suite.Require().Equal("constitution 1", res.Versions[1].Constitution)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	govAddr := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress()
+
+	_, err := queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{})
+	suite.Require().ErrorContains(err, "proposal messages can not be empty")
+
+	// messages must be signed by the governance module account
+	req, err := v1.NewQuerySimulateProposalRequest([]sdk.Msg{
+		v1.NewMsgProposeLaw(suite.addrs[0], "title", "text", nil),
+	})
+	suite.Require().NoError(err)
+	_, err = queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().ErrorContains(err, types.ErrInvalidSigner.Error())
+
+	// the simulation stops at the first failing message, the MsgSend handler
+	// of the test router being nil
+	req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{
+		v1.NewMsgProposeLaw(govAddr, "title", "text", nil),
+		banktypes.NewMsgSend(govAddr, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+		v1.NewMsgProposeLaw(govAddr, "title", "text", nil),
+	})
+	suite.Require().NoError(err)
+	res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 2)
+
+	suite.Require().True(res.Results[0].Success)
+	suite.Require().Equal(sdk.MsgTypeURL(&v1.MsgProposeLaw{}), res.Results[0].MsgTypeUrl)
+	suite.Require().Empty(res.Results[0].Error)
+	suite.Require().NotZero(res.Results[0].GasUsed)
+	suite.Require().NotEmpty(res.Results[0].Events)
+	suite.Require().Equal(types.EventTypeRatifyLaw, res.Results[0].Events[0].Type)
+
+	suite.Require().False(res.Results[1].Success)
+	suite.Require().Contains(res.Results[1].Error, "PANICKED")
+	suite.Require().Equal(res.Results[0].GasUsed+res.Results[1].GasUsed, res.GasUsed)
+
+	// no state is written
+	suite.Require().Empty(suite.govKeeper.GetLaws(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryFundingStream() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.FundingStream(gocontext.Background(), &v1.QueryFundingStreamRequest{})
+	suite.Require().ErrorContains(err, "funding stream id can not be 0")
+	_, err = queryClient.FundingStream(gocontext.Background(), &v1.QueryFundingStreamRequest{StreamId: 1})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	stream, err := suite.govKeeper.CreateFundingStream(ctx, 1, suite.addrs[0],
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, ctx.BlockTime().Add(24*time.Hour))
+	suite.Require().NoError(err)
+	res, err := queryClient.FundingStream(gocontext.Background(), &v1.QueryFundingStreamRequest{StreamId: stream.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(stream.Id, res.FundingStream.Id)
+	suite.Require().Equal(stream.ProposalId, res.FundingStream.ProposalId)
+	suite.Require().Equal(stream.Recipient, res.FundingStream.Recipient)
+	suite.Require().Equal(stream.Amount, res.FundingStream.Amount)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryFundingStreams() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	res, err := queryClient.FundingStreams(gocontext.Background(), &v1.QueryFundingStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.FundingStreams)
+
+	for i := uint64(1); i <= 3; i++ {
+		_, err := suite.govKeeper.CreateFundingStream(ctx, i, suite.addrs[0],
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, ctx.BlockTime().Add(24*time.Hour))
+		suite.Require().NoError(err)
+	}
+	res, err = queryClient.FundingStreams(gocontext.Background(), &v1.QueryFundingStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FundingStreams, 3)
+
+	// paginated
+	res, err = queryClient.FundingStreams(gocontext.Background(), &v1.QueryFundingStreamsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FundingStreams, 2)
+	suite.Require().Equal(uint64(1), res.FundingStreams[0].Id)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
@@ -24,6 +24,7 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalCanceledValid          bool
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
@@ -46,8 +47,12 @@ func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, p
 	h.AfterProposalVotingPeriodEndedValid = true
 }
 
+func (h *MockGovHooksReceiver) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalCanceledValid = true
+}
+
 func TestHooks(t *testing.T) {
-	minDeposit := v1.DefaultParams().MinDeposit
+	minDeposit := v1.DefaultParams().MinDepositThrottler.FloorValue
 	govKeeper, mocks, _, ctx := setupGovKeeper(t)
 	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
 	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 1, minDeposit[0].Amount)
@@ -63,9 +68,10 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVoteValid)
 	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterProposalCanceledValid)
 
 	tp := TestProposal
-	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalSubmissionValid)
 
@@ -76,7 +82,7 @@ func TestHooks(t *testing.T) {
 
 	require.True(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 
-	p2, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	p2, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	require.NoError(t, err)
 
 	activated, err := govKeeper.AddDeposit(ctx, p2.Id, addrs[0], minDeposit)
@@ -93,4 +99,10 @@ func TestHooks(t *testing.T) {
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, govKeeper)
 	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+
+	p3, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", addrs[0], false)
+	require.NoError(t, err)
+	err = govKeeper.CancelProposal(ctx, p3.Id, addrs[0].String())
+	require.NoError(t, err)
+	require.True(t, govHooksReceiver.AfterProposalCanceledValid)
 }
@@ -14,12 +14,17 @@ import (
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper *Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "voting-power-accumulator", VotingPowerAccumulatorInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(keeper, bk)(ctx)
+		res, stop := ModuleAccountInvariant(keeper, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+		return VotingPowerAccumulatorInvariant(keeper)(ctx)
 	}
 }
 
@@ -47,3 +52,89 @@ func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// VotingPowerAccumulatorInvariant checks that the voting power accumulator of
+// each proposal in voting period matches a full recount of its votes.
+func VotingPowerAccumulatorInvariant(keeper *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, proposalID := range keeper.getVotingPeriodProposalIDs(ctx) {
+			votedShares, deductions := keeper.RecountVotingPowerAccumulator(ctx, proposalID)
+
+			// every stored entry must match the recount, and every non-zero
+			// recounted entry must be stored
+			stored := 0
+			keeper.IterateVotedShares(ctx, proposalID, func(validatorAddr sdk.ValAddress, shares sdk.Dec) bool {
+				stored++
+				if expected, ok := votedShares[validatorAddr.String()]; !ok || !expected.Equal(shares) {
+					broken = true
+					msg += fmt.Sprintf("\tproposal %d voted shares of validator %s: %s, recounted: %v\n",
+						proposalID, validatorAddr, shares, expected)
+				}
+				return false
+			})
+			if stored != countNonZeroShares(votedShares) {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d has %d voted shares entries, recounted: %d\n",
+					proposalID, stored, countNonZeroShares(votedShares))
+			}
+
+			stored = 0
+			keeper.IterateGovernorDeductions(ctx, proposalID, func(governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares sdk.Dec) bool {
+				stored++
+				if expected, ok := deductions[governorAddr.String()][validatorAddr.String()]; !ok || !expected.Equal(shares) {
+					broken = true
+					msg += fmt.Sprintf("\tproposal %d deductions of governor %s for validator %s: %s, recounted: %v\n",
+						proposalID, governorAddr, validatorAddr, shares, expected)
+				}
+				return false
+			})
+			recounted := 0
+			for _, govDeductions := range deductions {
+				recounted += countNonZeroShares(govDeductions)
+			}
+			if stored != recounted {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d has %d governor deductions entries, recounted: %d\n",
+					proposalID, stored, recounted)
+			}
+
+			// the voting governors must be the governors who voted
+			votingGovernors := make(map[string]bool)
+			keeper.IterateVotingGovernors(ctx, proposalID, func(governorAddr sdk.AccAddress) bool {
+				votingGovernors[governorAddr.String()] = true
+				return false
+			})
+			keeper.IterateGovernors(ctx, func(governor v1.Governor) bool {
+				govAddr := governor.GetAddress()
+				_, voted := keeper.GetVote(ctx, proposalID, govAddr)
+				if voted != votingGovernors[govAddr.String()] {
+					broken = true
+					msg += fmt.Sprintf("\tproposal %d governor %s voted: %t, stored as voting governor: %t\n",
+						proposalID, govAddr, voted, votingGovernors[govAddr.String()])
+				}
+				delete(votingGovernors, govAddr.String())
+				return false
+			})
+			for govAddr := range votingGovernors {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d voting governor %s is not a governor\n", proposalID, govAddr)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "voting power accumulator", msg), broken
+	}
+}
+
+func countNonZeroShares(shares map[string]sdk.Dec) (n int) {
+	for _, s := range shares {
+		if !s.IsZero() {
+			n++
+		}
+	}
+	return n
+}
@@ -25,6 +25,12 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The reference to the photon keeper to value photon deposits
+	pk types.PhotonKeeper
+
+	// The reference to the distribution keeper to pay funding streams
+	dk types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -61,8 +67,8 @@ func (k Keeper) GetAuthority() string {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, authKeeper types.AccountKeeper,
-	bankKeeper types.BankKeeper, sk types.StakingKeeper,
-	router *baseapp.MsgServiceRouter, config types.Config, authority string,
+	bankKeeper types.BankKeeper, sk types.StakingKeeper, pk types.PhotonKeeper,
+	dk types.DistributionKeeper, router *baseapp.MsgServiceRouter, config types.Config, authority string,
 ) *Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -83,6 +89,8 @@ func NewKeeper(
 		authKeeper: authKeeper,
 		bankKeeper: bankKeeper,
 		sk:         sk,
+		pk:         pk,
+		dk:         dk,
 		cdc:        cdc,
 		router:     router,
 		config:     config,
@@ -181,6 +189,19 @@ func (keeper Keeper) RemoveFromQuorumCheckQueue(ctx sdk.Context, proposalID uint
 	store.Delete(types.QuorumCheckQueueKey(proposalID, endTime))
 }
 
+// InsertExecutionQueue inserts a proposalID into the execution queue at executionTime
+func (keeper Keeper) InsertExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.ExecutionQueueKey(proposalID, executionTime), bz)
+}
+
+// RemoveFromExecutionQueue removes a proposalID from the execution queue
+func (keeper Keeper) RemoveFromExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ExecutionQueueKey(proposalID, executionTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -241,6 +262,25 @@ func (keeper Keeper) IterateQuorumCheckQueue(ctx sdk.Context, endTime time.Time,
 	}
 }
 
+// IterateExecutionQueue iterates over the proposals in the execution queue
+// due by executionTime and performs a callback function
+func (keeper Keeper) IterateExecutionQueue(ctx sdk.Context, executionTime time.Time, cb func(proposal v1.Proposal) (stop bool)) {
+	iterator := keeper.ExecutionQueueIterator(ctx, executionTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitExecutionQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -259,6 +299,12 @@ func (keeper Keeper) QuorumCheckQueueIterator(ctx sdk.Context, endTime time.Time
 	return store.Iterator(types.QuorumCheckQueuePrefix, sdk.PrefixEndBytes(types.QuorumCheckByTimeKey(endTime)))
 }
 
+// ExecutionQueueIterator returns an sdk.Iterator for all the proposals in the Execution Queue due by executionTime
+func (keeper Keeper) ExecutionQueueIterator(ctx sdk.Context, executionTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ExecutionQueuePrefix, sdk.PrefixEndBytes(types.ExecutionQueueByTimeKey(executionTime)))
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than a pre-defined MaxMetadataLen.
 func (keeper Keeper) assertMetadataLength(metadata string) error {
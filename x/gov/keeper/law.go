@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// RatifyLaw stores a new law ratified by the proposal proposalID and returns
+// it. The laws it supersedes must exist.
+func (keeper Keeper) RatifyLaw(ctx sdk.Context, proposalID uint64, title, text string, supersedes []uint64) (v1.Law, error) {
+	for _, lawID := range supersedes {
+		if _, found := keeper.GetLaw(ctx, lawID); !found {
+			return v1.Law{}, sdkerrors.Wrapf(types.ErrUnknownLaw, "superseded law %d doesn't exist", lawID)
+		}
+	}
+
+	lawID := keeper.GetLawID(ctx)
+	ratificationTime := ctx.BlockTime()
+	law := v1.Law{
+		Id:               lawID,
+		ProposalId:       proposalID,
+		Title:            title,
+		Text:             text,
+		Supersedes:       supersedes,
+		RatificationTime: &ratificationTime,
+	}
+	keeper.SetLaw(ctx, law)
+	keeper.SetLawID(ctx, lawID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRatifyLaw,
+			sdk.NewAttribute(types.AttributeKeyLawID, fmt.Sprintf("%d", lawID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return law, nil
+}
+
+// GetLaw gets a law from store by LawID.
+// Panics if can't unmarshal the law.
+func (keeper Keeper) GetLaw(ctx sdk.Context, lawID uint64) (v1.Law, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.LawKey(lawID))
+	if bz == nil {
+		return v1.Law{}, false
+	}
+
+	var law v1.Law
+	keeper.cdc.MustUnmarshal(bz, &law)
+	return law, true
+}
+
+// SetLaw sets a law to store.
+func (keeper Keeper) SetLaw(ctx sdk.Context, law v1.Law) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&law)
+	store.Set(types.LawKey(law.Id), bz)
+}
+
+// IterateLaws iterates over all the laws and performs a callback function.
+func (keeper Keeper) IterateLaws(ctx sdk.Context, cb func(law v1.Law) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.LawsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var law v1.Law
+		keeper.cdc.MustUnmarshal(iterator.Value(), &law)
+
+		if cb(law) {
+			break
+		}
+	}
+}
+
+// GetLaws returns all the laws from store
+func (keeper Keeper) GetLaws(ctx sdk.Context) (laws v1.Laws) {
+	keeper.IterateLaws(ctx, func(law v1.Law) bool {
+		laws = append(laws, &law)
+		return false
+	})
+	return
+}
+
+// GetLawID gets the ID of the next law, laws IDs start at 1.
+func (keeper Keeper) GetLawID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LawIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLawID sets the ID of the next law to the store
+func (keeper Keeper) SetLawID(ctx sdk.Context, lawID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.LawIDKey, sdk.Uint64ToBigEndian(lawID))
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+func TestLaws(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+
+	_, found := govKeeper.GetLaw(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, uint64(1), govKeeper.GetLawID(ctx))
+
+	law, err := govKeeper.RatifyLaw(ctx, 3, "title", "text", nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), law.Id)
+	assert.Equal(t, uint64(3), law.ProposalId)
+	assert.Equal(t, ctx.BlockTime(), *law.RatificationTime)
+	got, found := govKeeper.GetLaw(ctx, 1)
+	require.True(t, found)
+	assert.Equal(t, law, got)
+	assert.Equal(t, uint64(2), govKeeper.GetLawID(ctx))
+
+	// superseding an existing law
+	law, err = govKeeper.RatifyLaw(ctx, 5, "title 2", "text 2", []uint64{1})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), law.Id)
+	assert.Equal(t, []uint64{1}, law.Supersedes)
+	assert.Len(t, govKeeper.GetLaws(ctx), 2)
+
+	// superseding an unknown law
+	_, err = govKeeper.RatifyLaw(ctx, 6, "title 3", "text 3", []uint64{3})
+	require.ErrorIs(t, err, types.ErrUnknownLaw)
+	assert.Len(t, govKeeper.GetLaws(ctx), 2)
+	assert.Equal(t, uint64(3), govKeeper.GetLawID(ctx))
+}
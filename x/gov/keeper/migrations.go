@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/atomone-hub/atomone/x/gov/exported"
+	v5 "github.com/atomone-hub/atomone/x/gov/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -17,3 +20,14 @@ func NewMigrator(keeper *Keeper, legacySubspace exported.ParamSubspace) Migrator
 		legacySubspace: legacySubspace,
 	}
 }
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if err := v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	// the voting power accumulators depend on the staking delegations, hence
+	// they are computed by the keeper rather than by the store migration
+	m.keeper.ResetVotingPowerAccumulators(ctx)
+	return nil
+}
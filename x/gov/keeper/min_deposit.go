@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetActiveProposalsNumber gets the number of active proposals from store
+func (keeper Keeper) GetActiveProposalsNumber(ctx sdk.Context) (activeProposalsNumber uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ActiveProposalsNumberKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetActiveProposalsNumber sets the new number of active proposals to the store
+func (keeper Keeper) SetActiveProposalsNumber(ctx sdk.Context, activeProposalsNumber uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ActiveProposalsNumberKey, sdk.Uint64ToBigEndian(activeProposalsNumber))
+}
+
+// IncrementActiveProposalsNumber increments the number of active proposals by one
+// and triggers an update of the dynamic min deposit.
+func (keeper Keeper) IncrementActiveProposalsNumber(ctx sdk.Context) {
+	activeProposalsNumber := keeper.GetActiveProposalsNumber(ctx)
+	keeper.UpdateMinDeposit(ctx, activeProposalsNumber, activeProposalsNumber+1)
+	keeper.SetActiveProposalsNumber(ctx, activeProposalsNumber+1)
+}
+
+// DecrementActiveProposalsNumber decrements the number of active proposals by one
+// and triggers an update of the dynamic min deposit.
+func (keeper Keeper) DecrementActiveProposalsNumber(ctx sdk.Context) {
+	activeProposalsNumber := keeper.GetActiveProposalsNumber(ctx)
+	if activeProposalsNumber == 0 {
+		// should never happen
+		panic("number of active proposals should never be negative")
+	}
+	keeper.UpdateMinDeposit(ctx, activeProposalsNumber, activeProposalsNumber-1)
+	keeper.SetActiveProposalsNumber(ctx, activeProposalsNumber-1)
+}
+
+// SetLastMinDeposit updates the last min deposit and last min deposit time.
+// Used to record these values the last time the number of active proposals changed.
+func (keeper Keeper) SetLastMinDeposit(ctx sdk.Context, minDeposit sdk.Coins, timeStamp time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	lastMinDeposit := v1.LastMinDeposit{
+		Value: minDeposit,
+		Time:  &timeStamp,
+	}
+	bz := keeper.cdc.MustMarshal(&lastMinDeposit)
+	store.Set(types.LastMinDepositKey, bz)
+}
+
+// GetLastMinDeposit returns the last min deposit and the time it was set.
+// If the last min deposit has never been set, the min deposit floor and the
+// current block time are returned.
+func (keeper Keeper) GetLastMinDeposit(ctx sdk.Context) (sdk.Coins, time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LastMinDepositKey)
+	if bz == nil {
+		params := keeper.GetParams(ctx)
+		return params.MinDepositThrottler.FloorValue, ctx.BlockTime()
+	}
+
+	var lastMinDeposit v1.LastMinDeposit
+	keeper.cdc.MustUnmarshal(bz, &lastMinDeposit)
+	return lastMinDeposit.Value, *lastMinDeposit.Time
+}
+
+// GetMinDeposit returns the (dynamic) minimum deposit currently required for
+// a proposal to enter the voting period.
+//
+// The value is computed lazily from the last stored min deposit, by applying
+// the update rate of the current number of active proposals once per update
+// period (tick) elapsed since the last update.
+func (keeper Keeper) GetMinDeposit(ctx sdk.Context) sdk.Coins {
+	params := keeper.GetParams(ctx)
+	lastMinDeposit, lastMinDepositTime := keeper.GetLastMinDeposit(ctx)
+
+	ticksPassed := uint64(0)
+	if elapsed := ctx.BlockTime().Sub(lastMinDepositTime); elapsed > 0 {
+		ticksPassed = uint64(elapsed / *params.MinDepositThrottler.UpdatePeriod)
+	}
+
+	throttler := params.MinDepositThrottler
+	rate := depositUpdateRate(keeper.GetActiveProposalsNumber(ctx), throttler.TargetActiveProposals,
+		throttler.IncreaseRatio, throttler.DecreaseRatio, throttler.SensitivityTargetDistance)
+	return computeDynamicDeposit(throttler.FloorValue, lastMinDeposit, rate, ticksPassed)
+}
+
+// GetProposalMinDeposit returns the minimum deposit currently required for
+// the given proposal to enter the voting period. Expedited proposals require
+// the highest of the expedited min deposit and the dynamic min deposit.
+func (keeper Keeper) GetProposalMinDeposit(ctx sdk.Context, proposal v1.Proposal) sdk.Coins {
+	minDeposit := keeper.GetMinDeposit(ctx)
+	if proposal.Expedited {
+		params := keeper.GetParams(ctx)
+		return minDeposit.Max(params.ExpeditedMinDeposit)
+	}
+	return minDeposit
+}
+
+// UpdateMinDeposit updates the last min deposit in store. It must be called
+// whenever the number of active proposals changes from oldActiveProposals to
+// newActiveProposals, before the new number is stored.
+//
+// The ticks elapsed since the last update are first accounted for using the
+// previous number of active proposals, then the update rate corresponding to
+// the new number of active proposals is applied once.
+func (keeper Keeper) UpdateMinDeposit(ctx sdk.Context, oldActiveProposals, newActiveProposals uint64) {
+	params := keeper.GetParams(ctx)
+	throttler := *params.MinDepositThrottler
+	lastMinDeposit, lastMinDepositTime := keeper.GetLastMinDeposit(ctx)
+
+	ticksPassed := uint64(0)
+	if elapsed := ctx.BlockTime().Sub(lastMinDepositTime); elapsed > 0 {
+		ticksPassed = uint64(elapsed / *throttler.UpdatePeriod)
+	}
+
+	oldRate := depositUpdateRate(oldActiveProposals, throttler.TargetActiveProposals,
+		throttler.IncreaseRatio, throttler.DecreaseRatio, throttler.SensitivityTargetDistance)
+	newRate := depositUpdateRate(newActiveProposals, throttler.TargetActiveProposals,
+		throttler.IncreaseRatio, throttler.DecreaseRatio, throttler.SensitivityTargetDistance)
+	currentMinDeposit := computeDynamicDeposit(throttler.FloorValue, lastMinDeposit, oldRate, ticksPassed)
+	newMinDeposit := computeDynamicDeposit(throttler.FloorValue, currentMinDeposit, newRate, 1)
+
+	keeper.SetLastMinDeposit(ctx, newMinDeposit, ctx.BlockTime())
+}
+
+// depositUpdateRate returns the factor applied to a dynamic deposit for each
+// update, given the number of proposals n and the target N:
+//
+//	1 + sign(n - N) * alpha * (|n - N|)^(1/k)
+//
+// where alpha is the increase ratio if n > N and the decrease ratio otherwise,
+// and k is the sensitivity target distance.
+func depositUpdateRate(n, target uint64, increaseRatio, decreaseRatio string, sensitivityTargetDistance uint64) math.LegacyDec {
+	var (
+		distance uint64
+		alpha    math.LegacyDec
+	)
+	if n > target {
+		distance = n - target
+		alpha = math.LegacyMustNewDecFromStr(increaseRatio)
+	} else {
+		distance = target - n
+		alpha = math.LegacyMustNewDecFromStr(decreaseRatio).Neg()
+	}
+	if distance == 0 {
+		return math.LegacyOneDec()
+	}
+
+	root, err := math.LegacyNewDecFromInt(math.NewIntFromUint64(distance)).ApproxRoot(sensitivityTargetDistance)
+	if err != nil {
+		// should never happen, ApproxRoot only fails on negative numbers
+		panic(err)
+	}
+
+	return math.LegacyOneDec().Add(alpha.Mul(root))
+}
+
+// maxDynamicDepositAmount bounds the amount of each denom of a dynamic
+// deposit, so that it cannot overflow when it keeps increasing for a long time
+// without being updated. Such an amount is far beyond any supply.
+var maxDynamicDepositAmount = math.NewIntWithDecimal(1, 36)
+
+// computeDynamicDeposit applies ticks times the update rate to deposit, never
+// going below the floor value nor above maxDynamicDepositAmount, unless the
+// floor value is above it. Only denoms present in the floor are returned.
+func computeDynamicDeposit(floor, deposit sdk.Coins, rate math.LegacyDec, ticks uint64) sdk.Coins {
+	factor := math.LegacyOneDec()
+	if ticks > 0 {
+		switch {
+		case !rate.IsPositive():
+			// the min deposit cannot go below the floor value anyway
+			return floor
+		case rate.GT(math.LegacyOneDec()):
+			// each amount is at least 1, so a factor above the max amount
+			// brings it to the max amount
+			factor = cappedPower(rate, math.LegacyNewDecFromInt(maxDynamicDepositAmount), ticks)
+		default:
+			factor = rate.Power(ticks)
+		}
+	}
+
+	newDeposit := sdk.NewCoins()
+	for _, floorCoin := range floor {
+		amount := deposit.AmountOf(floorCoin.Denom)
+		if amount.IsZero() {
+			amount = floorCoin.Amount
+		}
+		amount = math.MinInt(factor.MulInt(amount).TruncateInt(), maxDynamicDepositAmount)
+		newDeposit = newDeposit.Add(sdk.NewCoin(floorCoin.Denom, math.MaxInt(amount, floorCoin.Amount)))
+	}
+
+	return newDeposit
+}
+
+// cappedPower returns rate^ticks, computed like math.LegacyDec.Power, or limit
+// if it is greater. rate must be greater than one, so that the result is
+// greater than any intermediate value and the computation can stop as soon as
+// one of them exceeds limit, before it can overflow.
+func cappedPower(rate, limit math.LegacyDec, ticks uint64) math.LegacyDec {
+	power, tmp := rate, math.LegacyOneDec()
+	for i := ticks; i > 1; i /= 2 {
+		if i%2 != 0 {
+			tmp = tmp.Mul(power)
+			if tmp.GT(limit) {
+				return limit
+			}
+		}
+		power = power.Mul(power)
+		if power.GT(limit) {
+			return limit
+		}
+	}
+	return math.LegacyMinDec(power.Mul(tmp), limit)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestGetMinDeposit(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	params := govKeeper.GetParams(ctx)
+	updatePeriod := *params.MinDepositThrottler.UpdatePeriod
+	minDepositAmount := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	// initial min deposit is the floor value
+	require.Equal(t, v1.DefaultMinDepositFloor, govKeeper.GetMinDeposit(ctx))
+	require.EqualValues(t, 0, govKeeper.GetActiveProposalsNumber(ctx))
+
+	// below or at target, the min deposit cannot go below the floor
+	govKeeper.IncrementActiveProposalsNumber(ctx)
+	govKeeper.IncrementActiveProposalsNumber(ctx)
+	require.EqualValues(t, 2, govKeeper.GetActiveProposalsNumber(ctx))
+	require.Equal(t, v1.DefaultMinDepositFloor, govKeeper.GetMinDeposit(ctx))
+
+	// above target, the min deposit increases immediately
+	govKeeper.IncrementActiveProposalsNumber(ctx)
+	require.Equal(t, minDepositAmount(10_500_000), govKeeper.GetMinDeposit(ctx))
+
+	// and keeps increasing at each update period while above target
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updatePeriod / 2))
+	require.Equal(t, minDepositAmount(10_500_000), govKeeper.GetMinDeposit(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updatePeriod / 2))
+	require.Equal(t, minDepositAmount(11_025_000), govKeeper.GetMinDeposit(ctx))
+
+	// back at target, the min deposit no longer changes
+	govKeeper.DecrementActiveProposalsNumber(ctx)
+	require.Equal(t, minDepositAmount(11_025_000), govKeeper.GetMinDeposit(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updatePeriod))
+	require.Equal(t, minDepositAmount(11_025_000), govKeeper.GetMinDeposit(ctx))
+
+	// below target, the min deposit decreases immediately
+	govKeeper.DecrementActiveProposalsNumber(ctx)
+	require.Equal(t, minDepositAmount(10_749_375), govKeeper.GetMinDeposit(ctx))
+
+	// and keeps decreasing at each update period, until it reaches the floor
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updatePeriod))
+	require.Equal(t, minDepositAmount(10_480_640), govKeeper.GetMinDeposit(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * updatePeriod))
+	require.Equal(t, v1.DefaultMinDepositFloor, govKeeper.GetMinDeposit(ctx))
+
+	govKeeper.DecrementActiveProposalsNumber(ctx)
+	require.EqualValues(t, 0, govKeeper.GetActiveProposalsNumber(ctx))
+	require.Equal(t, v1.DefaultMinDepositFloor, govKeeper.GetMinDeposit(ctx))
+	require.Panics(t, func() { govKeeper.DecrementActiveProposalsNumber(ctx) })
+}
+
+func TestGetMinDepositSensitivity(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	params := govKeeper.GetParams(ctx)
+	params.MinDepositThrottler.TargetActiveProposals = 0
+	params.MinDepositThrottler.SensitivityTargetDistance = 2
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	// distance 1 from target: 1 + 0.05 * 1^(1/2)
+	govKeeper.IncrementActiveProposalsNumber(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_500_000)), govKeeper.GetMinDeposit(ctx))
+
+	// distance 4 from target: 1 + 0.05 * 4^(1/2) = 1.1
+	govKeeper.SetActiveProposalsNumber(ctx, 3)
+	govKeeper.SetLastMinDeposit(ctx, v1.DefaultMinDepositFloor, ctx.BlockTime())
+	govKeeper.IncrementActiveProposalsNumber(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 11_000_000)), govKeeper.GetMinDeposit(ctx))
+}
+
+func TestGetMinDepositOverflow(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	params := govKeeper.GetParams(ctx)
+	updatePeriod := *params.MinDepositThrottler.UpdatePeriod
+	maxMinDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, keeper.MaxDynamicDepositAmount))
+
+	// above target for a very long time, the min deposit reaches its bound
+	// instead of overflowing
+	govKeeper.SetActiveProposalsNumber(ctx, params.MinDepositThrottler.TargetActiveProposals+1)
+	govKeeper.SetLastMinDeposit(ctx, v1.DefaultMinDepositFloor, ctx.BlockTime())
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10_000 * updatePeriod))
+	require.Equal(t, maxMinDeposit, govKeeper.GetMinDeposit(ctx))
+
+	// and stays at its bound when updated
+	govKeeper.IncrementActiveProposalsNumber(ctx)
+	require.Equal(t, maxMinDeposit, govKeeper.GetMinDeposit(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10_000 * updatePeriod))
+	require.Equal(t, maxMinDeposit, govKeeper.GetMinDeposit(ctx))
+
+	// below target, it decreases again down to the floor
+	govKeeper.SetActiveProposalsNumber(ctx, 0)
+	govKeeper.SetLastMinDeposit(ctx, maxMinDeposit, ctx.BlockTime())
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10_000 * updatePeriod))
+	require.Equal(t, v1.DefaultMinDepositFloor, govKeeper.GetMinDeposit(ctx))
+}
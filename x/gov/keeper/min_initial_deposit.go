@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetInactiveProposalsNumber gets the number of inactive proposals (i.e. in
+// deposit period) from store
+func (keeper Keeper) GetInactiveProposalsNumber(ctx sdk.Context) (inactiveProposalsNumber uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.InactiveProposalsNumberKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetInactiveProposalsNumber sets the new number of inactive proposals to the store
+func (keeper Keeper) SetInactiveProposalsNumber(ctx sdk.Context, inactiveProposalsNumber uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.InactiveProposalsNumberKey, sdk.Uint64ToBigEndian(inactiveProposalsNumber))
+}
+
+// IncrementInactiveProposalsNumber increments the number of inactive proposals
+// by one and triggers an update of the dynamic min initial deposit.
+func (keeper Keeper) IncrementInactiveProposalsNumber(ctx sdk.Context) {
+	inactiveProposalsNumber := keeper.GetInactiveProposalsNumber(ctx)
+	keeper.UpdateMinInitialDeposit(ctx, inactiveProposalsNumber, inactiveProposalsNumber+1)
+	keeper.SetInactiveProposalsNumber(ctx, inactiveProposalsNumber+1)
+}
+
+// DecrementInactiveProposalsNumber decrements the number of inactive proposals
+// by one and triggers an update of the dynamic min initial deposit.
+func (keeper Keeper) DecrementInactiveProposalsNumber(ctx sdk.Context) {
+	inactiveProposalsNumber := keeper.GetInactiveProposalsNumber(ctx)
+	if inactiveProposalsNumber == 0 {
+		// should never happen
+		panic("number of inactive proposals should never be negative")
+	}
+	keeper.UpdateMinInitialDeposit(ctx, inactiveProposalsNumber, inactiveProposalsNumber-1)
+	keeper.SetInactiveProposalsNumber(ctx, inactiveProposalsNumber-1)
+}
+
+// SetLastMinInitialDeposit updates the last min initial deposit and last min
+// initial deposit time. Used to record these values the last time the number
+// of inactive proposals changed.
+func (keeper Keeper) SetLastMinInitialDeposit(ctx sdk.Context, minInitialDeposit sdk.Coins, timeStamp time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	lastMinInitialDeposit := v1.LastMinDeposit{
+		Value: minInitialDeposit,
+		Time:  &timeStamp,
+	}
+	bz := keeper.cdc.MustMarshal(&lastMinInitialDeposit)
+	store.Set(types.LastMinInitialDepositKey, bz)
+}
+
+// GetLastMinInitialDeposit returns the last min initial deposit and the time
+// it was set. If the last min initial deposit has never been set, the min
+// initial deposit floor and the current block time are returned.
+func (keeper Keeper) GetLastMinInitialDeposit(ctx sdk.Context) (sdk.Coins, time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LastMinInitialDepositKey)
+	if bz == nil {
+		params := keeper.GetParams(ctx)
+		return params.MinInitialDepositThrottler.FloorValue, ctx.BlockTime()
+	}
+
+	var lastMinInitialDeposit v1.LastMinDeposit
+	keeper.cdc.MustUnmarshal(bz, &lastMinInitialDeposit)
+	return lastMinInitialDeposit.Value, *lastMinInitialDeposit.Time
+}
+
+// GetMinInitialDeposit returns the (dynamic) minimum initial deposit currently
+// required for a proposal to be submitted.
+//
+// The value is computed lazily from the last stored min initial deposit, by
+// applying the update rate of the current number of inactive proposals once
+// per update period (tick) elapsed since the last update.
+func (keeper Keeper) GetMinInitialDeposit(ctx sdk.Context) sdk.Coins {
+	params := keeper.GetParams(ctx)
+	throttler := params.MinInitialDepositThrottler
+	lastMinInitialDeposit, lastMinInitialDepositTime := keeper.GetLastMinInitialDeposit(ctx)
+
+	ticksPassed := uint64(0)
+	if elapsed := ctx.BlockTime().Sub(lastMinInitialDepositTime); elapsed > 0 {
+		ticksPassed = uint64(elapsed / *throttler.UpdatePeriod)
+	}
+
+	rate := depositUpdateRate(keeper.GetInactiveProposalsNumber(ctx), throttler.TargetProposals,
+		throttler.IncreaseRatio, throttler.DecreaseRatio, throttler.SensitivityTargetDistance)
+	return computeDynamicDeposit(throttler.FloorValue, lastMinInitialDeposit, rate, ticksPassed)
+}
+
+// UpdateMinInitialDeposit updates the last min initial deposit in store. It
+// must be called whenever the number of inactive proposals changes from
+// oldInactiveProposals to newInactiveProposals, before the new number is
+// stored.
+//
+// The ticks elapsed since the last update are first accounted for using the
+// previous number of inactive proposals, then the update rate corresponding
+// to the new number of inactive proposals is applied once.
+func (keeper Keeper) UpdateMinInitialDeposit(ctx sdk.Context, oldInactiveProposals, newInactiveProposals uint64) {
+	params := keeper.GetParams(ctx)
+	throttler := *params.MinInitialDepositThrottler
+	lastMinInitialDeposit, lastMinInitialDepositTime := keeper.GetLastMinInitialDeposit(ctx)
+
+	ticksPassed := uint64(0)
+	if elapsed := ctx.BlockTime().Sub(lastMinInitialDepositTime); elapsed > 0 {
+		ticksPassed = uint64(elapsed / *throttler.UpdatePeriod)
+	}
+
+	oldRate := depositUpdateRate(oldInactiveProposals, throttler.TargetProposals,
+		throttler.IncreaseRatio, throttler.DecreaseRatio, throttler.SensitivityTargetDistance)
+	newRate := depositUpdateRate(newInactiveProposals, throttler.TargetProposals,
+		throttler.IncreaseRatio, throttler.DecreaseRatio, throttler.SensitivityTargetDistance)
+	currentMinInitialDeposit := computeDynamicDeposit(throttler.FloorValue, lastMinInitialDeposit, oldRate, ticksPassed)
+	newMinInitialDeposit := computeDynamicDeposit(throttler.FloorValue, currentMinInitialDeposit, newRate, 1)
+
+	keeper.SetLastMinInitialDeposit(ctx, newMinInitialDeposit, ctx.BlockTime())
+}
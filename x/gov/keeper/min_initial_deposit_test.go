@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestGetMinInitialDeposit(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	params := govKeeper.GetParams(ctx)
+	params.MinInitialDepositThrottler.TargetProposals = 1
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	updatePeriod := *params.MinInitialDepositThrottler.UpdatePeriod
+	minInitialDepositAmount := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	// initial min initial deposit is the floor value
+	require.Equal(t, v1.DefaultMinInitialDepositFloor, govKeeper.GetMinInitialDeposit(ctx))
+	require.EqualValues(t, 0, govKeeper.GetInactiveProposalsNumber(ctx))
+
+	// at target, the min initial deposit doesn't change
+	govKeeper.IncrementInactiveProposalsNumber(ctx)
+	require.Equal(t, v1.DefaultMinInitialDepositFloor, govKeeper.GetMinInitialDeposit(ctx))
+
+	// above target, the min initial deposit increases immediately
+	govKeeper.IncrementInactiveProposalsNumber(ctx)
+	require.Equal(t, minInitialDepositAmount(101_000), govKeeper.GetMinInitialDeposit(ctx))
+
+	// and keeps increasing at each update period while above target
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updatePeriod))
+	require.Equal(t, minInitialDepositAmount(102_010), govKeeper.GetMinInitialDeposit(ctx))
+
+	// below target, the min initial deposit decreases until it reaches the floor
+	govKeeper.DecrementInactiveProposalsNumber(ctx)
+	govKeeper.DecrementInactiveProposalsNumber(ctx)
+	require.Equal(t, minInitialDepositAmount(101_499), govKeeper.GetMinInitialDeposit(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * updatePeriod))
+	require.Equal(t, v1.DefaultMinInitialDepositFloor, govKeeper.GetMinInitialDeposit(ctx))
+
+	require.Panics(t, func() { govKeeper.DecrementInactiveProposalsNumber(ctx) })
+}
+
+func TestGetMinInitialDepositOverflow(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	params := govKeeper.GetParams(ctx)
+	updatePeriod := *params.MinInitialDepositThrottler.UpdatePeriod
+	maxMinInitialDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, keeper.MaxDynamicDepositAmount))
+
+	// above target for a very long time, the min initial deposit reaches its
+	// bound instead of overflowing
+	govKeeper.SetInactiveProposalsNumber(ctx, params.MinInitialDepositThrottler.TargetProposals+1)
+	govKeeper.SetLastMinInitialDeposit(ctx, v1.DefaultMinInitialDepositFloor, ctx.BlockTime())
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(100_000 * updatePeriod))
+	require.Equal(t, maxMinInitialDeposit, govKeeper.GetMinInitialDeposit(ctx))
+
+	// and stays at its bound when updated
+	govKeeper.IncrementInactiveProposalsNumber(ctx)
+	require.Equal(t, maxMinInitialDeposit, govKeeper.GetMinInitialDeposit(ctx))
+}
+
+func TestInactiveProposalsNumber(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdk.NewInt(100_000_000))
+
+	// submitted proposals are in deposit period
+	proposal1, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	proposal2, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, govKeeper.GetInactiveProposalsNumber(ctx))
+
+	// proposals entering voting period leave deposit period
+	_, err = govKeeper.AddDeposit(ctx, proposal1.Id, addrs[0], govKeeper.GetMinDeposit(ctx))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, govKeeper.GetInactiveProposalsNumber(ctx))
+
+	// deleted proposals leave deposit period
+	govKeeper.DeleteProposal(ctx, proposal2.Id)
+	require.EqualValues(t, 0, govKeeper.GetInactiveProposalsNumber(ctx))
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"cosmossdk.io/errors"
 
@@ -46,11 +48,17 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
 	if err != nil {
 		return nil, err
 	}
 
+	// proposals are executed all-or-nothing unless specified otherwise
+	if msg.ExecutionPolicy != v1.ExecutionPolicyAllOrNothing {
+		proposal.ExecutionPolicy = msg.ExecutionPolicy
+		k.SetProposal(ctx, proposal)
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -114,6 +122,9 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	if err != nil {
 		return nil, err
 	}
+	if err := k.Keeper.ValidateVoterStake(ctx, accAddr); err != nil {
+		return nil, err
+	}
 	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
 	if err != nil {
 		return nil, err
@@ -129,6 +140,9 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	if accErr != nil {
 		return nil, accErr
 	}
+	if err := k.Keeper.ValidateVoterStake(ctx, accAddr); err != nil {
+		return nil, err
+	}
 	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
 	if err != nil {
 		return nil, err
@@ -144,6 +158,9 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	if err != nil {
 		return nil, err
 	}
+	if err := k.Keeper.ValidateDepositorStake(ctx, accAddr); err != nil {
+		return nil, err
+	}
 
 	if err := validateDeposit(msg.Amount); err != nil {
 		return nil, err
@@ -194,8 +211,52 @@ func (k msgServer) ProposeLaw(goCtx context.Context, msg *v1.MsgProposeLaw) (*v1
 	if k.authority != msg.Authority {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
-	// only a no-op for now
-	return &v1.MsgProposeLawResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalID, ok := govtypes.ExecutedProposalID(ctx)
+	if !ok {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("law can only be ratified by a proposal")
+	}
+	law, err := k.RatifyLaw(ctx, proposalID, msg.Title, msg.Text, msg.Supersedes)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgProposeLawResponse{LawId: law.Id}, nil
+}
+
+// CreateFundingStream implements the MsgServer.CreateFundingStream method.
+func (k msgServer) CreateFundingStream(goCtx context.Context, msg *v1.MsgCreateFundingStream) (*v1.MsgCreateFundingStreamResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalID, ok := govtypes.ExecutedProposalID(ctx)
+	if !ok {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("funding stream can only be created by a proposal")
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := k.Keeper.CreateFundingStream(ctx, proposalID, recipient, msg.Amount, *msg.Interval, *msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgCreateFundingStreamResponse{StreamId: stream.Id}, nil
+}
+
+// CancelFundingStream implements the MsgServer.CancelFundingStream method.
+func (k msgServer) CancelFundingStream(goCtx context.Context, msg *v1.MsgCancelFundingStream) (*v1.MsgCancelFundingStreamResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, ok := govtypes.ExecutedProposalID(ctx); !ok {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("funding stream can only be canceled by a proposal")
+	}
+	if err := k.Keeper.CancelFundingStream(ctx, msg.StreamId); err != nil {
+		return nil, err
+	}
+	return &v1.MsgCancelFundingStreamResponse{}, nil
 }
 
 // ProposeConstitutionAmendment implements the MsgServer.ProposeConstitutionAmendment method.
@@ -207,14 +268,233 @@ func (k msgServer) ProposeConstitutionAmendment(goCtx context.Context, msg *v1.M
 		return nil, govtypes.ErrInvalidProposalMsg.Wrap("amendment cannot be empty")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	constitution, err := k.ApplyConstitutionAmendment(ctx, msg.Amendment)
+	constitution, hunkResults, err := k.ApplyConstitutionAmendment(ctx, msg.Amendment)
 	if err != nil {
 		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
 	}
-	k.SetConstitution(ctx, constitution)
+	proposalID, executedByProposal := govtypes.ExecutedProposalID(ctx)
+	constitutionVersion := k.AmendConstitution(ctx, proposalID, msg.Amendment, constitution)
+	if executedByProposal {
+		k.MarkConflictingAmendmentProposals(ctx, proposalID)
+	}
+
+	// report the offset and fuzz used to apply each hunk of the amendment
+	offsets := make([]string, len(hunkResults))
+	fuzz := make([]string, len(hunkResults))
+	for i, hunkResult := range hunkResults {
+		offsets[i] = strconv.Itoa(hunkResult.Offset)
+		fuzz[i] = strconv.Itoa(hunkResult.Fuzz)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeAmendConstitution,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(govtypes.AttributeKeyConstitutionVersion, fmt.Sprintf("%d", constitutionVersion.Version)),
+			sdk.NewAttribute(govtypes.AttributeKeyHunkOffsets, strings.Join(offsets, ",")),
+			sdk.NewAttribute(govtypes.AttributeKeyHunkFuzz, strings.Join(fuzz, ",")),
+		),
+	)
 	return &v1.MsgProposeConstitutionAmendmentResponse{}, nil
 }
 
+// ProposeStructuredConstitutionAmendment implements the MsgServer.ProposeStructuredConstitutionAmendment method.
+func (k msgServer) ProposeStructuredConstitutionAmendment(goCtx context.Context, msg *v1.MsgProposeStructuredConstitutionAmendment) (*v1.MsgProposeStructuredConstitutionAmendmentResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if len(msg.Operations) == 0 {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("operations cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	structured, err := k.ApplyStructuredConstitutionAmendment(ctx, msg.Operations)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
+	}
+	proposalID, executedByProposal := govtypes.ExecutedProposalID(ctx)
+	constitutionVersion := k.AmendStructuredConstitution(ctx, proposalID, msg.Operations, structured)
+	if executedByProposal {
+		k.MarkConflictingAmendmentProposals(ctx, proposalID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeAmendConstitution,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(govtypes.AttributeKeyConstitutionVersion, fmt.Sprintf("%d", constitutionVersion.Version)),
+		),
+	)
+	return &v1.MsgProposeStructuredConstitutionAmendmentResponse{}, nil
+}
+
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(goCtx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	govAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.Description.EnsureLength(); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetGovernor(ctx, govAddr); found {
+		return nil, govtypes.ErrGovernorExists.Wrapf("governor %s", msg.Address)
+	}
+
+	governor := v1.NewGovernor(govAddr, msg.Description, ctx.BlockTime())
+	if !k.ValidateGovernorMinSelfDelegation(ctx, governor) {
+		return nil, govtypes.ErrInsufficientSelfDelegation.Wrapf("need at least %s bonded tokens", k.GetParams(ctx).MinGovernorSelfDelegation)
+	}
+	k.SetGovernor(ctx, governor)
+	// the votes cast before becoming a governor now carry the voting power
+	// delegated to the governor
+	k.accumulateGovernor(ctx, govAddr)
+
+	// a governor is always delegated to itself, so its voting power is
+	// accounted for when it votes
+	k.UndelegateFromGovernor(ctx, govAddr)
+	k.DelegateToGovernor(ctx, govAddr, govAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeCreateGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+		),
+	)
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// EditGovernor implements the MsgServer.EditGovernor method.
+func (k msgServer) EditGovernor(goCtx context.Context, msg *v1.MsgEditGovernor) (*v1.MsgEditGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	govAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.Description.EnsureLength(); err != nil {
+		return nil, err
+	}
+	if !v1.ValidGovernorStatus(msg.Status) {
+		return nil, govtypes.ErrInvalidGovernorStatus.Wrap(msg.Status.String())
+	}
+	governor, found := k.GetGovernor(ctx, govAddr)
+	if !found {
+		return nil, govtypes.ErrUnknownGovernor.Wrapf("governor %s", msg.Address)
+	}
+
+	governor.Description = msg.Description
+	if msg.Status != governor.Status {
+		statusChangePeriod := *k.GetParams(ctx).GovernorStatusChangePeriod
+		if governor.LastStatusChangeTime.Add(statusChangePeriod).After(ctx.BlockTime()) {
+			return nil, govtypes.ErrGovernorStatusChangePeriod.Wrapf("last status change was at %s, the status change period is %s",
+				governor.LastStatusChangeTime, statusChangePeriod)
+		}
+		if msg.Status == v1.GovernorStatusActive && !k.ValidateGovernorMinSelfDelegation(ctx, governor) {
+			return nil, govtypes.ErrInsufficientSelfDelegation.Wrapf("need at least %s bonded tokens", k.GetParams(ctx).MinGovernorSelfDelegation)
+		}
+		blockTime := ctx.BlockTime()
+		governor.Status = msg.Status
+		governor.LastStatusChangeTime = &blockTime
+	}
+	k.SetGovernor(ctx, governor)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeEditGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+			sdk.NewAttribute(govtypes.AttributeKeyGovernorStatus, governor.Status.String()),
+		),
+	)
+
+	return &v1.MsgEditGovernorResponse{}, nil
+}
+
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(goCtx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	govAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetGovernor(ctx, delAddr); found {
+		return nil, govtypes.ErrDelegatorIsGovernor.Wrapf("governor %s cannot delegate to another governor", msg.DelegatorAddress)
+	}
+	governor, found := k.GetGovernor(ctx, govAddr)
+	if !found {
+		return nil, govtypes.ErrUnknownGovernor.Wrapf("governor %s", msg.GovernorAddress)
+	}
+	if !governor.IsActive() {
+		return nil, govtypes.ErrInvalidGovernorStatus.Wrapf("governor %s is inactive", msg.GovernorAddress)
+	}
+
+	if delegation, found := k.GetGovernanceDelegation(ctx, delAddr); found {
+		if delegation.GovernorAddress == msg.GovernorAddress {
+			return &v1.MsgDelegateGovernorResponse{}, nil
+		}
+		k.UndelegateFromGovernor(ctx, delAddr)
+	}
+	k.DelegateToGovernor(ctx, delAddr, govAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeDelegateGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.GovernorAddress),
+		),
+	)
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(goCtx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetGovernor(ctx, delAddr); found {
+		return nil, govtypes.ErrDelegatorIsGovernor.Wrapf("governor %s cannot undelegate from itself", msg.DelegatorAddress)
+	}
+	delegation, found := k.GetGovernanceDelegation(ctx, delAddr)
+	if !found {
+		return nil, govtypes.ErrNoGovernanceDelegation.Wrapf("delegator %s", msg.DelegatorAddress)
+	}
+	k.UndelegateFromGovernor(ctx, delAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeUndelegateGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
+// CancelProposal implements the MsgServer.CancelProposal method.
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -240,7 +520,7 @@ func (k legacyMsgServer) SubmitProposal(goCtx context.Context, msg *v1beta1.MsgS
 		msg.Proposer,
 		"",
 		msg.GetContent().GetTitle(),
-		msg.GetContent().GetDescription(),
+		msg.GetContent().GetDescription(), false,
 	)
 	if err != nil {
 		return nil, err
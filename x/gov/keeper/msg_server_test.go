@@ -3,13 +3,18 @@ package keeper_test
 import (
 	"fmt"
 	"strings"
+	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/atomone-hub/atomone/x/gov/keeper"
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
@@ -23,7 +28,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
 	initialDeposit := coins
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -43,7 +48,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					proposer.String(),
 					strings.Repeat("1", 300),
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 			},
 			expErr:    true,
@@ -57,7 +62,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 			},
 			expErr:    true,
@@ -71,7 +76,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 			},
 			expErr:    true,
@@ -85,7 +90,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 			},
 			expErr:    true,
@@ -99,7 +104,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 			},
 			expErr: false,
@@ -112,7 +117,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 			},
 			expErr: false,
@@ -137,12 +142,13 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 
 func (suite *KeeperTestSuite) TestVoteReq() {
 	suite.reset()
+	suite.disableMinVoteStakedTokens()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -155,7 +161,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		proposer.String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	suite.Require().NoError(err)
 
@@ -180,7 +186,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -223,7 +229,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -256,12 +262,13 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 
 func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 	suite.reset()
+	suite.disableMinVoteStakedTokens()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -274,7 +281,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		proposer.String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	suite.Require().NoError(err)
 
@@ -300,7 +307,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -343,7 +350,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -380,7 +387,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	minDeposit := sdk.Coins(suite.govKeeper.GetParams(suite.ctx).MinDeposit)
+	minDeposit := sdk.Coins(suite.govKeeper.GetMinDeposit(suite.ctx))
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -393,7 +400,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		proposer.String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	suite.Require().NoError(err)
 
@@ -459,7 +466,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
 	initialDeposit := coins
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 
 	cases := map[string]struct {
 		preRun func() (*v1beta1.MsgSubmitProposal, error)
@@ -503,12 +510,14 @@ func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 }
 
 func (suite *KeeperTestSuite) TestLegacyMsgVote() {
+	suite.reset()
+	suite.disableMinVoteStakedTokens()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -521,7 +530,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		proposer.String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	suite.Require().NoError(err)
 
@@ -546,7 +555,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -579,7 +588,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -612,12 +621,13 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 
 func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 	suite.reset()
+	suite.disableMinVoteStakedTokens()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -630,7 +640,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		proposer.String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	suite.Require().NoError(err)
 
@@ -656,7 +666,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -689,7 +699,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					proposer.String(),
 					"",
 					"Proposal",
-					"description of proposal",
+					"description of proposal", false,
 				)
 				suite.Require().NoError(err)
 
@@ -726,7 +736,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	minDeposit := suite.govKeeper.GetMinDeposit(suite.ctx)
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -739,7 +749,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		proposer.String(),
 		"",
 		"Proposal",
-		"description of proposal",
+		"description of proposal", false,
 	)
 	suite.Require().NoError(err)
 
@@ -822,10 +832,26 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErrMsg: "invalid authority address",
 		},
 		{
-			name: "invalid min deposit",
+			name: "min deposit set manually",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MinDeposit = v1.DefaultMinDepositFloor
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "manually setting min deposit is deprecated in favor of a dynamic min deposit",
+		},
+		{
+			name: "invalid min deposit floor",
 			input: func() *v1.MsgUpdateParams {
 				params1 := params
-				params1.MinDeposit = nil
+				throttler := *params.MinDepositThrottler
+				throttler.FloorValue = nil
+				params1.MinDepositThrottler = &throttler
 
 				return &v1.MsgUpdateParams{
 					Authority: authority,
@@ -839,10 +865,12 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			name: "negative deposit",
 			input: func() *v1.MsgUpdateParams {
 				params1 := params
-				params1.MinDeposit = sdk.Coins{{
+				throttler := *params.MinDepositThrottler
+				throttler.FloorValue = sdk.Coins{{
 					Denom:  sdk.DefaultBondDenom,
 					Amount: sdk.NewInt(-100),
 				}}
+				params1.MinDepositThrottler = &throttler
 
 				return &v1.MsgUpdateParams{
 					Authority: authority,
@@ -1234,43 +1262,37 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
-	baseDepositRatioDec := sdk.NewDec(baseDepositTestPercent).Quo(sdk.NewDec(100))
 
 	testcases := map[string]struct {
-		minDeposit             sdk.Coins
-		minInitialDepositRatio sdk.Dec
-		initialDeposit         sdk.Coins
-		accountBalance         sdk.Coins
+		minInitialDeposit sdk.Coins
+		initialDeposit    sdk.Coins
+		accountBalance    sdk.Coins
 
 		expectError bool
 	}{
 		"meets initial deposit, enough balance - success": {
-			minDeposit:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositRatio: baseDepositRatioDec,
-			initialDeposit:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
-			accountBalance:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			accountBalance:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
 		},
 		"does not meet initial deposit, enough balance - error": {
-			minDeposit:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositRatio: baseDepositRatioDec,
-			initialDeposit:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
-			accountBalance:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
+			accountBalance:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
 
 			expectError: true,
 		},
 		"meets initial deposit, not enough balance - error": {
-			minDeposit:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositRatio: baseDepositRatioDec,
-			initialDeposit:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
-			accountBalance:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			accountBalance:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
 
 			expectError: true,
 		},
 		"does not meet initial deposit and not enough balance - error": {
-			minDeposit:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
-			minInitialDepositRatio: baseDepositRatioDec,
-			initialDeposit:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
-			accountBalance:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
+			minInitialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue))),
+			initialDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
+			accountBalance:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(meetsDepositValue-1))),
 
 			expectError: true,
 		},
@@ -1283,11 +1305,14 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 			address := simtestutil.AddTestAddrs(suite.bankKeeper, suite.stakingKeeper, ctx, 1, tc.accountBalance[0].Amount)[0]
 
 			params := v1.DefaultParams()
-			params.MinDeposit = tc.minDeposit
-			params.MinInitialDepositRatio = tc.minInitialDepositRatio.String()
+			minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount)))
+			params.MinDepositThrottler.FloorValue = minDeposit
+			params.MinInitialDepositThrottler.FloorValue = tc.minInitialDeposit
 			govKeeper.SetParams(ctx, params)
+			govKeeper.SetLastMinDeposit(ctx, minDeposit, ctx.BlockTime())
+			govKeeper.SetLastMinInitialDeposit(ctx, tc.minInitialDeposit, ctx.BlockTime())
 
-			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test", "Proposal", "description of proposal")
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test", "Proposal", "description of proposal", false)
 			suite.Require().NoError(err)
 
 			// System under test
@@ -1347,7 +1372,7 @@ func (suite *KeeperTestSuite) TestProposeConstitutionAmendment() {
 
 	for name, tc := range cases {
 		suite.Run(name, func() {
-			_, err := suite.msgSrvr.ProposeConstitutionAmendment(sdk.WrapSDKContext(ctx), tc.msg)
+			_, err := suite.msgSrvr.ProposeConstitutionAmendment(sdk.WrapSDKContext(govtypes.WithExecutedProposalID(ctx, 3)), tc.msg)
 			if tc.expErr {
 				suite.Require().Error(err)
 				if tc.expErrMsg != "" {
@@ -1356,7 +1381,622 @@ func (suite *KeeperTestSuite) TestProposeConstitutionAmendment() {
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().Equal(tc.expResult, suite.govKeeper.GetConstitution(ctx))
+				// the amendment is recorded in the constitution history
+				constitutionVersion, found := suite.govKeeper.GetLatestConstitutionVersion(ctx)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(3), constitutionVersion.ProposalId)
+				suite.Require().Equal(tc.msg.Amendment, constitutionVersion.Amendment)
+				suite.Require().Equal(tc.expResult, constitutionVersion.Constitution)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProposeStructuredConstitutionAmendment() {
+	ctx := suite.ctx
+	authority := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	proposalCtx := govtypes.WithExecutedProposalID(ctx, 5)
+	suite.govKeeper.SetConstitution(ctx, "Hello World")
+
+	// a free-form constitution cannot be amended by article
+	_, err := suite.msgSrvr.ProposeStructuredConstitutionAmendment(sdk.WrapSDKContext(proposalCtx),
+		v1.NewMsgProposeStructuredConstitutionAmendment(authority, []*v1.ArticleOperation{{
+			Type:    v1.ArticleOperationInsert,
+			Article: &v1.ConstitutionArticle{Id: "art-1", Title: "Article 1", Text: "Hello"},
+		}}))
+	suite.Require().ErrorContains(err, "the constitution is not structured")
+	suite.Require().Equal("Hello World", suite.govKeeper.GetConstitution(ctx))
+	suite.govKeeper.SetStructuredConstitution(ctx, v1.StructuredConstitution{})
+
+	// cases are run in order, on the structured constitution left by the
+	// previous case
+	cases := []struct {
+		name      string
+		msg       *v1.MsgProposeStructuredConstitutionAmendment
+		expErrMsg string
+		expResult string
+	}{
+		{
+			name: "invalid authority",
+			msg: v1.NewMsgProposeStructuredConstitutionAmendment(sdk.AccAddress("invalid"), []*v1.ArticleOperation{{
+				Type:    v1.ArticleOperationInsert,
+				Article: &v1.ConstitutionArticle{Id: "art-1", Title: "Article 1", Text: "Hello"},
+			}}),
+			expErrMsg: govtypes.ErrInvalidSigner.Error(),
+		},
+		{
+			name: "unknown article",
+			msg: v1.NewMsgProposeStructuredConstitutionAmendment(authority, []*v1.ArticleOperation{{
+				Type:      v1.ArticleOperationDelete,
+				ArticleId: "art-1",
+			}}),
+			expErrMsg: govtypes.ErrUnknownConstitutionArticle.Error(),
+		},
+		{
+			name: "structure the constitution",
+			msg: v1.NewMsgProposeStructuredConstitutionAmendment(authority, []*v1.ArticleOperation{{
+				Type:    v1.ArticleOperationInsert,
+				Article: &v1.ConstitutionArticle{Id: "art-1", Title: "Article 1", Text: "Hello"},
+			}}),
+			expResult: "# Article 1\n\nHello\n",
+		},
+		{
+			name: "replace and insert",
+			msg: v1.NewMsgProposeStructuredConstitutionAmendment(authority, []*v1.ArticleOperation{
+				{
+					Type:      v1.ArticleOperationReplace,
+					ArticleId: "art-1",
+					Article:   &v1.ConstitutionArticle{Title: "Article 1", Text: "Hi"},
+				},
+				{
+					Type:     v1.ArticleOperationInsert,
+					ParentId: "art-1",
+					Article:  &v1.ConstitutionArticle{Id: "art-1.1", Title: "Section 1", Text: "World"},
+				},
+			}),
+			expResult: "# Article 1\n\nHi\n\n## Section 1\n\nWorld\n",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.ProposeStructuredConstitutionAmendment(sdk.WrapSDKContext(proposalCtx), tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResult, suite.govKeeper.GetConstitution(ctx))
+			// the operations are recorded in the constitution history
+			constitutionVersion, found := suite.govKeeper.GetLatestConstitutionVersion(ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(5), constitutionVersion.ProposalId)
+			suite.Require().Equal(tc.msg.Operations, constitutionVersion.Operations)
+			suite.Require().Empty(constitutionVersion.Amendment)
+			suite.Require().Equal(tc.expResult, constitutionVersion.Constitution)
+		})
+	}
+
+	// a structured constitution can no longer be amended with a diff
+	_, err = suite.msgSrvr.ProposeConstitutionAmendment(sdk.WrapSDKContext(proposalCtx),
+		v1.NewMsgProposeConstitutionAmendment(authority, "@@ -3 +3 @@\n-Hi\n+Hello"))
+	suite.Require().ErrorContains(err, govtypes.ErrInvalidConstitutionAmendment.Error())
+}
+
+func (suite *KeeperTestSuite) TestProposeLaw() {
+	ctx := suite.ctx
+	authority := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	proposalCtx := govtypes.WithExecutedProposalID(ctx, 4)
+
+	// cases are run in order, the second law supersedes the first one
+	cases := []struct {
+		name      string
+		ctx       sdk.Context
+		msg       *v1.MsgProposeLaw
+		expErrMsg string
+		expLawID  uint64
+	}{
+		{
+			name:     "successful law",
+			ctx:      proposalCtx,
+			msg:      v1.NewMsgProposeLaw(authority, "title", "text", nil),
+			expLawID: 1,
+		},
+		{
+			name:     "successful law superseding a law",
+			ctx:      proposalCtx,
+			msg:      v1.NewMsgProposeLaw(authority, "title", "text", []uint64{1}),
+			expLawID: 2,
+		},
+		{
+			name:      "unknown superseded law",
+			ctx:       proposalCtx,
+			msg:       v1.NewMsgProposeLaw(authority, "title", "text", []uint64{5}),
+			expErrMsg: "superseded law 5 doesn't exist",
+		},
+		{
+			name:      "not executed by a proposal",
+			ctx:       ctx,
+			msg:       v1.NewMsgProposeLaw(authority, "title", "text", nil),
+			expErrMsg: "law can only be ratified by a proposal",
+		},
+		{
+			name:      "invalid authority",
+			ctx:       proposalCtx,
+			msg:       v1.NewMsgProposeLaw(sdk.AccAddress("invalid"), "title", "text", nil),
+			expErrMsg: govtypes.ErrInvalidSigner.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			res, err := suite.msgSrvr.ProposeLaw(sdk.WrapSDKContext(tc.ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLawID, res.LawId)
+			law, found := suite.govKeeper.GetLaw(ctx, tc.expLawID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(4), law.ProposalId)
+			suite.Require().Equal(tc.msg.Title, law.Title)
+			suite.Require().Equal(tc.msg.Text, law.Text)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateFundingStream() {
+	suite.reset()
+	ctx := suite.ctx
+	authority := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	proposalCtx := govtypes.WithExecutedProposalID(ctx, 4)
+	recipient := suite.addrs[0]
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	endTime := ctx.BlockTime().Add(30 * 24 * time.Hour)
+
+	cases := []struct {
+		name        string
+		ctx         sdk.Context
+		msg         *v1.MsgCreateFundingStream
+		expErrMsg   string
+		expStreamID uint64
+	}{
+		{
+			name:        "successful funding stream",
+			ctx:         proposalCtx,
+			msg:         v1.NewMsgCreateFundingStream(authority, recipient, amount, 24*time.Hour, endTime),
+			expStreamID: 1,
+		},
+		{
+			name:      "end time before the first payout",
+			ctx:       proposalCtx,
+			msg:       v1.NewMsgCreateFundingStream(authority, recipient, amount, 31*24*time.Hour, endTime),
+			expErrMsg: "is before the first payout time",
+		},
+		{
+			name:      "not executed by a proposal",
+			ctx:       ctx,
+			msg:       v1.NewMsgCreateFundingStream(authority, recipient, amount, 24*time.Hour, endTime),
+			expErrMsg: "funding stream can only be created by a proposal",
+		},
+		{
+			name:      "invalid authority",
+			ctx:       proposalCtx,
+			msg:       v1.NewMsgCreateFundingStream(sdk.AccAddress("invalid"), recipient, amount, 24*time.Hour, endTime),
+			expErrMsg: govtypes.ErrInvalidSigner.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			res, err := suite.msgSrvr.CreateFundingStream(sdk.WrapSDKContext(tc.ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStreamID, res.StreamId)
+			stream, found := suite.govKeeper.GetFundingStream(ctx, tc.expStreamID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(4), stream.ProposalId)
+			suite.Require().Equal(tc.msg.Recipient, stream.Recipient)
+			suite.Require().Equal(amount, sdk.NewCoins(stream.Amount...))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelFundingStream() {
+	suite.reset()
+	ctx := suite.ctx
+	authority := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	proposalCtx := govtypes.WithExecutedProposalID(ctx, 4)
+	stream, err := suite.govKeeper.CreateFundingStream(ctx, 1, suite.addrs[0],
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, ctx.BlockTime().Add(24*time.Hour))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.CancelFundingStream(proposalCtx, v1.NewMsgCancelFundingStream(sdk.AccAddress("invalid"), stream.Id))
+	suite.Require().ErrorContains(err, govtypes.ErrInvalidSigner.Error())
+
+	// a direct cancel, not executed by a proposal, is rejected
+	_, err = suite.msgSrvr.CancelFundingStream(ctx, v1.NewMsgCancelFundingStream(authority, stream.Id))
+	suite.Require().ErrorContains(err, "funding stream can only be canceled by a proposal")
+	_, found := suite.govKeeper.GetFundingStream(ctx, stream.Id)
+	suite.Require().True(found)
+
+	_, err = suite.msgSrvr.CancelFundingStream(proposalCtx, v1.NewMsgCancelFundingStream(authority, stream.Id))
+	suite.Require().NoError(err)
+	_, found = suite.govKeeper.GetFundingStream(ctx, stream.Id)
+	suite.Require().False(found)
+
+	_, err = suite.msgSrvr.CancelFundingStream(proposalCtx, v1.NewMsgCancelFundingStream(authority, stream.Id))
+	suite.Require().ErrorIs(err, govtypes.ErrUnknownFundingStream)
+}
+
+// setupGovernorMsgServer returns a gov msg server backed by a mock staking
+// state, where addrs[0] has self-delegated enough tokens to be a governor
+// and addrs[1] has not.
+func setupGovernorMsgServer(t *testing.T) (v1.MsgServer, *keeper.Keeper, sdk.Context, []sdk.AccAddress) {
+	t.Helper()
+	st := newMockStakingState()
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockStakingStateExpectations(st))
+	addrs := simtestutil.CreateRandomAccounts(4)
+	valAddr := sdk.ValAddress(addrs[3])
+	minSelfDelegation := v1.DefaultMinGovernorSelfDelegation.Int64()
+	st.delegate(addrs[0], valAddr, minSelfDelegation)
+	st.delegate(addrs[1], valAddr, minSelfDelegation-1)
+	st.delegate(addrs[2], valAddr, 1)
+	return keeper.NewMsgServerImpl(govKeeper), govKeeper, ctx, addrs
+}
+
+func TestCreateGovernorReq(t *testing.T) {
+	msgSrvr, govKeeper, ctx, addrs := setupGovernorMsgServer(t)
+	longMoniker := strings.Repeat("a", v1.MaxMonikerLength+1)
+
+	tests := []struct {
+		name      string
+		msg       *v1.MsgCreateGovernor
+		expErrMsg string
+	}{
+		{
+			name:      "invalid description",
+			msg:       v1.NewMsgCreateGovernor(addrs[0], v1.NewGovernorDescription(longMoniker, "", "", "", "")),
+			expErrMsg: "invalid moniker length",
+		},
+		{
+			name:      "insufficient self delegation",
+			msg:       v1.NewMsgCreateGovernor(addrs[1], v1.NewGovernorDescription("gov", "", "", "", "")),
+			expErrMsg: govtypes.ErrInsufficientSelfDelegation.Error(),
+		},
+		{
+			name: "valid governor",
+			msg:  v1.NewMsgCreateGovernor(addrs[0], v1.NewGovernorDescription("gov", "", "", "", "")),
+		},
+		{
+			name:      "governor already exists",
+			msg:       v1.NewMsgCreateGovernor(addrs[0], v1.NewGovernorDescription("gov", "", "", "", "")),
+			expErrMsg: govtypes.ErrGovernorExists.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgSrvr.CreateGovernor(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			governor, found := govKeeper.GetGovernor(ctx, addrs[0])
+			require.True(t, found)
+			require.True(t, governor.IsActive())
+			// governor is delegated to itself
+			delegation, found := govKeeper.GetGovernanceDelegation(ctx, addrs[0])
+			require.True(t, found)
+			require.Equal(t, addrs[0].String(), delegation.GovernorAddress)
+			require.Len(t, govKeeper.GetAllGovernorValShares(ctx, addrs[0]), 1)
+		})
+	}
+}
+
+func TestEditGovernorReq(t *testing.T) {
+	msgSrvr, govKeeper, ctx, addrs := setupGovernorMsgServer(t)
+	_, err := msgSrvr.CreateGovernor(sdk.WrapSDKContext(ctx), v1.NewMsgCreateGovernor(addrs[0], v1.GovernorDescription{}))
+	require.NoError(t, err)
+	// addrs[1] doesn't have enough self-delegation to be active
+	govKeeper.SetGovernor(ctx, v1.Governor{
+		GovernorAddress:      addrs[1].String(),
+		Status:               v1.GovernorStatusInactive,
+		LastStatusChangeTime: &time.Time{},
+	})
+	statusChangePeriod := *govKeeper.GetParams(ctx).GovernorStatusChangePeriod
+	desc := v1.NewGovernorDescription("new moniker", "", "", "", "")
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		msg       *v1.MsgEditGovernor
+		expErrMsg string
+		expStatus v1.GovernorStatus
+	}{
+		{
+			name:      "unknown governor",
+			msg:       v1.NewMsgEditGovernor(addrs[2], desc, v1.GovernorStatusActive),
+			expErrMsg: govtypes.ErrUnknownGovernor.Error(),
+		},
+		{
+			name:      "invalid status",
+			msg:       v1.NewMsgEditGovernor(addrs[0], desc, v1.GovernorStatusUnspecified),
+			expErrMsg: govtypes.ErrInvalidGovernorStatus.Error(),
+		},
+		{
+			name:      "edit description only",
+			msg:       v1.NewMsgEditGovernor(addrs[0], desc, v1.GovernorStatusActive),
+			expStatus: v1.GovernorStatusActive,
+		},
+		{
+			name:      "status change too early",
+			msg:       v1.NewMsgEditGovernor(addrs[0], desc, v1.GovernorStatusInactive),
+			expErrMsg: govtypes.ErrGovernorStatusChangePeriod.Error(),
+		},
+		{
+			name:      "status change after period",
+			blockTime: ctx.BlockTime().Add(statusChangePeriod),
+			msg:       v1.NewMsgEditGovernor(addrs[0], desc, v1.GovernorStatusInactive),
+			expStatus: v1.GovernorStatusInactive,
+		},
+		{
+			name:      "activation with insufficient self delegation",
+			blockTime: ctx.BlockTime().Add(statusChangePeriod),
+			msg:       v1.NewMsgEditGovernor(addrs[1], desc, v1.GovernorStatusActive),
+			expErrMsg: govtypes.ErrInsufficientSelfDelegation.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
+			_, err := msgSrvr.EditGovernor(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			governor, found := govKeeper.GetGovernor(ctx, sdk.MustAccAddressFromBech32(tc.msg.Address))
+			require.True(t, found)
+			require.Equal(t, tc.expStatus, governor.Status)
+			require.Equal(t, desc, governor.Description)
+		})
+	}
+}
+
+func TestDelegateGovernorReq(t *testing.T) {
+	msgSrvr, govKeeper, ctx, addrs := setupGovernorMsgServer(t)
+	_, err := msgSrvr.CreateGovernor(sdk.WrapSDKContext(ctx), v1.NewMsgCreateGovernor(addrs[0], v1.GovernorDescription{}))
+	require.NoError(t, err)
+	govKeeper.SetGovernor(ctx, v1.Governor{
+		GovernorAddress:      addrs[1].String(),
+		Status:               v1.GovernorStatusInactive,
+		LastStatusChangeTime: &time.Time{},
+	})
+
+	tests := []struct {
+		name      string
+		msg       *v1.MsgDelegateGovernor
+		expErrMsg string
+	}{
+		{
+			name:      "governor delegates to another governor",
+			msg:       v1.NewMsgDelegateGovernor(addrs[1], addrs[0]),
+			expErrMsg: govtypes.ErrDelegatorIsGovernor.Error(),
+		},
+		{
+			name:      "unknown governor",
+			msg:       v1.NewMsgDelegateGovernor(addrs[2], addrs[3]),
+			expErrMsg: govtypes.ErrUnknownGovernor.Error(),
+		},
+		{
+			name:      "inactive governor",
+			msg:       v1.NewMsgDelegateGovernor(addrs[2], addrs[1]),
+			expErrMsg: govtypes.ErrInvalidGovernorStatus.Error(),
+		},
+		{
+			name: "valid delegation",
+			msg:  v1.NewMsgDelegateGovernor(addrs[2], addrs[0]),
+		},
+		{
+			name: "same delegation again",
+			msg:  v1.NewMsgDelegateGovernor(addrs[2], addrs[0]),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgSrvr.DelegateGovernor(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			delegation, found := govKeeper.GetGovernanceDelegation(ctx, addrs[2])
+			require.True(t, found)
+			require.Equal(t, addrs[0].String(), delegation.GovernorAddress)
+			// governor shares include its own and the delegator's
+			valShares, found := govKeeper.GetGovernorValShares(ctx, addrs[0], sdk.ValAddress(addrs[3]))
+			require.True(t, found)
+			require.Equal(t, v1.DefaultMinGovernorSelfDelegation.AddRaw(1).Int64(), valShares.Shares.TruncateInt64())
+		})
+	}
+}
+
+func TestUndelegateGovernorReq(t *testing.T) {
+	msgSrvr, govKeeper, ctx, addrs := setupGovernorMsgServer(t)
+	_, err := msgSrvr.CreateGovernor(sdk.WrapSDKContext(ctx), v1.NewMsgCreateGovernor(addrs[0], v1.GovernorDescription{}))
+	require.NoError(t, err)
+	_, err = msgSrvr.DelegateGovernor(sdk.WrapSDKContext(ctx), v1.NewMsgDelegateGovernor(addrs[2], addrs[0]))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		msg       *v1.MsgUndelegateGovernor
+		expErrMsg string
+	}{
+		{
+			name:      "governor undelegates from itself",
+			msg:       v1.NewMsgUndelegateGovernor(addrs[0]),
+			expErrMsg: govtypes.ErrDelegatorIsGovernor.Error(),
+		},
+		{
+			name:      "no governance delegation",
+			msg:       v1.NewMsgUndelegateGovernor(addrs[1]),
+			expErrMsg: govtypes.ErrNoGovernanceDelegation.Error(),
+		},
+		{
+			name: "valid undelegation",
+			msg:  v1.NewMsgUndelegateGovernor(addrs[2]),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgSrvr.UndelegateGovernor(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			_, found := govKeeper.GetGovernanceDelegation(ctx, addrs[2])
+			require.False(t, found)
+			valShares, found := govKeeper.GetGovernorValShares(ctx, addrs[0], sdk.ValAddress(addrs[3]))
+			require.True(t, found)
+			require.Equal(t, v1.DefaultMinGovernorSelfDelegation.Int64(), valShares.Shares.TruncateInt64())
+		})
+	}
+}
+
+func TestVoteMinStakedTokens(t *testing.T) {
+	msgSrvr, govKeeper, ctx, addrs := setupGovernorMsgServer(t)
+	legacyMsgSrvr := keeper.NewLegacyMsgServerImpl(govAcct.String(), msgSrvr)
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", addrs[0], false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	govKeeper.SetProposal(ctx, proposal)
+
+	vote := func(voter sdk.AccAddress) []error {
+		_, voteErr := msgSrvr.Vote(ctx, v1.NewMsgVote(voter, proposal.Id, v1.OptionYes, ""))
+		_, voteWeightedErr := msgSrvr.VoteWeighted(ctx, v1.NewMsgVoteWeighted(voter, proposal.Id, v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+		_, legacyVoteErr := legacyMsgSrvr.Vote(ctx, v1beta1.NewMsgVote(voter, proposal.Id, v1beta1.OptionYes))
+		_, legacyVoteWeightedErr := legacyMsgSrvr.VoteWeighted(ctx, v1beta1.NewMsgVoteWeighted(voter, proposal.Id, v1beta1.NewNonSplitVoteOption(v1beta1.OptionNo)))
+		return []error{voteErr, voteWeightedErr, legacyVoteErr, legacyVoteWeightedErr}
+	}
+
+	// addrs[0] and addrs[1] have enough stake
+	for _, voter := range addrs[:2] {
+		for _, err := range vote(voter) {
+			require.NoError(t, err)
+		}
+	}
+	// addrs[2] doesn't
+	for _, err := range vote(addrs[2]) {
+		require.ErrorIs(t, err, govtypes.ErrInsufficientStake)
+	}
+	// a zero minimum disables the check
+	params := govKeeper.GetParams(ctx)
+	params.MinVoteStakedTokens = sdk.ZeroInt().String()
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	for _, err := range vote(addrs[2]) {
+		require.NoError(t, err)
+	}
+}
+
+func TestDepositMinStakedTokens(t *testing.T) {
+	st := newMockStakingState()
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockStakingStateExpectations(st))
+	msgSrvr := keeper.NewMsgServerImpl(govKeeper)
+	legacyMsgSrvr := keeper.NewLegacyMsgServerImpl(govAcct.String(), msgSrvr)
+	addrs := simtestutil.CreateRandomAccounts(3)
+	st.delegate(addrs[0], sdk.ValAddress(addrs[2]), 1000000)
+	for _, addr := range addrs[:2] {
+		require.NoError(t, banktestutil.FundAccount(mocks.bankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000))))
+	}
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", addrs[0], false)
+	require.NoError(t, err)
+	params := govKeeper.GetParams(ctx)
+	params.MinDepositStakedTokens = "1000000"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100000))
+	deposit := func(depositor sdk.AccAddress) []error {
+		_, depositErr := msgSrvr.Deposit(ctx, v1.NewMsgDeposit(depositor, proposal.Id, amount))
+		_, legacyDepositErr := legacyMsgSrvr.Deposit(ctx, v1beta1.NewMsgDeposit(depositor, proposal.Id, amount))
+		return []error{depositErr, legacyDepositErr}
+	}
+
+	// addrs[0] has enough stake
+	for _, err := range deposit(addrs[0]) {
+		require.NoError(t, err)
+	}
+	// addrs[1] doesn't
+	for _, err := range deposit(addrs[1]) {
+		require.ErrorIs(t, err, govtypes.ErrInsufficientStake)
+	}
+	// a zero minimum disables the check
+	params.MinDepositStakedTokens = sdk.ZeroInt().String()
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	for _, err := range deposit(addrs[1]) {
+		require.NoError(t, err)
+	}
+}
+
+func TestCancelProposalReq(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	msgSrvr := keeper.NewMsgServerImpl(govKeeper)
+	addrs := simtestutil.CreateRandomAccounts(2)
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		msg       *v1.MsgCancelProposal
+		expErrMsg string
+	}{
+		{
+			name:      "invalid proposer address",
+			msg:       &v1.MsgCancelProposal{ProposalId: proposal.Id, Proposer: "invalid"},
+			expErrMsg: "decoding bech32 failed",
+		},
+		{
+			name:      "unknown proposal",
+			msg:       v1.NewMsgCancelProposal(proposal.Id+1, addrs[0]),
+			expErrMsg: govtypes.ErrUnknownProposal.Error(),
+		},
+		{
+			name:      "not the proposer",
+			msg:       v1.NewMsgCancelProposal(proposal.Id, addrs[1]),
+			expErrMsg: govtypes.ErrInvalidProposer.Error(),
+		},
+		{
+			name: "valid cancellation",
+			msg:  v1.NewMsgCancelProposal(proposal.Id, addrs[0]),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := msgSrvr.CancelProposal(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, proposal.Id, res.ProposalId)
+			require.Equal(t, ctx.BlockTime(), res.CanceledTime)
+			require.Equal(t, uint64(ctx.BlockHeight()), res.CanceledHeight)
+			_, found := govKeeper.GetProposal(ctx, proposal.Id)
+			require.False(t, found)
 		})
 	}
 }
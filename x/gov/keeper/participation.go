@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// participationEMAWeight is the weight given to the participation of a newly
+// tallied proposal when updating the participation exponential moving
+// averages.
+var participationEMAWeight = sdk.NewDecWithPrec(2, 1)
+
+// GetParticipationEMA returns the participation exponential moving average of
+// regular proposals.
+func (keeper Keeper) GetParticipationEMA(ctx sdk.Context) sdk.Dec {
+	return keeper.getParticipationEMA(ctx, types.ParticipationEMAKey)
+}
+
+// SetParticipationEMA sets the participation exponential moving average of
+// regular proposals.
+func (keeper Keeper) SetParticipationEMA(ctx sdk.Context, participationEMA sdk.Dec) {
+	keeper.setParticipationEMA(ctx, types.ParticipationEMAKey, participationEMA)
+}
+
+// GetConstitutionAmendmentParticipationEMA returns the participation
+// exponential moving average of constitution amendment proposals.
+func (keeper Keeper) GetConstitutionAmendmentParticipationEMA(ctx sdk.Context) sdk.Dec {
+	return keeper.getParticipationEMA(ctx, types.ConstitutionAmendmentParticipationEMAKey)
+}
+
+// SetConstitutionAmendmentParticipationEMA sets the participation exponential
+// moving average of constitution amendment proposals.
+func (keeper Keeper) SetConstitutionAmendmentParticipationEMA(ctx sdk.Context, participationEMA sdk.Dec) {
+	keeper.setParticipationEMA(ctx, types.ConstitutionAmendmentParticipationEMAKey, participationEMA)
+}
+
+// GetLawParticipationEMA returns the participation exponential moving average
+// of law proposals.
+func (keeper Keeper) GetLawParticipationEMA(ctx sdk.Context) sdk.Dec {
+	return keeper.getParticipationEMA(ctx, types.LawParticipationEMAKey)
+}
+
+// SetLawParticipationEMA sets the participation exponential moving average of
+// law proposals.
+func (keeper Keeper) SetLawParticipationEMA(ctx sdk.Context, participationEMA sdk.Dec) {
+	keeper.setParticipationEMA(ctx, types.LawParticipationEMAKey, participationEMA)
+}
+
+func (keeper Keeper) getParticipationEMA(ctx sdk.Context, key []byte) sdk.Dec {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return v1.DefaultParticipationEMA
+	}
+	var participationEMA sdk.Dec
+	if err := participationEMA.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return participationEMA
+}
+
+func (keeper Keeper) setParticipationEMA(ctx sdk.Context, key []byte, participationEMA sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz, err := participationEMA.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// UpdateParticipationEMA updates the participation exponential moving average
+// matching the kind of the tallied proposal, using the participation of its
+// final tally results. A proposal containing a constitution amendment updates
+// the constitution amendment average, a proposal containing a law updates the
+// law average, and any other proposal updates the regular average.
+func (keeper Keeper) UpdateParticipationEMA(ctx sdk.Context, proposal v1.Proposal, tallyResults v1.TallyResult) {
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return
+	}
+
+	totalVotes := sdk.ZeroInt()
+	for _, count := range []string{tallyResults.YesCount, tallyResults.NoCount, tallyResults.AbstainCount} {
+		c, ok := sdk.NewIntFromString(count)
+		if !ok {
+			panic(fmt.Sprintf("invalid tally count %s for proposal %d", count, proposal.Id))
+		}
+		totalVotes = totalVotes.Add(c)
+	}
+	participation := sdk.NewDecFromInt(totalVotes).QuoInt(totalBonded)
+	if participation.GT(sdk.OneDec()) {
+		participation = sdk.OneDec()
+	}
+
+	get, set := keeper.GetParticipationEMA, keeper.SetParticipationEMA
+	switch {
+	case keeper.proposalHasMsg(proposal, &v1.MsgProposeConstitutionAmendment{}),
+		keeper.proposalHasMsg(proposal, &v1.MsgProposeStructuredConstitutionAmendment{}):
+		get, set = keeper.GetConstitutionAmendmentParticipationEMA, keeper.SetConstitutionAmendmentParticipationEMA
+	case keeper.proposalHasMsg(proposal, &v1.MsgProposeLaw{}):
+		get, set = keeper.GetLawParticipationEMA, keeper.SetLawParticipationEMA
+	}
+	oldEMA := get(ctx)
+	newEMA := oldEMA.Mul(sdk.OneDec().Sub(participationEMAWeight)).Add(participation.Mul(participationEMAWeight))
+	set(ctx, newEMA)
+}
+
+// proposalHasMsg returns true if one of the proposal's messages has the same
+// type URL as msg.
+func (keeper Keeper) proposalHasMsg(proposal v1.Proposal, msg sdk.Msg) bool {
+	typeURL := sdk.MsgTypeURL(msg)
+	for _, m := range proposal.Messages {
+		if m.TypeUrl == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// GetQuorums returns the quorums currently required for regular, constitution
+// amendment and law proposals. If the dynamic quorum is enabled, they are
+// derived from the participation exponential moving averages within the
+// quorum ranges, otherwise the fixed quorums are returned.
+func (keeper Keeper) GetQuorums(ctx sdk.Context) (quorum, constitutionAmendmentQuorum, lawQuorum sdk.Dec, err error) {
+	params := keeper.GetParams(ctx)
+	if params.DynamicQuorum {
+		quorum = params.QuorumRange.Compute(keeper.GetParticipationEMA(ctx))
+		constitutionAmendmentQuorum = params.ConstitutionAmendmentQuorumRange.Compute(keeper.GetConstitutionAmendmentParticipationEMA(ctx))
+		lawQuorum = params.LawQuorumRange.Compute(keeper.GetLawParticipationEMA(ctx))
+		return quorum, constitutionAmendmentQuorum, lawQuorum, nil
+	}
+
+	quorum, err = sdk.NewDecFromStr(params.Quorum)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.Quorum: %w", err)
+	}
+	constitutionAmendmentQuorum, err = sdk.NewDecFromStr(params.ConstitutionAmendmentQuorum)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.ConstitutionAmendmentQuorum: %w", err)
+	}
+	lawQuorum, err = sdk.NewDecFromStr(params.LawQuorum)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.LawQuorum: %w", err)
+	}
+	return quorum, constitutionAmendmentQuorum, lawQuorum, nil
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestUpdateParticipationEMA(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	authority := authtypes.NewModuleAddress(types.ModuleName).String()
+	newProposal := func(msgs ...sdk.Msg) v1.Proposal {
+		proposal, err := v1.NewProposal(msgs, 1, ctx.BlockTime(), ctx.BlockTime(), "", "title", "summary", sdk.AccAddress("proposer"), false)
+		require.NoError(t, err)
+		return proposal
+	}
+	lawMsg := &v1.MsgProposeLaw{Authority: authority}
+	amendmentMsg := &v1.MsgProposeConstitutionAmendment{Authority: authority}
+
+	// initial values are the defaults
+	require.Equal(t, v1.DefaultParticipationEMA, govKeeper.GetParticipationEMA(ctx))
+	require.Equal(t, v1.DefaultConstitutionAmendmentParticipationEMA, govKeeper.GetConstitutionAmendmentParticipationEMA(ctx))
+	require.Equal(t, v1.DefaultLawParticipationEMA, govKeeper.GetLawParticipationEMA(ctx))
+
+	// 40% participation on a regular proposal (total bonded is 10,000,000)
+	govKeeper.UpdateParticipationEMA(ctx, newProposal(),
+		v1.NewTallyResult(math.NewInt(2_000_000), math.NewInt(1_000_000), math.NewInt(1_000_000)))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.38"), govKeeper.GetParticipationEMA(ctx))
+	require.Equal(t, v1.DefaultConstitutionAmendmentParticipationEMA, govKeeper.GetConstitutionAmendmentParticipationEMA(ctx))
+	require.Equal(t, v1.DefaultLawParticipationEMA, govKeeper.GetLawParticipationEMA(ctx))
+
+	// no participation on a law proposal
+	govKeeper.UpdateParticipationEMA(ctx, newProposal(lawMsg), v1.EmptyTallyResult())
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.38"), govKeeper.GetParticipationEMA(ctx))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), govKeeper.GetLawParticipationEMA(ctx))
+
+	// full participation on a proposal with both a law and a constitution
+	// amendment, which counts as a constitution amendment proposal
+	govKeeper.UpdateParticipationEMA(ctx, newProposal(lawMsg, amendmentMsg),
+		v1.NewTallyResult(math.NewInt(10_000_000), math.ZeroInt(), math.ZeroInt()))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), govKeeper.GetConstitutionAmendmentParticipationEMA(ctx))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), govKeeper.GetLawParticipationEMA(ctx))
+}
+
+func TestGetQuorums(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+
+	// fixed quorums
+	quorum, amendmentQuorum, lawQuorum, err := govKeeper.GetQuorums(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultQuorum, quorum)
+	require.Equal(t, v1.DefaultConstitutionAmendmentQuorum, amendmentQuorum)
+	require.Equal(t, v1.DefaultLawQuorum, lawQuorum)
+
+	// dynamic quorums with the default participation EMAs match the fixed
+	// default quorums
+	params := govKeeper.GetParams(ctx)
+	params.DynamicQuorum = true
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	quorum, amendmentQuorum, lawQuorum, err = govKeeper.GetQuorums(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultQuorum, quorum)
+	require.Equal(t, v1.DefaultConstitutionAmendmentQuorum, amendmentQuorum)
+	require.Equal(t, v1.DefaultLawQuorum, lawQuorum)
+
+	// dynamic quorums are bounded by the quorum ranges
+	govKeeper.SetParticipationEMA(ctx, math.LegacyZeroDec())
+	govKeeper.SetConstitutionAmendmentParticipationEMA(ctx, math.LegacyOneDec())
+	govKeeper.SetLawParticipationEMA(ctx, math.LegacyMustNewDecFromStr("0.5"))
+	quorum, amendmentQuorum, lawQuorum, err = govKeeper.GetQuorums(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultQuorumRangeMin, quorum)
+	require.Equal(t, v1.DefaultConstitutionAmendmentQuorumRangeMax, amendmentQuorum)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), lawQuorum)
+}
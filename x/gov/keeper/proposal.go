@@ -7,6 +7,7 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -15,7 +16,7 @@ import (
 )
 
 // SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -33,6 +34,20 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 		return v1.Proposal{}, err
 	}
 
+	params := keeper.GetParams(ctx)
+
+	// Expedited proposals can only contain the messages of the allowlist
+	if expedited {
+		if len(messages) == 0 {
+			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidExpeditedProposal, "expedited proposals must contain at least one message")
+		}
+		for _, msg := range messages {
+			if typeURL := sdk.MsgTypeURL(msg); !params.IsExpeditedAllowedMsgTypeURL(typeURL) {
+				return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidExpeditedProposal, "message %s is not allowed in expedited proposals", typeURL)
+			}
+		}
+	}
+
 	// Will hold a comma-separated string of all Msg type URLs.
 	msgsStr := ""
 
@@ -41,31 +56,16 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// perform a basic validation of the message
-		if err := msg.ValidateBasic(); err != nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := keeper.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		handler, err := keeper.proposalMsgHandler(ctx, msg)
+		if err != nil {
+			return v1.Proposal{}, err
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
 		// proposal in a cached context.
 		// For other Msgs, we do not verify the proposal messages any further.
-		// They may fail upon execution.
+		// They may fail upon execution, proposers can check them beforehand
+		// with SimulateProposalMsgs.
 		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
 		if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
 			cacheCtx, _ := ctx.CacheContext()
@@ -79,21 +79,44 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 
 	}
 
+	// Dry-run the constitution amendments against the current constitution,
+	// so that a malformed or stale amendment is rejected at submission rather
+	// than failing on execution.
+	amendments := constitutionAmendments(messages)
+	var conflictingProposalIDs []uint64
+	if len(amendments) > 0 {
+		if _, found := keeper.GetStructuredConstitution(ctx); found {
+			return v1.Proposal{}, types.ErrInvalidConstitutionAmendment.Wrap("the constitution is structured, it must be amended by article")
+		}
+		if _, err := keeper.applyConstitutionAmendments(keeper.GetConstitution(ctx), amendments); err != nil {
+			return v1.Proposal{}, err
+		}
+		conflictingProposalIDs = keeper.ConflictingAmendmentProposals(ctx, amendments)
+	}
+	if operations := articleOperations(messages); len(operations) > 0 {
+		if _, err := keeper.ApplyStructuredConstitutionAmendment(ctx, operations); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
 	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+	depositPeriod := params.MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
+	proposal.ConflictingProposalIds = conflictingProposalIDs
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
+	keeper.IncrementInactiveProposalsNumber(ctx)
 	keeper.SetProposalID(ctx, proposalID+1)
 
 	// called right after a proposal is submitted
@@ -106,6 +129,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
 		),
 	)
+	for _, conflictingProposalID := range conflictingProposalIDs {
+		emitConstitutionAmendmentConflictEvent(ctx, proposalID, conflictingProposalID)
+	}
 
 	return proposal, nil
 }
@@ -157,16 +183,19 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	}
 
 	if proposal.DepositEndTime != nil {
-		keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
+		keeper.removeFromInactiveProposals(ctx, proposalID, *proposal.DepositEndTime)
 	}
 	if proposal.VotingEndTime != nil {
 		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
 		store.Delete(types.VotingPeriodProposalKey(proposalID))
+		if proposal.Status == v1.StatusVotingPeriod {
+			keeper.DecrementActiveProposalsNumber(ctx)
+		}
 		// Delete from QuorumCheckQueue: as we do not know with certainty the value
 		// of the first part of the key (the time part), we need to iterate over it,
-		// starting by proposal.VotingStartTime, because we know for sure that the
-		// time part is greater than that.
-		keeper.IterateQuorumCheckQueue(ctx, *proposal.VotingStartTime,
+		// up to proposal.VotingEndTime, because we know for sure that the time
+		// part is not greater than that.
+		keeper.IterateQuorumCheckQueue(ctx, *proposal.VotingEndTime,
 			func(p v1.Proposal, t time.Time, _ v1.QuorumCheckQueueEntry) bool {
 				if p.Id == proposalID {
 					// found the proposal, delete from queue and stop
@@ -180,6 +209,146 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// proposalMsgHandler performs a basic validation of a proposal message,
+// checks the governance module account is its only signer, and returns its
+// handler.
+func (keeper Keeper) proposalMsgHandler(ctx sdk.Context, msg sdk.Msg) (baseapp.MsgServiceHandler, error) {
+	// perform a basic validation of the message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := keeper.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+	return handler, nil
+}
+
+// SimulateProposalMsgs executes the messages of a proposal in a cached context,
+// as the governance module account, and returns the result of each message
+// and the total gas used. No state is written. As the execution of a passed
+// proposal would, the simulation is limited to the MaxProposalExecutionGas
+// param and stops at the first failing message.
+func (keeper Keeper) SimulateProposalMsgs(ctx sdk.Context, messages []sdk.Msg) (results []v1.ProposalMsgResult, gasUsed uint64, err error) {
+	handlers := make([]baseapp.MsgServiceHandler, len(messages))
+	for i, msg := range messages {
+		handlers[i], err = keeper.proposalMsgHandler(ctx, msg)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// the messages are executed as if by the next submitted proposal
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	gasMeter := sdk.NewGasMeter(keeper.GetParams(ctx).MaxProposalExecutionGas)
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = types.WithExecutedProposalID(cacheCtx.WithGasMeter(gasMeter), proposalID)
+
+	for i, msg := range messages {
+		msgCtx := cacheCtx.WithEventManager(sdk.NewEventManager())
+		gasBefore := gasMeter.GasConsumedToLimit()
+		res, err := keeper.SafeExecuteHandler(msgCtx, msg, handlers[i])
+		result := v1.ProposalMsgResult{
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			GasUsed:    gasMeter.GasConsumedToLimit() - gasBefore,
+		}
+		gasUsed += result.GasUsed
+		if err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			break
+		}
+		result.Success = true
+		result.Events = res.Events
+		results = append(results, result)
+	}
+	return results, gasUsed, nil
+}
+
+// SafeExecuteHandler executes handler(msg) and recovers from panic. Running out
+// of gas is reported as ErrProposalExecutionOutOfGas. It is used both to
+// execute passed proposals and to simulate proposal messages, so that both
+// report the same outcome.
+func (keeper Keeper) SafeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(types.ErrProposalExecutionOutOfGas, "out of gas in location: %s; gas limit: %d", oog.Descriptor, ctx.GasMeter().Limit())
+				return
+			}
+			err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", msg, r)
+		}
+	}()
+	return handler(ctx, msg)
+}
+
+// CancelProposal cancels a proposal in deposit or voting period on behalf of
+// its proposer. The ratio of the deposits defined by the ProposalCancelRatio
+// param is burned and the remaining deposits are refunded, then the proposal
+// and its votes are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return types.ErrInvalidProposer.Wrapf("%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is not in deposit or voting period", proposalID)
+	}
+
+	// the proposal can't be canceled once its voting period has ended, even if
+	// it has not been tallied yet
+	if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "voting period of proposal %d has already ended", proposalID)
+	}
+
+	burnRatio, err := sdk.NewDecFromStr(keeper.GetParams(ctx).ProposalCancelRatio)
+	if err != nil {
+		return err
+	}
+	burned, err := keeper.ChargeAndDeleteDeposits(ctx, proposalID, burnRatio)
+	if err != nil {
+		return err
+	}
+
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteVoteHistory(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	// called when proposal is canceled
+	keeper.Hooks().AfterProposalCanceled(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+		),
+	)
+
+	return nil
+}
+
 // IterateProposals iterates over all the proposals and performs a callback function.
 // Panics when the iterator encounters a proposal which can't be unmarshaled.
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
@@ -279,13 +448,44 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal)
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
 	params := keeper.GetParams(ctx)
-	endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+	votingPeriod := params.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = params.ExpeditedVotingPeriod
+	}
+	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+	keeper.removeFromInactiveProposals(ctx, proposal.Id, *proposal.DepositEndTime)
 	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	keeper.IncrementActiveProposalsNumber(ctx)
+	// expedited proposals are added to the quorum check queue only if they are
+	// converted to regular proposals.
+	if !proposal.Expedited {
+		keeper.insertQuorumCheck(ctx, proposal, params)
+	}
+}
+
+// ConvertExpeditedProposal converts an expedited proposal which did not pass
+// into a regular proposal. Its voting period is extended to the regular
+// voting period, counted from the start of the voting period, and the votes
+// are kept.
+func (keeper Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal v1.Proposal) v1.Proposal {
+	params := keeper.GetParams(ctx)
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+	proposal.VotingEndTime = &endTime
+	proposal.Expedited = false
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	keeper.insertQuorumCheck(ctx, proposal, params)
+	return proposal
+}
+
+// insertQuorumCheck adds a proposal in voting period to the quorum check
+// queue, if quorum checks are enabled.
+func (keeper Keeper) insertQuorumCheck(ctx sdk.Context, proposal v1.Proposal, params v1.Params) {
 	if params.QuorumCheckCount > 0 {
 		// add proposal to quorum check queue
 		quorumTimeoutTime := proposal.VotingStartTime.Add(*params.QuorumTimeout)
@@ -312,3 +512,15 @@ func (keeper Keeper) UnmarshalProposal(bz []byte, proposal *v1.Proposal) error {
 	}
 	return nil
 }
+
+// removeFromInactiveProposals removes a proposal from the inactive proposal
+// queue, and decrements the number of inactive proposals if the proposal was
+// actually in the queue.
+func (keeper Keeper) removeFromInactiveProposals(ctx sdk.Context, proposalID uint64, depositEndTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	if !store.Has(types.InactiveProposalQueueKey(proposalID, depositEndTime)) {
+		return
+	}
+	keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, depositEndTime)
+	keeper.DecrementInactiveProposalsNumber(ctx)
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/atomone-hub/atomone/x/gov"
 	"github.com/atomone-hub/atomone/x/gov/client/testutil"
 	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
@@ -20,7 +22,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -38,7 +40,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 		},
 	)
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -58,7 +60,7 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	suite.Require().NoError(err)
 
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -92,7 +94,7 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	suite.reset()
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	suite.Require().NoError(err)
 	suite.Require().Nil(proposal.VotingStartTime)
 
@@ -127,6 +129,94 @@ type invalidProposalRoute struct{ v1beta1.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
 
+func TestCancelProposal(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(mocks.bankKeeper, mocks.stakingKeeper, ctx, 3, sdk.NewInt(10000))
+	proposer, depositor := addrs[0], addrs[1]
+	params := govKeeper.GetParams(ctx)
+	params.MinDepositRatio = "0"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+	submit := func() v1.Proposal {
+		proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", proposer, false)
+		require.NoError(t, err)
+		_, err = govKeeper.AddDeposit(ctx, proposal.Id, proposer, stake(1000))
+		require.NoError(t, err)
+		_, err = govKeeper.AddDeposit(ctx, proposal.Id, depositor, stake(500))
+		require.NoError(t, err)
+		proposal, _ = govKeeper.GetProposal(ctx, proposal.Id)
+		return proposal
+	}
+
+	// unknown proposal
+	err := govKeeper.CancelProposal(ctx, 42, proposer.String())
+	require.ErrorIs(t, err, types.ErrUnknownProposal)
+
+	// proposal in deposit period, only the proposer can cancel it
+	proposal := submit()
+	require.EqualValues(t, 1, govKeeper.GetInactiveProposalsNumber(ctx))
+	err = govKeeper.CancelProposal(ctx, proposal.Id, depositor.String())
+	require.ErrorIs(t, err, types.ErrInvalidProposer)
+	err = govKeeper.CancelProposal(ctx, proposal.Id, proposer.String())
+	require.NoError(t, err)
+	_, found := govKeeper.GetProposal(ctx, proposal.Id)
+	require.False(t, found)
+	require.Empty(t, govKeeper.GetDeposits(ctx, proposal.Id))
+	require.EqualValues(t, 0, govKeeper.GetInactiveProposalsNumber(ctx))
+	// half of the deposits are burned with the default cancel ratio
+	require.Equal(t, stake(9500), mocks.bankKeeper.GetAllBalances(ctx, proposer))
+	require.Equal(t, stake(9750), mocks.bankKeeper.GetAllBalances(ctx, depositor))
+
+	// proposal in voting period, with a cancel ratio of 1
+	params.ProposalCancelRatio = "1"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	proposal = submit()
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, _ = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, depositor, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.EqualValues(t, 1, govKeeper.GetActiveProposalsNumber(ctx))
+	err = govKeeper.CancelProposal(ctx, proposal.Id, proposer.String())
+	require.NoError(t, err)
+	_, found = govKeeper.GetProposal(ctx, proposal.Id)
+	require.False(t, found)
+	require.Empty(t, govKeeper.GetVotes(ctx, proposal.Id))
+	require.EqualValues(t, 0, govKeeper.GetActiveProposalsNumber(ctx))
+	require.False(t, testutil.HasActiveProposal(ctx, govKeeper, proposal.Id, *proposal.VotingEndTime))
+	require.Equal(t, stake(8500), mocks.bankKeeper.GetAllBalances(ctx, proposer))
+	require.Equal(t, stake(9250), mocks.bankKeeper.GetAllBalances(ctx, depositor))
+
+	// proposal in voting period with quorum checks enabled, its quorum check is
+	// removed so that the EndBlocker doesn't process a deleted proposal
+	params.QuorumCheckCount = 2
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	proposal = submit()
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, _ = govKeeper.GetProposal(ctx, proposal.Id)
+	quorumTimeoutTime := proposal.VotingStartTime.Add(*params.QuorumTimeout)
+	require.True(t, testutil.HasQuorumCheck(ctx, govKeeper, proposal.Id, quorumTimeoutTime))
+	err = govKeeper.CancelProposal(ctx, proposal.Id, proposer.String())
+	require.NoError(t, err)
+	require.False(t, testutil.HasQuorumCheck(ctx, govKeeper, proposal.Id, quorumTimeoutTime))
+	require.NotPanics(t, func() {
+		gov.EndBlocker(ctx.WithBlockTime(quorumTimeoutTime.Add(time.Second)), govKeeper)
+	})
+	params.QuorumCheckCount = 0
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	// proposal whose voting period has ended can't be canceled
+	proposal = submit()
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, _ = govKeeper.GetProposal(ctx, proposal.Id)
+	err = govKeeper.CancelProposal(ctx.WithBlockTime(*proposal.VotingEndTime), proposal.Id, proposer.String())
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	// proposal which is not in deposit or voting period can't be canceled
+	proposal.Status = v1.StatusPassed
+	govKeeper.SetProposal(ctx, proposal)
+	err = govKeeper.CancelProposal(ctx, proposal.Id, proposer.String())
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
 	_, _, randomAddr := testdata.KeyTestPubAddr()
@@ -155,7 +245,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -168,7 +258,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1.NewProposal(TestProposal, proposalID, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+			p, err := v1.NewProposal(TestProposal, proposalID, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -226,3 +316,36 @@ func TestMigrateProposalMessages(t *testing.T) {
 	require.Equal(t, "Test", content.GetTitle())
 	require.Equal(t, "description", content.GetDescription())
 }
+
+func failingHandler(_ sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+	panic("test-fail")
+}
+
+func outOfGasHandler(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+	ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "test")
+	return new(sdk.Result), nil
+}
+
+func okHandler(_ sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+	return new(sdk.Result), nil
+}
+
+func TestSafeExecuteHandler(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+
+	r, err := govKeeper.SafeExecuteHandler(ctx, nil, failingHandler)
+	require.ErrorContains(err, "test-fail")
+	require.Nil(r)
+
+	r, err = govKeeper.SafeExecuteHandler(ctx.WithGasMeter(sdk.NewGasMeter(10)), nil, outOfGasHandler)
+	require.ErrorIs(err, types.ErrProposalExecutionOutOfGas)
+	require.ErrorContains(err, "out of gas in location: test; gas limit: 10")
+	require.Nil(r)
+
+	r, err = govKeeper.SafeExecuteHandler(ctx, nil, okHandler)
+	require.Nil(err)
+	require.NotNil(r)
+}
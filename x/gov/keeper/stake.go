@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// ValidateVoterStake returns an error if the voter doesn't have the minimum
+// staked tokens required to vote.
+func (keeper Keeper) ValidateVoterStake(ctx sdk.Context, voter sdk.AccAddress) error {
+	params := keeper.GetParams(ctx)
+	return keeper.validateMinStakedTokens(ctx, voter, params.MinVoteStakedTokens, params.MaxDelegationsChecked, "voting")
+}
+
+// ValidateDepositorStake returns an error if the depositor doesn't have the
+// minimum staked tokens required to deposit.
+func (keeper Keeper) ValidateDepositorStake(ctx sdk.Context, depositor sdk.AccAddress) error {
+	params := keeper.GetParams(ctx)
+	return keeper.validateMinStakedTokens(ctx, depositor, params.MinDepositStakedTokens, params.MaxDelegationsChecked, "depositing")
+}
+
+func (keeper Keeper) validateMinStakedTokens(
+	ctx sdk.Context, addr sdk.AccAddress, minStakedTokensStr string, maxDelegationsChecked uint64, action string,
+) error {
+	minStakedTokens, ok := math.NewIntFromString(minStakedTokensStr)
+	if !ok || minStakedTokens.IsZero() {
+		return nil
+	}
+	if !keeper.HasMinStakedTokens(ctx, addr, minStakedTokens, maxDelegationsChecked) {
+		return sdkerrors.Wrapf(types.ErrInsufficientStake, "insufficient stake for %s - min required %v", action, minStakedTokens)
+	}
+	return nil
+}
+
+// HasMinStakedTokens returns true if addr has at least minStakedTokens staked,
+// looking at no more than maxDelegationsChecked of its delegations.
+func (keeper Keeper) HasMinStakedTokens(ctx sdk.Context, addr sdk.AccAddress, minStakedTokens math.Int, maxDelegationsChecked uint64) bool {
+	enoughStake := false
+	delegationCount := uint64(0)
+	stakedTokens := math.LegacyZeroDec()
+	minStakedTokensDec := math.LegacyNewDecFromInt(minStakedTokens)
+	keeper.sk.IterateDelegations(ctx, addr, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		validator, found := keeper.sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			tokens := validator.TokensFromSharesTruncated(delegation.GetShares())
+			stakedTokens = stakedTokens.Add(tokens)
+			if stakedTokens.GTE(minStakedTokensDec) {
+				enoughStake = true
+				return true // break the iteration
+			}
+		}
+		delegationCount++
+		// break the iteration if maxDelegationsChecked were already checked
+		return delegationCount >= maxDelegationsChecked
+	})
+	return enoughStake
+}
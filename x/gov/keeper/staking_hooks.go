@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wrapper struct for the gov keeper, keeping the validator
+// shares delegated to governors and the voting power accumulators of the
+// proposals in voting period in sync with staking delegations.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the gov keeper
+func (keeper Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{keeper}
+}
+
+// BeforeDelegationSharesModified removes the current shares of the
+// delegation from the delegator's governor and from the voting power
+// accumulators of the proposals the delegator voted on, they are added back
+// in AfterDelegationModified.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, found := h.k.sk.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+	h.k.updateVoterShares(ctx, delAddr, valAddr, delegation.GetShares().Neg())
+	govDelegation, found := h.k.GetGovernanceDelegation(ctx, delAddr)
+	if !found {
+		return nil
+	}
+	govAddr := sdk.MustAccAddressFromBech32(govDelegation.GovernorAddress)
+	h.k.DecreaseGovernorShares(ctx, govAddr, valAddr, delegation.GetShares())
+	return nil
+}
+
+// AfterDelegationModified adds the new shares of the delegation to the
+// delegator's governor and to the voting power accumulators of the proposals
+// the delegator voted on.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, found := h.k.sk.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+	h.k.updateVoterShares(ctx, delAddr, valAddr, delegation.GetShares())
+	govDelegation, found := h.k.GetGovernanceDelegation(ctx, delAddr)
+	if !found {
+		return nil
+	}
+	govAddr := sdk.MustAccAddressFromBech32(govDelegation.GovernorAddress)
+	h.k.IncreaseGovernorShares(ctx, govAddr, valAddr, delegation.GetShares())
+	return nil
+}
+
+// BeforeDelegationRemoved is a no-op, the shares of the delegation have
+// already been removed from the governor and the voting power accumulators in
+// BeforeDelegationSharesModified.
+func (h StakingHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
@@ -12,46 +12,77 @@ import (
 )
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. The votes are deleted once tallied.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
+	projection, burnDeposits, _ := keeper.tally(ctx, proposal, true)
+	return projection.Passes, burnDeposits, *projection.TallyResult
+}
+
+// TallyProjection returns the tally of a proposal as if its voting period
+// ended now. Unlike Tally, the votes are left untouched, so it can safely be
+// used while the proposal is in voting period.
+func (keeper Keeper) TallyProjection(ctx sdk.Context, proposal v1.Proposal) (v1.TallyProjection, error) {
+	projection, _, err := keeper.tally(ctx, proposal, false)
+	return projection, err
+}
+
+// tally computes the tally of a proposal, along with the quorum and threshold
+// that apply to it, and returns whether the deposits should be burned. If
+// `isFinal` is true, the votes are deleted as they are tallied.
+func (keeper Keeper) tally(ctx sdk.Context, proposal v1.Proposal, isFinal bool) (projection v1.TallyProjection, burnDeposits bool, err error) {
 	// fetch all the bonded validators
 	currValidators := keeper.getBondedValidatorsByAddress(ctx)
-	totalVotingPower, results := keeper.tallyVotes(ctx, proposal, currValidators, true)
+	// fetch all the active governors
+	currGovernors := keeper.getActiveGovernorsByAddress(ctx)
+	totalVotingPower, results := keeper.tallyVotes(ctx, proposal, currValidators, currGovernors, isFinal)
 
 	params := keeper.GetParams(ctx)
-	tallyResults = v1.NewTallyResultFromMap(results)
+	tallyResults := v1.NewTallyResultFromMap(results)
+	projection = v1.TallyProjection{
+		TallyResult:      &tallyResults,
+		TotalVotingPower: totalVotingPower.TruncateInt().String(),
+		Participation:    math.LegacyZeroDec().String(),
+	}
+
+	quorum, threshold, err := keeper.getQuorumAndThreshold(ctx, proposal)
+	if err != nil {
+		return projection, false, err
+	}
+	projection.Quorum = quorum.String()
+	projection.Threshold = threshold.String()
 
 	// If there is no staked coins, the proposal fails
 	totalBonded := keeper.sk.TotalBondedTokens(ctx)
 	if totalBonded.IsZero() {
-		return false, false, tallyResults
+		return projection, false, nil
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
-	quorum, threshold, err := keeper.getQuorumAndThreshold(ctx, proposal)
-	if err != nil {
-		return false, false, tallyResults
-	}
+	projection.Participation = percentVoting.String()
 	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults
+		return projection, params.BurnVoteQuorum, nil
 	}
+	projection.QuorumReached = true
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults
+		return projection, false, nil
 	}
 
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+		projection.Passes = true
+		return projection, false, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return projection, false, nil
 }
 
-// HasReachedQuorum returns whether or not a proposal has reached quorum
-// this is just a stripped down version of the Tally function above
+// HasReachedQuorum returns whether or not a proposal has reached quorum.
+// Unlike Tally, the voting power of the proposal is read from its voting power
+// accumulator, so the cost of the check does not depend on the number of
+// voters, governors or bonded validators (see getVotingPower).
 func (keeper Keeper) HasReachedQuorum(ctx sdk.Context, proposal v1.Proposal) (quorumPassed bool, err error) {
 	// If there is no staked coins, the proposal has not reached quorum
 	totalBonded := keeper.sk.TotalBondedTokens(ctx)
@@ -59,30 +90,7 @@ func (keeper Keeper) HasReachedQuorum(ctx sdk.Context, proposal v1.Proposal) (qu
 		return false, nil
 	}
 
-	/* DISABLED on AtomOne - no possible increase of computation speed by
-	 iterating over validators since vote inheritance is disabled.
-	 Keeping as comment because this should be adapted with governors loop
-
-	// we check first if voting power of validators alone is enough to pass quorum
-	// and if so, we return true skipping the iteration over all votes
-	// can speed up computation in case quorum is already reached by validator votes alone
-	approxTotalVotingPower := math.LegacyZeroDec()
-	for _, val := range currValidators {
-		_, ok := keeper.GetVote(ctx, proposal.Id, sdk.AccAddress(val.GetOperator()))
-		if ok {
-			approxTotalVotingPower = approxTotalVotingPower.Add(math.LegacyNewDecFromInt(val.GetBondedTokens()))
-		}
-	}
-	// check and return whether or not the proposal has reached quorum
-	approxPercentVoting := approxTotalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
-	if approxPercentVoting.GTE(quorum) {
-		return true, nil
-	}
-	*/
-
-	// voting power of validators does not reach quorum, let's tally all votes
-	currValidators := keeper.getBondedValidatorsByAddress(ctx)
-	totalVotingPower, _ := keeper.tallyVotes(ctx, proposal, currValidators, false)
+	totalVotingPower := keeper.getVotingPower(ctx, proposal.Id)
 
 	// check and return whether or not the proposal has reached quorum
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
@@ -104,101 +112,197 @@ func (keeper Keeper) getBondedValidatorsByAddress(ctx sdk.Context) map[string]st
 	return vals
 }
 
+// getActiveGovernorsByAddress fetches all the active governors that have
+// enough self-delegation, along with the validator shares delegated to them,
+// and return them in map using their address as the key.
+func (keeper Keeper) getActiveGovernorsByAddress(ctx sdk.Context) map[string]v1.GovernorGovInfo {
+	governors := make(map[string]v1.GovernorGovInfo)
+	keeper.IterateGovernors(ctx, func(governor v1.Governor) (stop bool) {
+		if governor.IsActive() && keeper.ValidateGovernorMinSelfDelegation(ctx, governor) {
+			govAddr := governor.GetAddress()
+			governors[governor.GovernorAddress] = v1.NewGovernorGovInfo(
+				govAddr,
+				keeper.GetAllGovernorValShares(ctx, govAddr),
+				v1.WeightedVoteOptions{},
+			)
+		}
+		return false
+	})
+	return governors
+}
+
 // tallyVotes returns the total voting power and tally results of the votes
-// on a proposal. If `isFinal` is true, results will be stored in `results`
-// map and votes will be deleted. Otherwise, only the total voting power
-// will be returned and `results` will be nil.
+// on a proposal. The vote of an active governor is inherited by its
+// delegators who did not vote themselves. If `isFinal` is true, votes will be
+// deleted as they are tallied along with the voting power accumulator of the
+// proposal, and if the PersistFinalVotes param is enabled a compact record of
+// each vote and its voting power is kept instead.
 func (keeper Keeper) tallyVotes(
 	ctx sdk.Context, proposal v1.Proposal,
-	currValidators map[string]stakingtypes.ValidatorI, isFinal bool,
+	currValidators map[string]stakingtypes.ValidatorI,
+	currGovernors map[string]v1.GovernorGovInfo, isFinal bool,
 ) (totalVotingPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec) {
 	totalVotingPower = math.LegacyZeroDec()
-	if isFinal {
-		results = make(map[v1.VoteOption]math.LegacyDec)
-		results[v1.OptionYes] = math.LegacyZeroDec()
-		results[v1.OptionAbstain] = math.LegacyZeroDec()
-		results[v1.OptionNo] = math.LegacyZeroDec()
-	}
+	results = make(map[v1.VoteOption]math.LegacyDec)
+	results[v1.OptionYes] = math.LegacyZeroDec()
+	results[v1.OptionAbstain] = math.LegacyZeroDec()
+	results[v1.OptionNo] = math.LegacyZeroDec()
+
+	// final votes are recorded in iteration order, and indexed by voter so the
+	// voting power inherited by governors can be added to their own record
+	params := keeper.GetParams(ctx)
+	persistFinalVotes := isFinal && params.PersistFinalVotes
+	var finalVotes []*v1.FinalVote
+	finalVotesByVoter := make(map[string]*v1.FinalVote)
 
 	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
-		// iterate over all delegations from voter, deduct from any delegated-to validators
+		voterPower := math.LegacyZeroDec()
+
+		// if the voter is an active governor, record its vote
+		if gov, ok := currGovernors[vote.Voter]; ok {
+			gov.Vote = vote.Options
+			currGovernors[vote.Voter] = gov
+		}
+
+		// if the voter delegated to an active governor, its shares are deducted
+		// from the governor's, so that its own vote overrides the governor's
+		var governor *v1.GovernorGovInfo
+		if govDelegation, found := keeper.GetGovernanceDelegation(ctx, voter); found {
+			if gov, ok := currGovernors[govDelegation.GovernorAddress]; ok {
+				governor = &gov
+			}
+		}
+
+		// iterate over all delegations from voter
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
+			if governor != nil {
+				if deductions, ok := governor.ValSharesDeductions[valAddrStr]; ok {
+					governor.ValSharesDeductions[valAddrStr] = deductions.Add(delegation.GetShares())
+				}
+			}
+
 			if val, ok := currValidators[valAddrStr]; ok {
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares())
 
-				if isFinal {
-					for _, option := range vote.Options {
-						weight, _ := math.LegacyNewDecFromStr(option.Weight)
-						subPower := votingPower.Mul(weight)
-						results[option.Option] = results[option.Option].Add(subPower)
-					}
+				for _, option := range vote.Options {
+					weight, _ := math.LegacyNewDecFromStr(option.Weight)
+					subPower := votingPower.Mul(weight)
+					results[option.Option] = results[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
+				voterPower = voterPower.Add(votingPower)
 			}
 
 			return false
 		})
 
+		if persistFinalVotes {
+			finalVote := v1.NewFinalVote(vote.ProposalId, voter, vote.Options, voterPower)
+			finalVotes = append(finalVotes, &finalVote)
+			finalVotesByVoter[vote.Voter] = &finalVote
+		}
+
 		if isFinal {
 			keeper.deleteVote(ctx, vote.ProposalId, voter)
 		}
 		return false
 	})
 
-	/* DISABLED on AtomOne - Voting can only be done with your own stake
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if len(val.Vote) == 0 {
+	// iterate over the governors to tally the voting power delegated to them
+	// by delegators who did not vote
+	for govAddrStr, gov := range currGovernors {
+		if len(gov.Vote) == 0 {
 			continue
 		}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		votingPower := math.LegacyZeroDec()
+		for valAddrStr, shares := range gov.ValShares {
+			if val, ok := currValidators[valAddrStr]; ok {
+				sharesAfterDeductions := shares.Sub(gov.ValSharesDeductions[valAddrStr])
+				votingPower = votingPower.Add(sharesAfterDeductions.MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+			}
+		}
 
-		for _, option := range val.Vote {
-			weight, _ := sdk.NewDecFromStr(option.Weight)
+		for _, option := range gov.Vote {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
 			subPower := votingPower.Mul(weight)
 			results[option.Option] = results[option.Option].Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		if finalVote, ok := finalVotesByVoter[govAddrStr]; ok {
+			finalVote.VotingPower = math.LegacyMustNewDecFromStr(finalVote.VotingPower).Add(votingPower).String()
+		}
+	}
+
+	if isFinal {
+		keeper.deleteVotingPowerAccumulator(ctx, proposal.Id)
+	}
+	for _, finalVote := range finalVotes {
+		keeper.SetFinalVote(ctx, *finalVote)
+	}
+	// the final votes and the vote history are pruned after the retention
+	// period
+	if len(finalVotes) > 0 || (isFinal && keeper.HasVoteHistory(ctx, proposal.Id)) {
+		keeper.InsertFinalVotesPruneQueue(ctx, proposal.Id, ctx.BlockTime().Add(*params.FinalVotesRetentionPeriod))
 	}
-	*/
 
 	return totalVotingPower, results
 }
 
 // getQuorumAndThreshold iterates over the proposal's messages to returns the
-// appropriate quorum and threshold.
+// appropriate quorum and threshold. Expedited proposals start from the
+// expedited threshold instead of the regular one. The highest quorum and
+// threshold of the proposal's messages apply, including the overrides of the
+// MessageTallyParams param.
 func (keeper Keeper) getQuorumAndThreshold(ctx sdk.Context, proposal v1.Proposal) (sdk.Dec, sdk.Dec, error) {
 	params := keeper.GetParams(ctx)
-	quorum, err := sdk.NewDecFromStr(params.Quorum)
+	quorum, amendmentQuorum, lawQuorum, err := keeper.GetQuorums(ctx)
 	if err != nil {
-		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.Quorum: %w", err)
+		return sdk.Dec{}, sdk.Dec{}, err
 	}
 	threshold, err := sdk.NewDecFromStr(params.Threshold)
 	if err != nil {
 		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.Threshold: %w", err)
 	}
+	if proposal.Expedited {
+		threshold, err = sdk.NewDecFromStr(params.ExpeditedThreshold)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.ExpeditedThreshold: %w", err)
+		}
+	}
 
 	// Check if a proposal message is an ExecLegacyContent message
 	if len(proposal.Messages) > 0 {
 		var sdkMsg sdk.Msg
 		for _, msg := range proposal.Messages {
+			if mtp, ok := params.FindMessageTallyParams(msg.TypeUrl); ok {
+				q, err := sdk.NewDecFromStr(mtp.Quorum)
+				if err != nil {
+					return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing quorum of message %s: %w", mtp.MsgTypeUrl, err)
+				}
+				if quorum.LT(q) {
+					quorum = q
+				}
+				t, err := sdk.NewDecFromStr(mtp.Threshold)
+				if err != nil {
+					return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing threshold of message %s: %w", mtp.MsgTypeUrl, err)
+				}
+				if threshold.LT(t) {
+					threshold = t
+				}
+			}
 			if err := keeper.cdc.UnpackAny(msg, &sdkMsg); err == nil {
 				// Check if proposal is a law or constitution amendment and adjust the
 				// quorum and threshold accordingly
 				switch sdkMsg.(type) {
-				case *v1.MsgProposeConstitutionAmendment:
-					q, err := sdk.NewDecFromStr(params.ConstitutionAmendmentQuorum)
-					if err != nil {
-						return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.ConstitutionAmendmentQuorum: %w", err)
-					}
-					if quorum.LT(q) {
-						quorum = q
+				case *v1.MsgProposeConstitutionAmendment, *v1.MsgProposeStructuredConstitutionAmendment:
+					if quorum.LT(amendmentQuorum) {
+						quorum = amendmentQuorum
 					}
 					t, err := sdk.NewDecFromStr(params.ConstitutionAmendmentThreshold)
 					if err != nil {
@@ -208,12 +312,8 @@ func (keeper Keeper) getQuorumAndThreshold(ctx sdk.Context, proposal v1.Proposal
 						threshold = t
 					}
 				case *v1.MsgProposeLaw:
-					q, err := sdk.NewDecFromStr(params.LawQuorum)
-					if err != nil {
-						return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("parsing params.LawQuorum: %w", err)
-					}
-					if quorum.LT(q) {
-						quorum = q
+					if quorum.LT(lawQuorum) {
+						quorum = lawQuorum
 					}
 					t, err := sdk.NewDecFromStr(params.LawThreshold)
 					if err != nil {
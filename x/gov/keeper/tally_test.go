@@ -12,6 +12,7 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/atomone-hub/atomone/x/gov/keeper"
@@ -39,6 +40,7 @@ type tallyFixture struct {
 // - initiates the validators with a self delegation of 1:
 //   - setup IterateBondedValidatorsByPower call
 //   - setup IterateDelegations call for validators
+//   - setup GetValidator call for governors self-delegation
 func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 	valAddrs []sdk.ValAddress, delAddrs []sdk.AccAddress, govKeeper *keeper.Keeper,
 	mocks mocks,
@@ -55,7 +57,7 @@ func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).
 		DoAndReturn(func(_ context.Context) sdkmath.Int {
 			return sdkmath.NewInt(s.totalBonded)
-		}).MaxTimes(1)
+		}).AnyTimes()
 	// Mocks a bunch of validators
 	for i := 0; i < len(valAddrs); i++ {
 		s.validators = append(s.validators, stakingtypes.Validator{
@@ -75,7 +77,7 @@ func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 					fn(int64(i), s.validators[i])
 				}
 				return nil
-			})
+			}).AnyTimes()
 	mocks.stakingKeeper.EXPECT().
 		IterateDelegations(ctx, gomock.Any(), gomock.Any()).
 		DoAndReturn(
@@ -87,6 +89,17 @@ func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 				}
 				return nil
 			}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().
+		GetValidator(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+				for _, v := range s.validators {
+					if v.OperatorAddress == addr.String() {
+						return v, true
+					}
+				}
+				return stakingtypes.Validator{}, false
+			}).AnyTimes()
 	return s
 }
 
@@ -113,6 +126,31 @@ func (s *tallyFixture) vote(voter sdk.AccAddress, vote v1.VoteOption) {
 	require.NoError(s.t, err)
 }
 
+// createGovernor creates a governor with the given status, self-delegating
+// its governance voting power. Staking delegations of the governor must be
+// created before.
+func (s *tallyFixture) createGovernor(governor sdk.AccAddress, status v1.GovernorStatus) {
+	gov := v1.NewGovernor(governor, v1.GovernorDescription{}, s.ctx.BlockTime())
+	gov.Status = status
+	s.keeper.SetGovernor(s.ctx, gov)
+	s.keeper.DelegateToGovernor(s.ctx, governor, governor)
+}
+
+// delegateGovernor delegates the governance voting power of delegator to
+// governor. Staking delegations of the delegator must be created before.
+func (s *tallyFixture) delegateGovernor(delegator, governor sdk.AccAddress) {
+	s.keeper.DelegateToGovernor(s.ctx, delegator, governor)
+}
+
+// enableDynamicQuorum enables the dynamic quorum and sets the participation
+// EMA of regular proposals.
+func (s *tallyFixture) enableDynamicQuorum(participationEMA string) {
+	params := s.keeper.GetParams(s.ctx)
+	params.DynamicQuorum = true
+	require.NoError(s.t, s.keeper.SetParams(s.ctx, params))
+	s.keeper.SetParticipationEMA(s.ctx, sdkmath.LegacyMustNewDecFromStr(participationEMA))
+}
+
 func (s *tallyFixture) validatorVote(voter sdk.ValAddress, vote v1.VoteOption) {
 	s.vote(sdk.AccAddress(voter), vote)
 }
@@ -434,6 +472,133 @@ func TestTally(t *testing.T) {
 				NoCount:      "0",
 			},
 		},
+		{
+			name: "dynamic quorum lowered by low participation, one validator votes: prop passes",
+			setup: func(s *tallyFixture) {
+				s.enableDynamicQuorum("0") // quorum is 0.1
+				s.validatorVote(s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:     "1",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "dynamic quorum raised by high participation, not reached: prop fails/burn deposit",
+			setup: func(s *tallyFixture) {
+				s.enableDynamicQuorum("1") // quorum is 0.5
+				s.validatorVote(s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[1], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[2], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:     "4",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "governor votes, delegator inherits its vote: prop passes",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 2)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.createGovernor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:     "5",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "governor votes, delegator overrides its vote: prop fails",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 2)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.delegate(s.delAddrs[2], s.valAddrs[1], 1)
+				s.createGovernor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.delegateGovernor(s.delAddrs[2], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.vote(s.delAddrs[1], v1.VoteOption_VOTE_OPTION_NO)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:     "3",
+				AbstainCount: "0",
+				NoCount:      "3",
+			},
+		},
+		{
+			name: "governor doesn't vote, delegators who vote keep their voting power: prop fails/burn deposit",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 2)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 1)
+				s.delegate(s.delAddrs[2], s.valAddrs[1], 1)
+				s.createGovernor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.delegateGovernor(s.delAddrs[2], s.delAddrs[0])
+				s.vote(s.delAddrs[1], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:     "1",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "inactive governor votes, delegator doesn't inherit its vote: prop fails/burn deposit",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 2)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.createGovernor(s.delAddrs[0], v1.GovernorStatusInactive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:     "2",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "governor without self-delegation votes, delegator doesn't inherit its vote: prop fails/burn deposit",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.createGovernor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:     "0",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -441,6 +606,8 @@ func TestTally(t *testing.T) {
 			params := v1.DefaultParams()
 			// Ensure params value are different than false
 			params.BurnVoteQuorum = true
+			// Allow governors with a small self-delegation
+			params.MinGovernorSelfDelegation = "1"
 			err := govKeeper.SetParams(ctx, params)
 			require.NoError(t, err)
 			var (
@@ -451,7 +618,7 @@ func TestTally(t *testing.T) {
 				delAddrs      = addrs[numVals:]
 			)
 			// Submit and activate a proposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0], false)
 			require.NoError(t, err)
 			govKeeper.ActivateVotingPeriod(ctx, proposal)
 			// Create the test fixture
@@ -460,12 +627,195 @@ func TestTally(t *testing.T) {
 				tt.setup(s)
 			}
 
+			// the projection matches the final tally, without removing the votes
+			numVotes := len(govKeeper.GetVotes(ctx, proposal.Id))
+			projection, err := govKeeper.TallyProjection(ctx, proposal)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, projection.Passes, "wrong projection pass")
+			assert.Equal(t, tt.expectedTally, *projection.TallyResult)
+			assert.Len(t, govKeeper.GetVotes(ctx, proposal.Id), numVotes, "votes removed by projection")
+
 			pass, burn, tally := govKeeper.Tally(ctx, proposal)
 
 			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
 			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
 			assert.Equal(t, tt.expectedTally, tally)
 			assert.Empty(t, govKeeper.GetVotes(ctx, proposal.Id), "votes not be removed after tally")
+			assert.Empty(t, govKeeper.GetFinalVotes(ctx, proposal.Id), "final votes persisted while disabled")
+		})
+	}
+}
+
+func TestTallyPersistFinalVotes(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	params := v1.DefaultParams()
+	params.PersistFinalVotes = true
+	params.MinGovernorSelfDelegation = "1"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	var (
+		addrs    = simtestutil.CreateRandomAccounts(6)
+		valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:3])
+		delAddrs = addrs[3:]
+	)
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+	// delAddrs[0] is a governor voting yes with its own 2 tokens and the 3
+	// tokens of delAddrs[1] who did not vote, delAddrs[2] delegated to the
+	// governor but voted no with its own 4 tokens
+	s.delegate(delAddrs[0], valAddrs[0], 2)
+	s.delegate(delAddrs[1], valAddrs[1], 3)
+	s.delegate(delAddrs[2], valAddrs[2], 4)
+	s.createGovernor(delAddrs[0], v1.GovernorStatusActive)
+	s.delegateGovernor(delAddrs[1], delAddrs[0])
+	s.delegateGovernor(delAddrs[2], delAddrs[0])
+	s.vote(delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+	s.vote(delAddrs[2], v1.VoteOption_VOTE_OPTION_NO)
+	s.validatorVote(valAddrs[0], v1.VoteOption_VOTE_OPTION_ABSTAIN)
+
+	_, _, tally := govKeeper.Tally(ctx, proposal)
+
+	assert.Equal(t, v1.TallyResult{YesCount: "5", AbstainCount: "1", NoCount: "4"}, tally)
+	assert.Empty(t, govKeeper.GetVotes(ctx, proposal.Id))
+	expected := map[string]string{
+		delAddrs[0].String():                 sdkmath.LegacyNewDec(5).String(),
+		delAddrs[2].String():                 sdkmath.LegacyNewDec(4).String(),
+		sdk.AccAddress(valAddrs[0]).String(): sdkmath.LegacyNewDec(1).String(),
+	}
+	finalVotes := govKeeper.GetFinalVotes(ctx, proposal.Id)
+	require.Len(t, finalVotes, len(expected))
+	for _, finalVote := range finalVotes {
+		assert.Equal(t, expected[finalVote.Voter], finalVote.VotingPower, "voting power of %s", finalVote.Voter)
+		assert.Len(t, finalVote.Options, 1)
+	}
+
+	// the final votes are pruned after the retention period
+	govKeeper.PruneFinalVotes(ctx.WithBlockTime(ctx.BlockTime().Add(*params.FinalVotesRetentionPeriod).Add(-1)))
+	assert.Len(t, govKeeper.GetFinalVotes(ctx, proposal.Id), len(expected))
+	govKeeper.PruneFinalVotes(ctx.WithBlockTime(ctx.BlockTime().Add(*params.FinalVotesRetentionPeriod)))
+	assert.Empty(t, govKeeper.GetFinalVotes(ctx, proposal.Id))
+}
+
+func TestTallyProjection(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func(*tallyFixture)
+		proposalMsgs       []sdk.Msg
+		expedited          bool
+		expectedProjection v1.TallyProjection
+	}{
+		{
+			name:         "no votes",
+			proposalMsgs: TestProposal,
+			expectedProjection: v1.TallyProjection{
+				TallyResult:      &v1.TallyResult{YesCount: "0", AbstainCount: "0", NoCount: "0"},
+				TotalVotingPower: "0",
+				Participation:    "0.000000000000000000",
+				Quorum:           "0.250000000000000000",
+				Threshold:        "0.667000000000000000",
+			},
+		},
+		{
+			name: "quorum reached with yes>.667: prop would pass",
+			setup: func(s *tallyFixture) {
+				s.validatorVote(s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[1], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[2], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_NO)
+			},
+			proposalMsgs: TestProposal,
+			expectedProjection: v1.TallyProjection{
+				TallyResult:      &v1.TallyResult{YesCount: "3", AbstainCount: "0", NoCount: "1"},
+				TotalVotingPower: "4",
+				Participation:    "0.400000000000000000",
+				Quorum:           "0.250000000000000000",
+				Threshold:        "0.667000000000000000",
+				QuorumReached:    true,
+				Passes:           true,
+			},
+		},
+		{
+			name: "expedited quorum reached with .667<yes<.75: prop would fail",
+			setup: func(s *tallyFixture) {
+				s.validatorVote(s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[1], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[2], v1.VoteOption_VOTE_OPTION_NO)
+			},
+			proposalMsgs: TestProposal,
+			expedited:    true,
+			expectedProjection: v1.TallyProjection{
+				TallyResult:      &v1.TallyResult{YesCount: "2", AbstainCount: "0", NoCount: "1"},
+				TotalVotingPower: "3",
+				Participation:    "0.300000000000000000",
+				Quorum:           "0.250000000000000000",
+				Threshold:        "0.750000000000000000",
+				QuorumReached:    true,
+			},
+		},
+		{
+			name: "message tally params override: prop would fail",
+			setup: func(s *tallyFixture) {
+				params := s.keeper.GetParams(s.ctx)
+				params.MessageTallyParams = []v1.MessageTallyParams{{
+					MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					Quorum:     "0.5",
+					Threshold:  "0.8",
+				}}
+				require.NoError(s.t, s.keeper.SetParams(s.ctx, params))
+				s.validatorVote(s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[1], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[2], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_NO)
+			},
+			proposalMsgs: TestProposal,
+			expectedProjection: v1.TallyProjection{
+				TallyResult:      &v1.TallyResult{YesCount: "3", AbstainCount: "0", NoCount: "1"},
+				TotalVotingPower: "4",
+				Participation:    "0.400000000000000000",
+				Quorum:           "0.500000000000000000",
+				Threshold:        "0.800000000000000000",
+			},
+		},
+		{
+			name: "law quorum not reached: prop would fail",
+			setup: func(s *tallyFixture) {
+				s.validatorVote(s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[1], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestLawProposal,
+			expectedProjection: v1.TallyProjection{
+				TallyResult:      &v1.TallyResult{YesCount: "2", AbstainCount: "0", NoCount: "0"},
+				TotalVotingPower: "2",
+				Participation:    "0.200000000000000000",
+				Quorum:           "0.250000000000000000",
+				Threshold:        "0.900000000000000000",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			var (
+				numVals  = 10
+				addrs    = simtestutil.CreateRandomAccounts(numVals)
+				valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs)
+			)
+			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", addrs[0], false)
+			require.NoError(t, err)
+			proposal.Expedited = tt.expedited
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, nil, govKeeper, mocks)
+			if tt.setup != nil {
+				tt.setup(s)
+			}
+			votes := govKeeper.GetVotes(ctx, proposal.Id)
+
+			projection, err := govKeeper.TallyProjection(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedProjection, projection)
+			assert.Equal(t, votes, govKeeper.GetVotes(ctx, proposal.Id), "votes must not be removed")
 		})
 	}
 }
@@ -554,10 +904,27 @@ func TestHasReachedQuorum(t *testing.T) {
 			proposalMsgs:   TestLawProposal,
 			expectedQuorum: true,
 		},
+		{
+			name:         "quorum reached thanks to governor",
+			proposalMsgs: TestProposal,
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 2)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.createGovernor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_NO)
+			},
+			expectedQuorum: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Allow governors with a small self-delegation
+			params.MinGovernorSelfDelegation = "1"
+			err := govKeeper.SetParams(ctx, params)
+			require.NoError(t, err)
 			var (
 				numVals       = 10
 				numDelegators = 5
@@ -566,11 +933,13 @@ func TestHasReachedQuorum(t *testing.T) {
 				delAddrs      = addrs[numVals:]
 			)
 			// Submit and activate a proposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0], false)
 			require.NoError(t, err)
 			govKeeper.ActivateVotingPeriod(ctx, proposal)
 			suite := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
 			tt.setup(suite)
+			msg, broken := keeper.VotingPowerAccumulatorInvariant(govKeeper)(ctx)
+			require.False(t, broken, msg)
 
 			quorum, err := govKeeper.HasReachedQuorum(ctx, proposal)
 
@@ -18,6 +18,12 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	if !store.Has(types.VotingPeriodProposalKey(proposalID)) {
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
+	// the proposal may still be in voting period after its voting end time if
+	// its tally was deferred to a later block by the EndBlocker
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	if found && proposal.VotingEndTime != nil && ctx.BlockTime().After(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "voting period of proposal %d has already ended", proposalID)
+	}
 
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -30,8 +36,24 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
+	params := keeper.GetParams(ctx)
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
+	prevVote, found := keeper.GetVote(ctx, proposalID, voterAddr)
+	if found {
+		vote.Changes = prevVote.Changes + 1
+		if params.MaxVoteChanges > 0 && vote.Changes > params.MaxVoteChanges {
+			return sdkerrors.Wrapf(types.ErrMaxVoteChangesReached, "voter %s can change its vote on proposal %d at most %d times", voterAddr, proposalID, params.MaxVoteChanges)
+		}
+	}
 	keeper.SetVote(ctx, vote)
+	// changing a vote leaves the voting power of the proposal unchanged
+	if !found {
+		keeper.accumulateVoter(ctx, proposalID, voterAddr)
+	}
+
+	if params.RecordVoteHistory {
+		keeper.AppendVoteHistory(ctx, proposalID, voterAddr, options)
+	}
 
 	// called after a vote on a proposal is cast
 	keeper.Hooks().AfterProposalVote(ctx, proposalID, voterAddr)
@@ -125,3 +147,17 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// deleteVotes deletes all the votes of a proposal, along with its voting power
+// accumulator
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.deleteVotingPowerAccumulator(ctx, proposalID)
+	var voters []sdk.AccAddress
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		voters = append(voters, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+	for _, voter := range voters {
+		keeper.deleteVote(ctx, proposalID, voter)
+	}
+}
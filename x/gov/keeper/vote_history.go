@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// AppendVoteHistory records a vote cast or changed on a proposal at the end
+// of its vote history, and returns the recorded entry.
+func (keeper Keeper) AppendVoteHistory(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions) v1.VoteHistoryEntry {
+	sequence := keeper.GetVoteHistorySequence(ctx, proposalID)
+	entry := v1.NewVoteHistoryEntry(proposalID, sequence, voterAddr, options, ctx.BlockHeight())
+	keeper.SetVoteHistoryEntry(ctx, entry)
+	keeper.SetVoteHistorySequence(ctx, proposalID, sequence+1)
+	return entry
+}
+
+// SetVoteHistoryEntry sets a VoteHistoryEntry to the gov store
+func (keeper Keeper) SetVoteHistoryEntry(ctx sdk.Context, entry v1.VoteHistoryEntry) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&entry)
+	store.Set(types.VoteHistoryEntryKey(entry.ProposalId, entry.Sequence), bz)
+}
+
+// GetVoteHistory returns the vote history of a proposal, in sequence order
+func (keeper Keeper) GetVoteHistory(ctx sdk.Context, proposalID uint64) (entries []*v1.VoteHistoryEntry) {
+	keeper.IterateVoteHistory(ctx, proposalID, func(entry v1.VoteHistoryEntry) bool {
+		entries = append(entries, &entry)
+		return false
+	})
+	return
+}
+
+// GetAllVoteHistory returns the vote history of all the proposals from the
+// store
+func (keeper Keeper) GetAllVoteHistory(ctx sdk.Context) (entries []*v1.VoteHistoryEntry) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteHistoryKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry v1.VoteHistoryEntry
+		keeper.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, &entry)
+	}
+	return
+}
+
+// IterateVoteHistory iterates over the vote history of a proposal in
+// sequence order and performs a callback function
+func (keeper Keeper) IterateVoteHistory(ctx sdk.Context, proposalID uint64, cb func(entry v1.VoteHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteHistoryKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry v1.VoteHistoryEntry
+		keeper.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// HasVoteHistory returns true if the vote history of a proposal is not empty
+func (keeper Keeper) HasVoteHistory(ctx sdk.Context, proposalID uint64) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteHistoryKey(proposalID))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// DeleteVoteHistory deletes the vote history of a proposal, along with its
+// sequence
+func (keeper Keeper) DeleteVoteHistory(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteHistoryKey(proposalID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.VoteHistorySequenceKey(proposalID))
+}
+
+// GetVoteHistorySequence gets the sequence of the next vote history entry of
+// a proposal, sequences start at 1.
+func (keeper Keeper) GetVoteHistorySequence(ctx sdk.Context, proposalID uint64) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteHistorySequenceKey(proposalID))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetVoteHistorySequence sets the sequence of the next vote history entry of
+// a proposal
+func (keeper Keeper) SetVoteHistorySequence(ctx sdk.Context, proposalID, sequence uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteHistorySequenceKey(proposalID), sdk.Uint64ToBigEndian(sequence))
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -10,6 +11,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
@@ -19,7 +21,7 @@ func TestVotes(t *testing.T) {
 	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	metadata := "metadata"
@@ -86,3 +88,53 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes[1].Options[1].Weight, sdk.NewDecWithPrec(30, 2).String())
 	require.Equal(t, votes[1].Options[2].Weight, sdk.NewDecWithPrec(5, 2).String())
 }
+
+func TestVoteHistory(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	govKeeper.SetProposal(ctx, proposal)
+
+	// the vote history isn't recorded by default
+	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.Empty(t, govKeeper.GetVoteHistory(ctx, proposalID))
+
+	params := govKeeper.GetParams(ctx)
+	params.RecordVoteHistory = true
+	params.MaxVoteChanges = 2
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	// votes and vote changes are recorded in order with their height
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	history := govKeeper.GetVoteHistory(ctx, proposalID)
+	require.Len(t, history, 2)
+	require.Equal(t, v1.NewVoteHistoryEntry(proposalID, 1, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), 10), *history[0])
+	require.Equal(t, v1.NewVoteHistoryEntry(proposalID, 2, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), 11), *history[1])
+
+	// the number of vote changes is limited
+	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
+	vote, found := govKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(2), vote.Changes)
+	err = govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.ErrorIs(t, err, types.ErrMaxVoteChangesReached)
+	vote, found = govKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, v1.OptionAbstain, vote.Options[0].Option)
+	require.Len(t, govKeeper.GetVoteHistory(ctx, proposalID), 3)
+	require.Equal(t, uint64(4), govKeeper.GetVoteHistorySequence(ctx, proposalID))
+
+	// the vote history is pruned along with the final votes
+	govKeeper.InsertFinalVotesPruneQueue(ctx, proposalID, ctx.BlockTime().Add(time.Hour))
+	govKeeper.PruneFinalVotes(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.Empty(t, govKeeper.GetVoteHistory(ctx, proposalID))
+	require.Equal(t, uint64(1), govKeeper.GetVoteHistorySequence(ctx, proposalID))
+}
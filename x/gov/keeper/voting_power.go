@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// The voting power accumulator of a proposal in voting period tracks, per
+// validator, the shares held by the voters of the proposal (the voted shares),
+// per governor, the shares of its delegators who voted themselves (the
+// governor deductions), and the governors who voted on the proposal (the
+// voting governors). It is updated when a vote is first cast, when a voter
+// becomes a governor and when the staking or governance delegations of a
+// voter are modified, so that the voting power of a proposal can be computed
+// without iterating over its votes, the governors or the validators.
+
+// GetVotedShares returns the shares of a validator held by the voters of a
+// proposal.
+func (keeper Keeper) GetVotedShares(ctx sdk.Context, proposalID uint64, validatorAddr sdk.ValAddress) math.LegacyDec {
+	return keeper.getShares(ctx, types.VotedSharesByValidatorKey(proposalID, validatorAddr))
+}
+
+// IterateVotedShares iterates over the shares held by the voters of a
+// proposal and performs a callback function
+func (keeper Keeper) IterateVotedShares(ctx sdk.Context, proposalID uint64, cb func(validatorAddr sdk.ValAddress, shares math.LegacyDec) (stop bool)) {
+	keeper.iterateShares(ctx, types.VotedSharesKey(proposalID), func(key []byte, shares math.LegacyDec) bool {
+		// key is prefix | proposalID | validatorAddrLen | validatorAddr
+		return cb(sdk.ValAddress(key[1:]), shares)
+	})
+}
+
+// GetGovernorDeductions returns the shares of a validator deducted from a
+// governor on a proposal, since they are held by delegators of the governor
+// who voted themselves.
+func (keeper Keeper) GetGovernorDeductions(ctx sdk.Context, proposalID uint64, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) math.LegacyDec {
+	return keeper.getShares(ctx, types.GovernorDeductionKey(proposalID, governorAddr, validatorAddr))
+}
+
+// IterateGovernorDeductions iterates over the shares deducted from the
+// governors on a proposal and performs a callback function
+func (keeper Keeper) IterateGovernorDeductions(ctx sdk.Context, proposalID uint64, cb func(governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) (stop bool)) {
+	keeper.iterateShares(ctx, types.GovernorDeductionsKey(proposalID), func(key []byte, shares math.LegacyDec) bool {
+		// key is prefix | proposalID | governorAddrLen | governorAddr | validatorAddrLen | validatorAddr
+		govAddrLen := int(key[0])
+		governorAddr := sdk.AccAddress(key[1 : 1+govAddrLen])
+		validatorAddr := sdk.ValAddress(key[2+govAddrLen:])
+		return cb(governorAddr, validatorAddr, shares)
+	})
+}
+
+// IterateVotingGovernors iterates over the governors who voted on a proposal
+// and performs a callback function
+func (keeper Keeper) IterateVotingGovernors(ctx sdk.Context, proposalID uint64, cb func(governorAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.VotingGovernorsKey(proposalID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is prefix | proposalID | governorAddrLen | governorAddr
+		if cb(sdk.AccAddress(iterator.Key()[len(prefix)+1:])) {
+			break
+		}
+	}
+}
+
+// ResetVotingPowerAccumulator recomputes the voting power accumulator of a
+// proposal from its votes and the current delegations of its voters.
+func (keeper Keeper) ResetVotingPowerAccumulator(ctx sdk.Context, proposalID uint64) {
+	keeper.deleteVotingPowerAccumulator(ctx, proposalID)
+	var voters []sdk.AccAddress
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		voters = append(voters, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+	for _, voter := range voters {
+		keeper.accumulateVoter(ctx, proposalID, voter)
+	}
+}
+
+// ResetVotingPowerAccumulators recomputes the voting power accumulators of all
+// the proposals in voting period.
+func (keeper Keeper) ResetVotingPowerAccumulators(ctx sdk.Context) {
+	for _, proposalID := range keeper.getVotingPeriodProposalIDs(ctx) {
+		keeper.ResetVotingPowerAccumulator(ctx, proposalID)
+	}
+}
+
+// RecountVotingPowerAccumulator computes the voted shares and the governor
+// deductions of a proposal by iterating over all its votes and the
+// delegations of its voters, without using nor modifying the accumulator.
+// The governor deductions are indexed by governor then validator address.
+func (keeper Keeper) RecountVotingPowerAccumulator(ctx sdk.Context, proposalID uint64) (votedShares map[string]math.LegacyDec, deductions map[string]map[string]math.LegacyDec) {
+	votedShares = make(map[string]math.LegacyDec)
+	deductions = make(map[string]map[string]math.LegacyDec)
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+		govDelegation, hasGovernor := keeper.GetGovernanceDelegation(ctx, voter)
+		keeper.sk.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+			votedShares[valAddrStr] = addShares(votedShares[valAddrStr], delegation.GetShares())
+			if hasGovernor {
+				govDeductions, ok := deductions[govDelegation.GovernorAddress]
+				if !ok {
+					govDeductions = make(map[string]math.LegacyDec)
+					deductions[govDelegation.GovernorAddress] = govDeductions
+				}
+				govDeductions[valAddrStr] = addShares(govDeductions[valAddrStr], delegation.GetShares())
+			}
+			return false
+		})
+		return false
+	})
+	return votedShares, deductions
+}
+
+// getVotingPower returns the voting power of the votes on a proposal from its
+// voting power accumulator, including the voting power inherited by the
+// active governors who voted. It matches the total voting power computed by
+// tallyVotes, up to rounding.
+//
+// Its cost doesn't depend on the number of votes, governors or bonded
+// validators: it reads one entry per validator the voters are delegated to,
+// plus, for each governor who voted, its self-delegation and one entry per
+// validator its delegators are delegated to. The validators are looked up
+// individually, since their exchange rate and bonded status are only known at
+// the time of the read.
+func (keeper Keeper) getVotingPower(ctx sdk.Context, proposalID uint64) math.LegacyDec {
+	totalVotingPower := math.LegacyZeroDec()
+	// bonded validators by address, nil if the validator is not bonded
+	bondedValidators := make(map[string]*stakingtypes.Validator)
+	sharesToPower := func(validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+		val, ok := bondedValidators[validatorAddr.String()]
+		if !ok {
+			if validator, found := keeper.sk.GetValidator(ctx, validatorAddr); found && validator.IsBonded() {
+				val = &validator
+			}
+			bondedValidators[validatorAddr.String()] = val
+		}
+		if val != nil {
+			// shares * bonded / total shares
+			totalVotingPower = totalVotingPower.Add(shares.MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+		}
+	}
+
+	keeper.IterateVotedShares(ctx, proposalID, func(validatorAddr sdk.ValAddress, shares math.LegacyDec) bool {
+		sharesToPower(validatorAddr, shares)
+		return false
+	})
+
+	// the active governors who voted add the voting power delegated to them by
+	// delegators who did not vote
+	keeper.IterateVotingGovernors(ctx, proposalID, func(govAddr sdk.AccAddress) bool {
+		governor, found := keeper.GetGovernor(ctx, govAddr)
+		if !found || !governor.IsActive() || !keeper.ValidateGovernorMinSelfDelegation(ctx, governor) {
+			return false
+		}
+		keeper.IterateGovernorValShares(ctx, govAddr, func(valShares v1.GovernorValShares) bool {
+			validatorAddr, err := sdk.ValAddressFromBech32(valShares.ValidatorAddress)
+			if err != nil {
+				panic(err)
+			}
+			deductions := keeper.GetGovernorDeductions(ctx, proposalID, govAddr, validatorAddr)
+			sharesToPower(validatorAddr, valShares.Shares.Sub(deductions))
+			return false
+		})
+		return false
+	})
+	return totalVotingPower
+}
+
+// accumulateVoter adds the shares of all the delegations of a voter to the
+// voting power accumulator of a proposal.
+func (keeper Keeper) accumulateVoter(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	if _, isGovernor := keeper.GetGovernor(ctx, voterAddr); isGovernor {
+		ctx.KVStore(keeper.storeKey).Set(types.VotingGovernorKey(proposalID, voterAddr), []byte{1})
+	}
+	govDelegation, hasGovernor := keeper.GetGovernanceDelegation(ctx, voterAddr)
+	keeper.sk.IterateDelegations(ctx, voterAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		validatorAddr := delegation.GetValidatorAddr()
+		keeper.updateShares(ctx, types.VotedSharesByValidatorKey(proposalID, validatorAddr), delegation.GetShares())
+		if hasGovernor {
+			govAddr := sdk.MustAccAddressFromBech32(govDelegation.GovernorAddress)
+			keeper.updateShares(ctx, types.GovernorDeductionKey(proposalID, govAddr, validatorAddr), delegation.GetShares())
+		}
+		return false
+	})
+}
+
+// accumulateGovernor adds a new governor to the voting governors of the
+// proposals in voting period it has already voted on.
+func (keeper Keeper) accumulateGovernor(ctx sdk.Context, governorAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, proposalID := range keeper.getVotedProposalIDs(ctx, governorAddr) {
+		store.Set(types.VotingGovernorKey(proposalID, governorAddr), []byte{1})
+	}
+}
+
+// updateVoterShares adds shares, which may be negative, of a validator to the
+// voting power accumulators of the proposals in voting period the voter has
+// voted on. If the voter delegated to a governor, its deductions are updated
+// too.
+func (keeper Keeper) updateVoterShares(ctx sdk.Context, voterAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+	proposalIDs := keeper.getVotedProposalIDs(ctx, voterAddr)
+	if len(proposalIDs) == 0 {
+		return
+	}
+	govDelegation, hasGovernor := keeper.GetGovernanceDelegation(ctx, voterAddr)
+	for _, proposalID := range proposalIDs {
+		keeper.updateShares(ctx, types.VotedSharesByValidatorKey(proposalID, validatorAddr), shares)
+		if hasGovernor {
+			govAddr := sdk.MustAccAddressFromBech32(govDelegation.GovernorAddress)
+			keeper.updateShares(ctx, types.GovernorDeductionKey(proposalID, govAddr, validatorAddr), shares)
+		}
+	}
+}
+
+// updateVoterGovernorDeductions adds the shares of all the delegations of a
+// voter, or subtracts them if `add` is false, to the deductions of a governor
+// on the proposals in voting period the voter has voted on.
+func (keeper Keeper) updateVoterGovernorDeductions(ctx sdk.Context, voterAddr, governorAddr sdk.AccAddress, add bool) {
+	proposalIDs := keeper.getVotedProposalIDs(ctx, voterAddr)
+	if len(proposalIDs) == 0 {
+		return
+	}
+	keeper.sk.IterateDelegations(ctx, voterAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		shares := delegation.GetShares()
+		if !add {
+			shares = shares.Neg()
+		}
+		for _, proposalID := range proposalIDs {
+			keeper.updateShares(ctx, types.GovernorDeductionKey(proposalID, governorAddr, delegation.GetValidatorAddr()), shares)
+		}
+		return false
+	})
+}
+
+// getVotedProposalIDs returns the ids of the proposals in voting period the
+// voter has voted on.
+func (keeper Keeper) getVotedProposalIDs(ctx sdk.Context, voterAddr sdk.AccAddress) (proposalIDs []uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, proposalID := range keeper.getVotingPeriodProposalIDs(ctx) {
+		if store.Has(types.VoteKey(proposalID, voterAddr)) {
+			proposalIDs = append(proposalIDs, proposalID)
+		}
+	}
+	return proposalIDs
+}
+
+// getVotingPeriodProposalIDs returns the ids of the proposals in voting
+// period.
+func (keeper Keeper) getVotingPeriodProposalIDs(ctx sdk.Context) (proposalIDs []uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotingPeriodProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, types.GetProposalIDFromBytes(iterator.Key()[1:]))
+	}
+	return proposalIDs
+}
+
+// deleteVotingPowerAccumulator deletes the voting power accumulator of a
+// proposal.
+func (keeper Keeper) deleteVotingPowerAccumulator(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{
+		types.VotedSharesKey(proposalID), types.GovernorDeductionsKey(proposalID), types.VotingGovernorsKey(proposalID),
+	} {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// updateShares adds shares, which may be negative, to the shares stored at
+// key, and removes the entry if no shares are left.
+func (keeper Keeper) updateShares(ctx sdk.Context, key []byte, shares math.LegacyDec) {
+	store := ctx.KVStore(keeper.storeKey)
+	newShares := keeper.getShares(ctx, key).Add(shares)
+	if newShares.IsNegative() {
+		panic(fmt.Sprintf("voting power accumulator shares should never be negative, got %s", newShares))
+	}
+	if newShares.IsZero() {
+		store.Delete(key)
+		return
+	}
+	bz, err := newShares.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func (keeper Keeper) getShares(ctx sdk.Context, key []byte) math.LegacyDec {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+	var shares math.LegacyDec
+	if err := shares.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return shares
+}
+
+// iterateShares iterates over the shares stored under prefix and performs a
+// callback function with the remainder of the key after the prefix.
+func (keeper Keeper) iterateShares(ctx sdk.Context, prefix []byte, cb func(key []byte, shares math.LegacyDec) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var shares math.LegacyDec
+		if err := shares.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(iterator.Key()[len(prefix):], shares) {
+			break
+		}
+	}
+}
+
+// addShares returns the sum of shares and delta, shares being nil if not
+// set yet.
+func addShares(shares, delta math.LegacyDec) math.LegacyDec {
+	if shares.IsNil() {
+		return delta
+	}
+	return shares.Add(delta)
+}
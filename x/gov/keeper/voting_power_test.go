@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestVotingPowerAccumulator(t *testing.T) {
+	st := newMockStakingState()
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockStakingStateExpectations(st))
+	params := v1.DefaultParams()
+	// Allow governors with a small self-delegation
+	params.MinGovernorSelfDelegation = "1"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	addrs := simtestutil.CreateRandomAccounts(4)
+	valAddr := sdk.ValAddress(addrs[0])
+	govAddr, delAddr1, delAddr2 := addrs[1], addrs[2], addrs[3]
+	hooks := govKeeper.StakingHooks()
+
+	st.delegate(govAddr, valAddr, 4)
+	st.delegate(delAddr1, valAddr, 3)
+	st.delegate(delAddr2, valAddr, 2)
+	govKeeper.SetGovernor(ctx, v1.NewGovernor(govAddr, v1.GovernorDescription{}, ctx.BlockTime()))
+	govKeeper.DelegateToGovernor(ctx, govAddr, govAddr)
+	govKeeper.DelegateToGovernor(ctx, delAddr1, govAddr)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddr1, false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	vote := func(voter sdk.AccAddress, option v1.VoteOption) {
+		require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(option), ""))
+	}
+	assertAccumulator := func(votedShares, deductions, votingPower int64) {
+		t.Helper()
+		assert.Equal(t, math.LegacyNewDec(votedShares), govKeeper.GetVotedShares(ctx, proposal.Id, valAddr))
+		assert.Equal(t, math.LegacyNewDec(deductions), govKeeper.GetGovernorDeductions(ctx, proposal.Id, govAddr, valAddr))
+		assert.Equal(t, math.LegacyNewDec(votingPower), govKeeper.GetVotingPower(ctx, proposal.Id))
+		msg, broken := keeper.VotingPowerAccumulatorInvariant(govKeeper)(ctx)
+		assert.False(t, broken, msg)
+	}
+	assertVotingGovernors := func(governors ...sdk.AccAddress) {
+		t.Helper()
+		var votingGovernors []sdk.AccAddress
+		govKeeper.IterateVotingGovernors(ctx, proposal.Id, func(governorAddr sdk.AccAddress) bool {
+			votingGovernors = append(votingGovernors, governorAddr)
+			return false
+		})
+		assert.ElementsMatch(t, governors, votingGovernors)
+	}
+	assertAccumulator(0, 0, 0)
+	assertVotingGovernors()
+
+	// a delegator of the governor votes, its shares are deducted from the
+	// governor who did not vote yet
+	vote(delAddr1, v1.OptionYes)
+	assertAccumulator(3, 3, 3)
+
+	// changing a vote doesn't change the accumulator
+	vote(delAddr1, v1.OptionNo)
+	assertAccumulator(3, 3, 3)
+
+	// the governor votes, inheriting no voting power since its only delegator
+	// voted
+	vote(govAddr, v1.OptionYes)
+	assertAccumulator(7, 7, 7)
+	assertVotingGovernors(govAddr)
+
+	// a voter without governor votes
+	vote(delAddr2, v1.OptionAbstain)
+	assertAccumulator(9, 7, 9)
+
+	// the delegation of a voter is increased through the staking hooks
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr1, valAddr))
+	st.delegate(delAddr1, valAddr, 1)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delAddr1, valAddr))
+	assertAccumulator(10, 8, 10)
+
+	// a voter leaves its governor, its shares are no longer deducted
+	govKeeper.UndelegateFromGovernor(ctx, delAddr1)
+	assertAccumulator(10, 4, 10)
+
+	// a voter delegates to the governor
+	govKeeper.DelegateToGovernor(ctx, delAddr2, govAddr)
+	assertAccumulator(10, 6, 10)
+
+	// a voter becomes a governor, it inherits no voting power since it is its
+	// only delegator
+	_, err = keeper.NewMsgServerImpl(govKeeper).CreateGovernor(ctx, v1.NewMsgCreateGovernor(delAddr2, v1.GovernorDescription{}))
+	require.NoError(t, err)
+	assertAccumulator(10, 4, 10)
+	assertVotingGovernors(govAddr, delAddr2)
+
+	// the accumulator matches the tally projection
+	projection, err := govKeeper.TallyProjection(ctx, proposal)
+	require.NoError(t, err)
+	assert.Equal(t, "10", projection.TotalVotingPower)
+
+	// the accumulator is recomputed from the votes
+	govKeeper.ResetVotingPowerAccumulator(ctx, proposal.Id)
+	assertAccumulator(10, 4, 10)
+	assertVotingGovernors(govAddr, delAddr2)
+
+	// the accumulator is deleted once the proposal is tallied
+	govKeeper.Tally(ctx, proposal)
+	govKeeper.IterateVotedShares(ctx, proposal.Id, func(validatorAddr sdk.ValAddress, shares math.LegacyDec) bool {
+		t.Fatalf("unexpected voted shares of %s: %s", validatorAddr, shares)
+		return false
+	})
+	govKeeper.IterateGovernorDeductions(ctx, proposal.Id, func(governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) bool {
+		t.Fatalf("unexpected deductions of %s for %s: %s", governorAddr, validatorAddr, shares)
+		return false
+	})
+	assertVotingGovernors()
+}
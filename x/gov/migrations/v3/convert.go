@@ -23,6 +23,12 @@ func ConvertToLegacyProposal(proposal v1.Proposal) (v1beta1.Proposal, error) {
 		TotalDeposit: types.NewCoins(proposal.TotalDeposit...),
 	}
 
+	// there is no legacy status for proposals pending execution, which have
+	// passed
+	if proposal.Status == v1.StatusPassedPendingExecution {
+		legacyProposal.Status = v1beta1.StatusPassed
+	}
+
 	legacyProposal.FinalTallyResult, err = ConvertToLegacyTallyResult(proposal.FinalTallyResult)
 	if err != nil {
 		return v1beta1.Proposal{}, err
@@ -0,0 +1,176 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v4 (v2) to v5 (v3). The
+// migration includes:
+//
+// - Replacing the static MinDeposit param with the dynamic min deposit
+// throttler params, using the static value as the floor value.
+// - Initializing the last min deposit to the floor value.
+// - Initializing the number of active proposals.
+// - Replacing the MinInitialDepositRatio param with the dynamic min initial
+// deposit throttler params, using the previous min initial deposit
+// (MinDeposit * MinInitialDepositRatio) as the floor value if not zero.
+// - Initializing the last min initial deposit to the floor value.
+// - Initializing the number of inactive proposals.
+// - Setting the governors params to their default values.
+// - Setting the dynamic quorum params to their default values (disabled).
+// - Initializing the participation EMAs to their default values.
+// - Setting the expedited proposals params, using 5 times the static MinDeposit
+// as the expedited min deposit.
+// - Setting the vote history params to their default values (disabled).
+// - Setting the minimum staked tokens params to their default values, which
+// match the previous hardcoded minimum stake required to vote.
+// - Setting the execution delay params to their default values (disabled).
+// - Setting the maximum proposal execution gas param to its default value.
+// - Setting the maximum number of proposals processed per block by the
+// EndBlocker param to its default value.
+// - Recording the current constitution as version 0 of the constitution
+// history.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params govv1.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	defaultParams := govv1.DefaultParams()
+	params.MinDepositThrottler = defaultParams.MinDepositThrottler
+	params.MinDepositThrottler.FloorValue = params.MinDeposit //nolint:staticcheck
+	params.MinInitialDepositThrottler = defaultParams.MinInitialDepositThrottler
+	if minInitialDepositFloor := minInitialDepositFloor(params); !minInitialDepositFloor.IsZero() {
+		params.MinInitialDepositThrottler.FloorValue = minInitialDepositFloor
+	}
+	params.MinGovernorSelfDelegation = defaultParams.MinGovernorSelfDelegation
+	params.GovernorStatusChangePeriod = defaultParams.GovernorStatusChangePeriod
+	params.DynamicQuorum = defaultParams.DynamicQuorum
+	params.QuorumRange = defaultParams.QuorumRange
+	params.ConstitutionAmendmentQuorumRange = defaultParams.ConstitutionAmendmentQuorumRange
+	params.LawQuorumRange = defaultParams.LawQuorumRange
+	params.PersistFinalVotes = defaultParams.PersistFinalVotes
+	params.FinalVotesRetentionPeriod = defaultParams.FinalVotesRetentionPeriod
+	params.ProposalCancelRatio = defaultParams.ProposalCancelRatio
+	params.ExpeditedVotingPeriod = defaultParams.ExpeditedVotingPeriod
+	if *params.ExpeditedVotingPeriod >= *params.VotingPeriod {
+		expeditedVotingPeriod := *params.VotingPeriod / 2
+		params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	}
+	params.ExpeditedThreshold = defaultParams.ExpeditedThreshold
+	// like the defaults, the expedited min deposit is 5 times the min deposit
+	params.ExpeditedMinDeposit = sdk.NewCoins(params.MinDeposit...).MulInt(sdk.NewInt(5)) //nolint:staticcheck
+	params.ExpeditedAllowedMsgTypeUrls = defaultParams.ExpeditedAllowedMsgTypeUrls
+	params.RecordVoteHistory = defaultParams.RecordVoteHistory
+	params.MaxVoteChanges = defaultParams.MaxVoteChanges
+	params.MinVoteStakedTokens = defaultParams.MinVoteStakedTokens
+	params.MaxDelegationsChecked = defaultParams.MaxDelegationsChecked
+	params.MinDepositStakedTokens = defaultParams.MinDepositStakedTokens
+	params.ExecutionDelay = defaultParams.ExecutionDelay
+	params.MessageExecutionDelays = defaultParams.MessageExecutionDelays
+	params.MaxProposalExecutionGas = defaultParams.MaxProposalExecutionGas
+	params.MaxEndBlockerProposals = defaultParams.MaxEndBlockerProposals
+	params.MinDeposit = nil            //nolint:staticcheck
+	params.MinInitialDepositRatio = "" //nolint:staticcheck
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	blockTime := ctx.BlockTime()
+	lastMinDeposit := govv1.LastMinDeposit{
+		Value: params.MinDepositThrottler.FloorValue,
+		Time:  &blockTime,
+	}
+	bz, err = cdc.Marshal(&lastMinDeposit)
+	if err != nil {
+		return err
+	}
+	store.Set(types.LastMinDepositKey, bz)
+
+	lastMinInitialDeposit := govv1.LastMinDeposit{
+		Value: params.MinInitialDepositThrottler.FloorValue,
+		Time:  &blockTime,
+	}
+	bz, err = cdc.Marshal(&lastMinInitialDeposit)
+	if err != nil {
+		return err
+	}
+	store.Set(types.LastMinInitialDepositKey, bz)
+
+	// count the proposals currently in voting period
+	activeProposalsNumber := uint64(0)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotingPeriodProposalKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		activeProposalsNumber++
+	}
+	store.Set(types.ActiveProposalsNumberKey, sdk.Uint64ToBigEndian(activeProposalsNumber))
+
+	// count the proposals currently in deposit period
+	inactiveProposalsNumber := uint64(0)
+	inactiveIterator := sdk.KVStorePrefixIterator(store, types.InactiveProposalQueuePrefix)
+	defer inactiveIterator.Close()
+	for ; inactiveIterator.Valid(); inactiveIterator.Next() {
+		inactiveProposalsNumber++
+	}
+	store.Set(types.InactiveProposalsNumberKey, sdk.Uint64ToBigEndian(inactiveProposalsNumber))
+
+	participationEMAs := []struct {
+		key   []byte
+		value sdk.Dec
+	}{
+		{types.ParticipationEMAKey, govv1.DefaultParticipationEMA},
+		{types.ConstitutionAmendmentParticipationEMAKey, govv1.DefaultConstitutionAmendmentParticipationEMA},
+		{types.LawParticipationEMAKey, govv1.DefaultLawParticipationEMA},
+	}
+	for _, participationEMA := range participationEMAs {
+		bz, err := participationEMA.value.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(participationEMA.key, bz)
+	}
+
+	constitutionVersion := govv1.ConstitutionVersion{
+		Height:       ctx.BlockHeight(),
+		Constitution: string(store.Get(types.KeyConstitution)),
+	}
+	bz, err = cdc.Marshal(&constitutionVersion)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ConstitutionVersionKey(0), bz)
+
+	return nil
+}
+
+// minInitialDepositFloor returns the min initial deposit as computed before
+// the dynamic min initial deposit, i.e. MinDeposit * MinInitialDepositRatio.
+func minInitialDepositFloor(params govv1.Params) sdk.Coins {
+	ratio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio) //nolint:staticcheck
+	if err != nil || !ratio.IsPositive() {
+		return nil
+	}
+	floor := sdk.NewCoins()
+	for _, coin := range params.MinDeposit { //nolint:staticcheck
+		floor = floor.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(ratio).TruncateInt()))
+	}
+	return floor
+}
@@ -0,0 +1,118 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v5 "github.com/atomone-hub/atomone/x/gov/migrations/v5"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := ctx.KVStore(govKey)
+
+	// set v4 params, with a static min deposit, a min initial deposit ratio
+	// and no throttlers nor governors params
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 512_000_000))
+	params := v1.DefaultParams()
+	params.MinDeposit = minDeposit         //nolint:staticcheck
+	params.MinInitialDepositRatio = "0.01" //nolint:staticcheck
+	params.MinDepositThrottler = nil
+	params.MinInitialDepositThrottler = nil
+	params.MinGovernorSelfDelegation = ""
+	params.GovernorStatusChangePeriod = nil
+	params.QuorumRange = nil
+	params.ConstitutionAmendmentQuorumRange = nil
+	params.LawQuorumRange = nil
+	params.FinalVotesRetentionPeriod = nil
+	bz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+	store.Set(types.ParamsKey, bz)
+
+	// add 2 proposals in voting period
+	endTime := ctx.BlockTime().Add(time.Hour)
+	store.Set(types.VotingPeriodProposalKey(1), []byte{1})
+	store.Set(types.ActiveProposalQueueKey(1, endTime), sdk.Uint64ToBigEndian(1))
+	store.Set(types.VotingPeriodProposalKey(2), []byte{1})
+	store.Set(types.ActiveProposalQueueKey(2, endTime), sdk.Uint64ToBigEndian(2))
+	// and 1 proposal in deposit period
+	store.Set(types.InactiveProposalQueueKey(3, endTime), sdk.Uint64ToBigEndian(3))
+	store.Set(types.KeyConstitution, []byte("This chain has a constitution."))
+
+	require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+
+	var newParams v1.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &newParams)
+	require.Empty(t, newParams.MinDeposit) //nolint:staticcheck
+	require.NotNil(t, newParams.MinDepositThrottler)
+	require.Equal(t, minDeposit, sdk.Coins(newParams.MinDepositThrottler.FloorValue))
+	require.Empty(t, newParams.MinInitialDepositRatio) //nolint:staticcheck
+	require.NotNil(t, newParams.MinInitialDepositThrottler)
+	minInitialDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_120_000))
+	require.Equal(t, minInitialDeposit, sdk.Coins(newParams.MinInitialDepositThrottler.FloorValue))
+	require.Equal(t, v1.DefaultMinGovernorSelfDelegation.String(), newParams.MinGovernorSelfDelegation)
+	require.Equal(t, v1.DefaultGovernorStatusChangePeriod, *newParams.GovernorStatusChangePeriod)
+	require.False(t, newParams.DynamicQuorum)
+	require.Equal(t, v1.DefaultParams().QuorumRange, newParams.QuorumRange)
+	require.Equal(t, v1.DefaultParams().ConstitutionAmendmentQuorumRange, newParams.ConstitutionAmendmentQuorumRange)
+	require.Equal(t, v1.DefaultParams().LawQuorumRange, newParams.LawQuorumRange)
+	require.False(t, newParams.PersistFinalVotes)
+	require.Equal(t, v1.DefaultFinalVotesRetentionPeriod, *newParams.FinalVotesRetentionPeriod)
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), newParams.ProposalCancelRatio)
+	require.Equal(t, v1.DefaultExpeditedVotingPeriod, *newParams.ExpeditedVotingPeriod)
+	require.Equal(t, v1.DefaultExpeditedThreshold.String(), newParams.ExpeditedThreshold)
+	require.Equal(t, minDeposit.MulInt(sdk.NewInt(5)), sdk.Coins(newParams.ExpeditedMinDeposit))
+	require.Equal(t, v1.DefaultExpeditedAllowedMsgTypeURLs, newParams.ExpeditedAllowedMsgTypeUrls)
+	require.False(t, newParams.RecordVoteHistory)
+	require.Zero(t, newParams.MaxVoteChanges)
+	require.Equal(t, v1.DefaultMinVoteStakedTokens.String(), newParams.MinVoteStakedTokens)
+	require.Equal(t, v1.DefaultMaxDelegationsChecked, newParams.MaxDelegationsChecked)
+	require.Equal(t, v1.DefaultMinDepositStakedTokens.String(), newParams.MinDepositStakedTokens)
+	require.Equal(t, v1.DefaultExecutionDelay, *newParams.ExecutionDelay)
+	require.Empty(t, newParams.MessageExecutionDelays)
+	require.Equal(t, v1.DefaultMaxProposalExecutionGas, newParams.MaxProposalExecutionGas)
+	require.Equal(t, v1.DefaultMaxEndBlockerProposals, newParams.MaxEndBlockerProposals)
+	require.NoError(t, newParams.ValidateBasic())
+
+	var lastMinDeposit v1.LastMinDeposit
+	cdc.MustUnmarshal(store.Get(types.LastMinDepositKey), &lastMinDeposit)
+	require.Equal(t, minDeposit, sdk.Coins(lastMinDeposit.Value))
+	require.Equal(t, ctx.BlockTime(), *lastMinDeposit.Time)
+
+	require.EqualValues(t, 2, sdk.BigEndianToUint64(store.Get(types.ActiveProposalsNumberKey)))
+
+	var lastMinInitialDeposit v1.LastMinDeposit
+	cdc.MustUnmarshal(store.Get(types.LastMinInitialDepositKey), &lastMinInitialDeposit)
+	require.Equal(t, minInitialDeposit, sdk.Coins(lastMinInitialDeposit.Value))
+	require.Equal(t, ctx.BlockTime(), *lastMinInitialDeposit.Time)
+
+	require.EqualValues(t, 1, sdk.BigEndianToUint64(store.Get(types.InactiveProposalsNumberKey)))
+
+	for _, key := range [][]byte{
+		types.ParticipationEMAKey,
+		types.ConstitutionAmendmentParticipationEMAKey,
+		types.LawParticipationEMAKey,
+	} {
+		var participationEMA sdk.Dec
+		require.NoError(t, participationEMA.Unmarshal(store.Get(key)))
+		require.Equal(t, v1.DefaultParticipationEMA, participationEMA)
+	}
+
+	var constitutionVersion v1.ConstitutionVersion
+	cdc.MustUnmarshal(store.Get(types.ConstitutionVersionKey(0)), &constitutionVersion)
+	require.Zero(t, constitutionVersion.Version)
+	require.Zero(t, constitutionVersion.ProposalId)
+	require.Equal(t, ctx.BlockHeight(), constitutionVersion.Height)
+	require.Equal(t, "This chain has a constitution.", constitutionVersion.Constitution)
+}
@@ -29,6 +29,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	govclient "github.com/atomone-hub/atomone/x/gov/client"
 	"github.com/atomone-hub/atomone/x/gov/client/cli"
@@ -39,9 +40,10 @@ import (
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
-const ConsensusVersion = 4
+const ConsensusVersion = 5
 
 var (
+	_ module.BeginBlockAppModule = AppModule{}
 	_ module.EndBlockAppModule   = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
@@ -176,6 +178,8 @@ type GovInputs struct {
 	AccountKeeper govtypes.AccountKeeper
 	BankKeeper    govtypes.BankKeeper
 	StakingKeeper govtypes.StakingKeeper
+	PhotonKeeper  govtypes.PhotonKeeper `optional:"true"`
+	DistrKeeper   govtypes.DistributionKeeper
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace govtypes.ParamSubspace `optional:"true"`
@@ -187,6 +191,7 @@ type GovOutputs struct {
 	Module       appmodule.AppModule
 	Keeper       *keeper.Keeper
 	HandlerRoute v1beta1.HandlerRoute
+	StakingHooks stakingtypes.StakingHooksWrapper
 }
 
 func ProvideModule(in GovInputs) GovOutputs {
@@ -207,6 +212,8 @@ func ProvideModule(in GovInputs) GovOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.StakingKeeper,
+		in.PhotonKeeper,
+		in.DistrKeeper,
 		in.MsgServiceRouter,
 		kConfig,
 		authority.String(),
@@ -214,7 +221,12 @@ func ProvideModule(in GovInputs) GovOutputs {
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
 	hr := v1beta1.HandlerRoute{Handler: v1beta1.ProposalHandler, RouteKey: govtypes.RouterKey}
 
-	return GovOutputs{Module: m, Keeper: k, HandlerRoute: hr}
+	return GovOutputs{
+		Module:       m,
+		Keeper:       k,
+		HandlerRoute: hr,
+		StakingHooks: stakingtypes.StakingHooksWrapper{StakingHooks: k.StakingHooks()},
+	}
 }
 
 func ProvideKeyTable() paramtypes.KeyTable {
@@ -284,7 +296,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	v1.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := keeper.NewMigrator(am.keeper, am.legacySubspace)
-	_ = m
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -306,6 +320,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// BeginBlock returns the begin blocker for the gov module.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
+
 // EndBlock returns the end blocker for the gov module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
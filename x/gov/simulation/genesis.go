@@ -22,7 +22,6 @@ import (
 const (
 	DepositParamsMinDeposit                   = "deposit_params_min_deposit"
 	DepositParamsDepositPeriod                = "deposit_params_deposit_period"
-	DepositMinInitialRatio                    = "deposit_params_min_initial_ratio"
 	VotingParamsVotingPeriod                  = "voting_params_voting_period"
 	TallyParamsQuorum                         = "tally_params_quorum"
 	TallyParamsThreshold                      = "tally_params_threshold"
@@ -36,6 +35,45 @@ const (
 	QuorumTimeout            = "quorum_timeout"
 	MaxVotingPeriodExtension = "max_voting_period_extension"
 	QuorumCheckCount         = "quorum_check_count"
+
+	MinDepositUpdatePeriod              = "min_deposit_update_period"
+	MinDepositSensitivityTargetDistance = "min_deposit_sensitivity_target_distance"
+	MinDepositIncreaseRatio             = "min_deposit_increase_ratio"
+	MinDepositDecreaseRatio             = "min_deposit_decrease_ratio"
+	TargetActiveProposals               = "target_active_proposals"
+
+	MinInitialDepositFloor                     = "min_initial_deposit_floor"
+	MinInitialDepositUpdatePeriod              = "min_initial_deposit_update_period"
+	MinInitialDepositSensitivityTargetDistance = "min_initial_deposit_sensitivity_target_distance"
+	MinInitialDepositIncreaseRatio             = "min_initial_deposit_increase_ratio"
+	MinInitialDepositDecreaseRatio             = "min_initial_deposit_decrease_ratio"
+	TargetProposalsInDepositPeriod             = "target_proposals_in_deposit_period"
+
+	MinGovernorSelfDelegation  = "min_governor_self_delegation"
+	GovernorStatusChangePeriod = "governor_status_change_period"
+
+	DynamicQuorum                    = "dynamic_quorum"
+	QuorumRange                      = "quorum_range"
+	ConstitutionAmendmentQuorumRange = "constitution_amendment_quorum_range"
+	LawQuorumRange                   = "law_quorum_range"
+
+	PersistFinalVotes         = "persist_final_votes"
+	FinalVotesRetentionPeriod = "final_votes_retention_period"
+
+	ProposalCancelRatio = "proposal_cancel_ratio"
+
+	ExpeditedVotingPeriod = "expedited_voting_period"
+	ExpeditedThreshold    = "expedited_threshold"
+	ExpeditedMinDeposit   = "expedited_min_deposit"
+
+	MessageTallyParams = "message_tally_params"
+
+	RecordVoteHistory = "record_vote_history"
+	MaxVoteChanges    = "max_vote_changes"
+
+	MaxDelegationsChecked = "max_delegations_checked"
+
+	ExecutionDelay = "execution_delay"
 )
 
 // GenDepositParamsDepositPeriod returns randomized DepositParamsDepositPeriod
@@ -43,14 +81,22 @@ func GenDepositParamsDepositPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
-// GenDepositParamsMinDeposit returns randomized DepositParamsMinDeposit
+// GenDepositParamsMinDeposit returns randomized DepositParamsMinDeposit, used
+// as the floor value of the dynamic min deposit
 func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
-// GenDepositMinInitialRatio returns randomized DepositMinInitialRatio
-func GenDepositMinInitialDepositRatio(r *rand.Rand) sdk.Dec {
-	return sdk.NewDec(int64(simulation.RandIntBetween(r, 0, 99))).Quo(sdk.NewDec(100))
+// GenMinInitialDepositFloor returns a randomized MinInitialDepositFloor
+// between 1% and 99% of minDeposit, with at least 1 token per denom.
+func GenMinInitialDepositFloor(r *rand.Rand, minDeposit sdk.Coins) sdk.Coins {
+	ratio := sdk.NewDec(int64(simulation.RandIntBetween(r, 1, 99))).Quo(sdk.NewDec(100))
+	floor := sdk.NewCoins()
+	for _, coin := range minDeposit {
+		amount := sdk.MaxInt(sdk.NewDecFromInt(coin.Amount).Mul(ratio).TruncateInt(), sdk.OneInt())
+		floor = floor.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return floor
 }
 
 // GenVotingParamsVotingPeriod returns randomized VotingParamsVotingPeriod
@@ -101,6 +147,138 @@ func GenQuorumCheckCount(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 0, 30))
 }
 
+// GenMinDepositUpdatePeriod returns a randomized MinDepositUpdatePeriod between 1 second and 2 weeks
+func GenMinDepositUpdatePeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*24*14)) * time.Second
+}
+
+// GenMinDepositSensitivityTargetDistance returns a randomized MinDepositSensitivityTargetDistance between 1 and 10
+func GenMinDepositSensitivityTargetDistance(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 10))
+}
+
+// GenMinDepositIncreaseRatio returns a randomized MinDepositIncreaseRatio between 0.002 and 0.1
+func GenMinDepositIncreaseRatio(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 2, 100)), 3)
+}
+
+// GenMinDepositDecreaseRatio returns a randomized MinDepositDecreaseRatio
+// strictly less than increaseRatio.
+func GenMinDepositDecreaseRatio(r *rand.Rand, increaseRatio math.LegacyDec) math.LegacyDec {
+	max := int(increaseRatio.Mul(sdk.NewDec(1000)).RoundInt64())
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, max)), 3)
+}
+
+// GenTargetActiveProposals returns a randomized TargetActiveProposals between 1 and 100
+func GenTargetActiveProposals(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenTargetProposalsInDepositPeriod returns a randomized
+// TargetProposalsInDepositPeriod between 1 and 100
+func GenTargetProposalsInDepositPeriod(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenMinGovernorSelfDelegation returns a randomized MinGovernorSelfDelegation
+func GenMinGovernorSelfDelegation(r *rand.Rand) math.Int {
+	return math.NewInt(int64(simulation.RandIntBetween(r, 1, 1e3)))
+}
+
+// GenGovernorStatusChangePeriod returns a randomized GovernorStatusChangePeriod
+func GenGovernorStatusChangePeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+}
+
+// GenDynamicQuorum returns a randomized DynamicQuorum
+func GenDynamicQuorum(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenQuorumRange returns a randomized QuorumRange, with a min between 0.05
+// and 0.3 and a max between min and 0.6.
+func GenQuorumRange(r *rand.Rand) v1.QuorumRange {
+	min := simulation.RandIntBetween(r, 50, 300)
+	max := simulation.RandIntBetween(r, min, 600)
+	return v1.QuorumRange{
+		Min: sdk.NewDecWithPrec(int64(min), 3).String(),
+		Max: sdk.NewDecWithPrec(int64(max), 3).String(),
+	}
+}
+
+// GenPersistFinalVotes returns a randomized PersistFinalVotes
+func GenPersistFinalVotes(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenFinalVotesRetentionPeriod returns a randomized FinalVotesRetentionPeriod
+// between 1 hour and 30 days
+func GenFinalVotesRetentionPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*30)) * time.Second
+}
+
+// GenProposalCancelRatio returns a randomized ProposalCancelRatio between 0
+// and 1
+func GenProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
+// GenExpeditedVotingPeriod returns a randomized ExpeditedVotingPeriod
+// strictly less than votingPeriod.
+func GenExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod)))
+}
+
+// GenExpeditedThreshold returns a randomized ExpeditedThreshold strictly
+// greater than threshold.
+func GenExpeditedThreshold(r *rand.Rand, threshold sdk.Dec) sdk.Dec {
+	min := int(threshold.Mul(sdk.NewDec(1000)).RoundInt64())
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, min+1, 1001)), 3)
+}
+
+// GenExpeditedMinDeposit returns a randomized ExpeditedMinDeposit between 2
+// and 10 times minDeposit.
+func GenExpeditedMinDeposit(r *rand.Rand, minDeposit sdk.Coins) sdk.Coins {
+	return minDeposit.MulInt(sdk.NewInt(int64(simulation.RandIntBetween(r, 2, 11))))
+}
+
+// GenMessageTallyParams returns randomized MessageTallyParams, either empty or
+// with overrides for software upgrades greater than or equal to quorum and
+// threshold.
+func GenMessageTallyParams(r *rand.Rand, quorum, threshold sdk.Dec) []v1.MessageTallyParams {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	return []v1.MessageTallyParams{{
+		MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		Quorum:     GenTallyParamsConstitutionalQuorum(r, quorum).String(),
+		Threshold:  GenTallyParamsConstitutionalThreshold(r, threshold).String(),
+	}}
+}
+
+// GenRecordVoteHistory returns a randomized RecordVoteHistory
+func GenRecordVoteHistory(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMaxVoteChanges returns a randomized MaxVoteChanges between 0 (unlimited)
+// and 5
+func GenMaxVoteChanges(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 6))
+}
+
+// GenMaxDelegationsChecked returns a randomized MaxDelegationsChecked between
+// 1 and 100
+func GenMaxDelegationsChecked(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 101))
+}
+
+// GenExecutionDelay returns a randomized ExecutionDelay between 0 (disabled)
+// and 2 days
+func GenExecutionDelay(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*2)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -117,11 +295,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
-	var minInitialDepositRatio sdk.Dec
-	simState.AppParams.GetOrGenerate(
-		simState.Cdc, DepositMinInitialRatio, &minInitialDepositRatio, simState.Rand,
-		func(r *rand.Rand) { minInitialDepositRatio = GenDepositMinInitialDepositRatio(r) },
-	)
+	var minInitialDepositFloor sdk.Coins
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinInitialDepositFloor, &minInitialDepositFloor, simState.Rand, func(r *rand.Rand) {
+		minInitialDepositFloor = GenMinInitialDepositFloor(r, minDeposit)
+	})
 
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
@@ -179,9 +356,140 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var quorumCheckCount uint64
 	simState.AppParams.GetOrGenerate(simState.Cdc, QuorumCheckCount, &quorumCheckCount, simState.Rand, func(r *rand.Rand) { quorumCheckCount = GenQuorumCheckCount(r) })
 
+	var minDepositUpdatePeriod time.Duration
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinDepositUpdatePeriod, &minDepositUpdatePeriod, simState.Rand, func(r *rand.Rand) { minDepositUpdatePeriod = GenMinDepositUpdatePeriod(r) })
+
+	var minDepositSensitivityTargetDistance uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinDepositSensitivityTargetDistance, &minDepositSensitivityTargetDistance, simState.Rand, func(r *rand.Rand) {
+		minDepositSensitivityTargetDistance = GenMinDepositSensitivityTargetDistance(r)
+	})
+
+	var minDepositIncreaseRatio math.LegacyDec
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinDepositIncreaseRatio, &minDepositIncreaseRatio, simState.Rand, func(r *rand.Rand) { minDepositIncreaseRatio = GenMinDepositIncreaseRatio(r) })
+
+	var minDepositDecreaseRatio math.LegacyDec
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinDepositDecreaseRatio, &minDepositDecreaseRatio, simState.Rand, func(r *rand.Rand) {
+		minDepositDecreaseRatio = GenMinDepositDecreaseRatio(r, minDepositIncreaseRatio)
+	})
+
+	var targetActiveProposals uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, TargetActiveProposals, &targetActiveProposals, simState.Rand, func(r *rand.Rand) { targetActiveProposals = GenTargetActiveProposals(r) })
+
+	var minInitialDepositUpdatePeriod time.Duration
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinInitialDepositUpdatePeriod, &minInitialDepositUpdatePeriod, simState.Rand, func(r *rand.Rand) {
+		minInitialDepositUpdatePeriod = GenMinDepositUpdatePeriod(r)
+	})
+
+	var minInitialDepositSensitivityTargetDistance uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinInitialDepositSensitivityTargetDistance, &minInitialDepositSensitivityTargetDistance, simState.Rand, func(r *rand.Rand) {
+		minInitialDepositSensitivityTargetDistance = GenMinDepositSensitivityTargetDistance(r)
+	})
+
+	var minInitialDepositIncreaseRatio math.LegacyDec
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinInitialDepositIncreaseRatio, &minInitialDepositIncreaseRatio, simState.Rand, func(r *rand.Rand) {
+		minInitialDepositIncreaseRatio = GenMinDepositIncreaseRatio(r)
+	})
+
+	var minInitialDepositDecreaseRatio math.LegacyDec
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinInitialDepositDecreaseRatio, &minInitialDepositDecreaseRatio, simState.Rand, func(r *rand.Rand) {
+		minInitialDepositDecreaseRatio = GenMinDepositDecreaseRatio(r, minInitialDepositIncreaseRatio)
+	})
+
+	var targetProposalsInDepositPeriod uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, TargetProposalsInDepositPeriod, &targetProposalsInDepositPeriod, simState.Rand, func(r *rand.Rand) {
+		targetProposalsInDepositPeriod = GenTargetProposalsInDepositPeriod(r)
+	})
+
+	var minGovernorSelfDelegation math.Int
+	simState.AppParams.GetOrGenerate(simState.Cdc, MinGovernorSelfDelegation, &minGovernorSelfDelegation, simState.Rand, func(r *rand.Rand) {
+		minGovernorSelfDelegation = GenMinGovernorSelfDelegation(r)
+	})
+
+	var governorStatusChangePeriod time.Duration
+	simState.AppParams.GetOrGenerate(simState.Cdc, GovernorStatusChangePeriod, &governorStatusChangePeriod, simState.Rand, func(r *rand.Rand) {
+		governorStatusChangePeriod = GenGovernorStatusChangePeriod(r)
+	})
+
+	var dynamicQuorum bool
+	simState.AppParams.GetOrGenerate(simState.Cdc, DynamicQuorum, &dynamicQuorum, simState.Rand, func(r *rand.Rand) { dynamicQuorum = GenDynamicQuorum(r) })
+
+	var quorumRange v1.QuorumRange
+	simState.AppParams.GetOrGenerate(simState.Cdc, QuorumRange, &quorumRange, simState.Rand, func(r *rand.Rand) { quorumRange = GenQuorumRange(r) })
+
+	var amendmentsQuorumRange v1.QuorumRange
+	simState.AppParams.GetOrGenerate(simState.Cdc, ConstitutionAmendmentQuorumRange, &amendmentsQuorumRange, simState.Rand, func(r *rand.Rand) {
+		amendmentsQuorumRange = GenQuorumRange(r)
+	})
+
+	var lawQuorumRange v1.QuorumRange
+	simState.AppParams.GetOrGenerate(simState.Cdc, LawQuorumRange, &lawQuorumRange, simState.Rand, func(r *rand.Rand) { lawQuorumRange = GenQuorumRange(r) })
+
+	var persistFinalVotes bool
+	simState.AppParams.GetOrGenerate(simState.Cdc, PersistFinalVotes, &persistFinalVotes, simState.Rand, func(r *rand.Rand) { persistFinalVotes = GenPersistFinalVotes(r) })
+
+	var finalVotesRetentionPeriod time.Duration
+	simState.AppParams.GetOrGenerate(simState.Cdc, FinalVotesRetentionPeriod, &finalVotesRetentionPeriod, simState.Rand, func(r *rand.Rand) {
+		finalVotesRetentionPeriod = GenFinalVotesRetentionPeriod(r)
+	})
+
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(simState.Cdc, ProposalCancelRatio, &proposalCancelRatio, simState.Rand, func(r *rand.Rand) { proposalCancelRatio = GenProposalCancelRatio(r) })
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(simState.Cdc, ExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand, func(r *rand.Rand) {
+		expeditedVotingPeriod = GenExpeditedVotingPeriod(r, votingPeriod)
+	})
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(simState.Cdc, ExpeditedThreshold, &expeditedThreshold, simState.Rand, func(r *rand.Rand) {
+		expeditedThreshold = GenExpeditedThreshold(r, threshold)
+	})
+
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(simState.Cdc, ExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand, func(r *rand.Rand) {
+		expeditedMinDeposit = GenExpeditedMinDeposit(r, minDeposit)
+	})
+
+	var messageTallyParams []v1.MessageTallyParams
+	simState.AppParams.GetOrGenerate(simState.Cdc, MessageTallyParams, &messageTallyParams, simState.Rand, func(r *rand.Rand) {
+		minMsgQuorum := quorum
+		if dynamicQuorum {
+			// with the dynamic quorum, the overrides can't be below the range max
+			minMsgQuorum = sdk.MaxDec(quorum, sdk.MustNewDecFromStr(quorumRange.Max))
+		}
+		messageTallyParams = GenMessageTallyParams(r, minMsgQuorum, threshold)
+	})
+
+	var recordVoteHistory bool
+	simState.AppParams.GetOrGenerate(simState.Cdc, RecordVoteHistory, &recordVoteHistory, simState.Rand, func(r *rand.Rand) { recordVoteHistory = GenRecordVoteHistory(r) })
+
+	var maxVoteChanges uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, MaxVoteChanges, &maxVoteChanges, simState.Rand, func(r *rand.Rand) { maxVoteChanges = GenMaxVoteChanges(r) })
+
+	var maxDelegationsChecked uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, MaxDelegationsChecked, &maxDelegationsChecked, simState.Rand, func(r *rand.Rand) {
+		maxDelegationsChecked = GenMaxDelegationsChecked(r)
+	})
+
+	var executionDelay time.Duration
+	simState.AppParams.GetOrGenerate(simState.Cdc, ExecutionDelay, &executionDelay, simState.Rand, func(r *rand.Rand) { executionDelay = GenExecutionDelay(r) })
+
+	// NOTE: the minimum staked tokens to vote and deposit are disabled to avoid
+	// failing the simulation, since simulated accounts may not have any stake
+	minStakedTokens := math.ZeroInt()
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), amendmentsQuorum.String(), amendmentsThreshold.String(), lawQuorum.String(), lawThreshold.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, minDepositRatio.String(), quorumTimout, maxVotingPeriodExtension, quorumCheckCount),
+		v1.NewParams(depositPeriod, votingPeriod, quorum.String(), threshold.String(), amendmentsQuorum.String(), amendmentsThreshold.String(), lawQuorum.String(), lawThreshold.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, minDepositRatio.String(), quorumTimout, maxVotingPeriodExtension, quorumCheckCount,
+			minDeposit, minDepositUpdatePeriod, minDepositSensitivityTargetDistance, minDepositIncreaseRatio.String(), minDepositDecreaseRatio.String(), targetActiveProposals,
+			minInitialDepositFloor, minInitialDepositUpdatePeriod, minInitialDepositSensitivityTargetDistance, minInitialDepositIncreaseRatio.String(), minInitialDepositDecreaseRatio.String(), targetProposalsInDepositPeriod,
+			minGovernorSelfDelegation.String(), governorStatusChangePeriod,
+			dynamicQuorum, quorumRange.Min, quorumRange.Max, amendmentsQuorumRange.Min, amendmentsQuorumRange.Max, lawQuorumRange.Min, lawQuorumRange.Max,
+			persistFinalVotes, finalVotesRetentionPeriod, proposalCancelRatio.String(),
+			expeditedVotingPeriod, expeditedThreshold.String(), expeditedMinDeposit, v1.DefaultExpeditedAllowedMsgTypeURLs,
+			messageTallyParams, recordVoteHistory, maxVoteChanges,
+			minStakedTokens.String(), maxDelegationsChecked, minStakedTokens.String(),
+			executionDelay, nil, v1.DefaultMaxProposalExecutionGas, v1.DefaultMaxEndBlockerProposals),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
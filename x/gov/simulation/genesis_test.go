@@ -44,16 +44,15 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &govGenesis)
 
 	const (
-		tallyQuorum          = "0.362000000000000000"
-		tallyThreshold       = "0.639000000000000000"
-		amendmentQuorum      = "0.579000000000000000"
-		amendmentThreshold   = "0.895000000000000000"
-		lawQuorum            = "0.552000000000000000"
-		lawThreshold         = "0.816000000000000000"
-		minInitialDepositDec = "0.590000000000000000"
+		tallyQuorum        = "0.362000000000000000"
+		tallyThreshold     = "0.639000000000000000"
+		amendmentQuorum    = "0.579000000000000000"
+		amendmentThreshold = "0.895000000000000000"
+		lawQuorum          = "0.552000000000000000"
+		lawThreshold       = "0.816000000000000000"
 	)
 
-	require.Equal(t, "905stake", govGenesis.Params.MinDeposit[0].String())
+	require.Equal(t, "905stake", govGenesis.Params.MinDepositThrottler.FloorValue[0].String())
 	require.Equal(t, "77h26m10s", govGenesis.Params.MaxDepositPeriod.String())
 	require.Equal(t, float64(275567), govGenesis.Params.VotingPeriod.Seconds())
 	require.Equal(t, tallyQuorum, govGenesis.Params.Quorum)
@@ -62,7 +61,8 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, amendmentThreshold, govGenesis.Params.ConstitutionAmendmentThreshold)
 	require.Equal(t, lawQuorum, govGenesis.Params.LawQuorum)
 	require.Equal(t, lawThreshold, govGenesis.Params.LawThreshold)
-	require.Equal(t, minInitialDepositDec, govGenesis.Params.MinInitialDepositRatio)
+	require.Equal(t, "45stake", govGenesis.Params.MinInitialDepositThrottler.FloorValue[0].String())
+	require.Equal(t, uint64(23), govGenesis.Params.MinInitialDepositThrottler.TargetProposals)
 	require.Equal(t, "7h46m6s", govGenesis.Params.QuorumTimeout.String())
 	require.Equal(t, "82h43m30s", govGenesis.Params.MaxVotingPeriodExtension.String())
 	require.Equal(t, uint64(5), govGenesis.Params.QuorumCheckCount)
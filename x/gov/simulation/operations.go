@@ -206,7 +206,7 @@ func simulateMsgSubmitProposal(ak types.AccountKeeper, bk types.BankKeeper, k *k
 			simAccount.Address.String(),
 			simtypes.RandStringOfLength(r, 100),
 			simtypes.RandStringOfLength(r, 100),
-			simtypes.RandStringOfLength(r, 100),
+			simtypes.RandStringOfLength(r, 100), false,
 		)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate a submit proposal msg"), nil, err
@@ -287,6 +287,10 @@ func SimulateMsgDeposit(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.K
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDeposit, "unable to generate deposit"), nil, err
 		}
 
+		if err := k.ValidateDepositorStake(ctx, simAccount.Address); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDeposit, "depositor has insufficient stake"), nil, nil
+		}
+
 		msg := v1.NewMsgDeposit(simAccount.Address, proposalID, deposit)
 
 		account := ak.GetAccount(ctx, simAccount.Address)
@@ -345,6 +349,10 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k *ke
 			proposalID = uint64(proposalIDInt)
 		}
 
+		if err := k.ValidateVoterStake(ctx, simAccount.Address); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVote, "voter has insufficient stake"), nil, nil
+		}
+
 		option := randomVotingOption(r)
 		msg := v1.NewMsgVote(simAccount.Address, proposalID, option, "")
 
@@ -397,6 +405,10 @@ func operationSimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeepe
 			proposalID = uint64(proposalIDInt)
 		}
 
+		if err := k.ValidateVoterStake(ctx, simAccount.Address); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteWeighted, "voter has insufficient stake"), nil, nil
+		}
+
 		options := randomWeightedVotingOptions(r)
 		msg := v1.NewMsgVoteWeighted(simAccount.Address, proposalID, options, "")
 
@@ -443,7 +455,7 @@ func randomDeposit(
 	}
 
 	params := k.GetParams(ctx)
-	minDeposit := params.MinDeposit
+	minDeposit := k.GetMinDeposit(ctx)
 	denomIndex := r.Intn(len(minDeposit))
 	denom := minDeposit[denomIndex].Denom
 
@@ -463,19 +475,17 @@ func randomDeposit(
 
 	minAmount := sdk.ZeroInt()
 	if useMinAmount {
-		minDepositPercent, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
+		minAmount = k.GetMinInitialDeposit(ctx).AmountOf(denom)
+	}
+
+	amount := minAmount
+	if minDepositAmount.GT(minAmount) {
+		amount, err = simtypes.RandPositiveInt(r, minDepositAmount.Sub(minAmount))
 		if err != nil {
 			return nil, false, err
 		}
-
-		minAmount = sdk.NewDecFromInt(minDepositAmount).Mul(minDepositPercent).TruncateInt()
-	}
-
-	amount, err := simtypes.RandPositiveInt(r, minDepositAmount.Sub(minAmount))
-	if err != nil {
-		return nil, false, err
+		amount = amount.Add(minAmount)
 	}
-	amount = amount.Add(minAmount)
 
 	// NOTE: backport from v50
 	amount = amount.MulRaw(3) // 3x what's required // TODO: this is a hack, we need to be able to calculate the correct amount using params
@@ -79,8 +79,11 @@ func SimulateConstitutionAmendmentProposal(_ *rand.Rand, ctx sdk.Context, _ []si
 }
 
 // SimulateLawProposal returns a random law proposal.
-func SimulateLawProposal(_ *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
-	return &v1.MsgProposeLaw{
-		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-	}
+func SimulateLawProposal(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	return v1.NewMsgProposeLaw(
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		simtypes.RandStringOfLength(r, 140),
+		simtypes.RandStringOfLength(r, 5000),
+		nil,
+	)
 }
@@ -31,6 +31,17 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	types.StakingKeeper
 
-	BondDenom(ctx sdk.Context) string
 	TokensFromConsensusPower(ctx sdk.Context, power int64) math.Int
 }
+
+// PhotonKeeper extends gov's actual expected PhotonKeeper with additional
+// methods used in tests.
+type PhotonKeeper interface {
+	types.PhotonKeeper
+}
+
+// DistributionKeeper extends gov's actual expected DistributionKeeper with
+// additional methods used in tests.
+type DistributionKeeper interface {
+	types.DistributionKeeper
+}
@@ -4,11 +4,20 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+	// MaxAmendmentOffset defines the maximum number of lines a hunk of a
+	// constitution amendment can be moved from its original position to apply.
+	MaxAmendmentOffset uint64
+	// MaxAmendmentFuzz defines the maximum number of leading and trailing
+	// context lines of a hunk of a constitution amendment that can be ignored
+	// to apply.
+	MaxAmendmentFuzz uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:     255,
+		MaxAmendmentOffset: 1000,
+		MaxAmendmentFuzz:   2,
 	}
 }
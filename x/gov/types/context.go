@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// executedProposalIDKey is the context key holding the ID of the proposal
+// whose messages are being executed.
+type executedProposalIDKey struct{}
+
+// WithExecutedProposalID returns a copy of ctx holding the ID of the proposal
+// whose messages are executed with it, so that message handlers can refer to
+// the proposal.
+func WithExecutedProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(executedProposalIDKey{}, proposalID)
+}
+
+// ExecutedProposalID returns the ID of the proposal whose messages are
+// executed with ctx. The second return value is false if ctx isn't used to
+// execute the messages of a proposal.
+func ExecutedProposalID(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(executedProposalIDKey{}).(uint64)
+	return proposalID, ok
+}
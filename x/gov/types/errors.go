@@ -23,4 +23,23 @@ var (
 	ErrMetadataTooLong              = sdkerrors.Register(ModuleName, 150, "metadata too long")                                        //nolint:staticcheck
 	ErrMinDepositTooSmall           = sdkerrors.Register(ModuleName, 160, "minimum deposit is too small")                             //nolint:staticcheck
 	ErrInvalidConstitutionAmendment = sdkerrors.Register(ModuleName, 170, "invalid constitution amendment")                           //nolint:staticcheck
+	ErrGovernorExists               = sdkerrors.Register(ModuleName, 180, "governor already exists")                                  //nolint:staticcheck
+	ErrUnknownGovernor              = sdkerrors.Register(ModuleName, 190, "unknown governor")                                         //nolint:staticcheck
+	ErrInvalidGovernorStatus        = sdkerrors.Register(ModuleName, 200, "invalid governor status")                                  //nolint:staticcheck
+	ErrGovernorStatusChangePeriod   = sdkerrors.Register(ModuleName, 210, "governor status change period not elapsed")                //nolint:staticcheck
+	ErrInsufficientSelfDelegation   = sdkerrors.Register(ModuleName, 220, "insufficient governor self delegation")                    //nolint:staticcheck
+	ErrDelegatorIsGovernor          = sdkerrors.Register(ModuleName, 230, "delegator is a governor")                                  //nolint:staticcheck
+	ErrNoGovernanceDelegation       = sdkerrors.Register(ModuleName, 240, "no governance delegation")                                 //nolint:staticcheck
+	ErrInvalidGovernorDescription   = sdkerrors.Register(ModuleName, 250, "invalid governor description")                             //nolint:staticcheck
+	ErrInvalidProposer              = sdkerrors.Register(ModuleName, 260, "invalid proposer")                                         //nolint:staticcheck
+	ErrInvalidExpeditedProposal     = sdkerrors.Register(ModuleName, 270, "invalid expedited proposal")                               //nolint:staticcheck
+	ErrUnknownLaw                   = sdkerrors.Register(ModuleName, 280, "unknown law")                                              //nolint:staticcheck
+	ErrInvalidLaw                   = sdkerrors.Register(ModuleName, 290, "invalid law")                                              //nolint:staticcheck
+	ErrMaxVoteChangesReached        = sdkerrors.Register(ModuleName, 300, "max vote changes reached")                                 //nolint:staticcheck
+	ErrInsufficientStake            = sdkerrors.Register(ModuleName, 310, "insufficient stake")                                       //nolint:staticcheck
+	ErrUnknownFundingStream         = sdkerrors.Register(ModuleName, 320, "unknown funding stream")                                   //nolint:staticcheck
+	ErrInvalidFundingStream         = sdkerrors.Register(ModuleName, 330, "invalid funding stream")                                   //nolint:staticcheck
+	ErrUnknownConstitutionArticle   = sdkerrors.Register(ModuleName, 340, "unknown constitution article")                             //nolint:staticcheck
+	ErrProposalExecutionOutOfGas    = sdkerrors.Register(ModuleName, 350, "proposal execution out of gas")                            //nolint:staticcheck
+	ErrInvalidDepositDenom          = sdkerrors.Register(ModuleName, 360, "invalid deposit denom")                                    //nolint:staticcheck
 )
@@ -2,13 +2,25 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeSignalProposal   = "signal_proposal"
-	EventTypeQuorumCheck      = "quorum_check"
+	EventTypeSubmitProposal                = "submit_proposal"
+	EventTypeProposalDeposit               = "proposal_deposit"
+	EventTypeProposalVote                  = "proposal_vote"
+	EventTypeInactiveProposal              = "inactive_proposal"
+	EventTypeActiveProposal                = "active_proposal"
+	EventTypeSignalProposal                = "signal_proposal"
+	EventTypeQuorumCheck                   = "quorum_check"
+	EventTypeCreateGovernor                = "create_governor"
+	EventTypeEditGovernor                  = "edit_governor"
+	EventTypeDelegateGovernor              = "delegate_governor"
+	EventTypeUndelegateGovernor            = "undelegate_governor"
+	EventTypeCancelProposal                = "cancel_proposal"
+	EventTypeRatifyLaw                     = "ratify_law"
+	EventTypeCreateFundingStream           = "create_funding_stream"
+	EventTypeCancelFundingStream           = "cancel_funding_stream"
+	EventTypeFundingStreamPayout           = "funding_stream_payout"
+	EventTypeConstitutionAmendmentConflict = "constitution_amendment_conflict"
+	EventTypeAmendConstitution             = "amend_constitution"
+	EventTypeExecuteProposal               = "execute_proposal"
 
 	AttributeKeyVoter                        = "voter"
 	AttributeKeyProposalResult               = "proposal_result"
@@ -26,4 +38,23 @@ const (
 	AttributeValueProposalQuorumMet          = "proposal_quorum_met"           // met quorum
 	AttributeValueProposalQuorumNotMet       = "proposal_quorum_not_met"       // didn't meet quorum
 	AttributeValueProposalQuorumCheckSkipped = "proposal_quorum_check_skipped" // skipped quorum check
+	AttributeKeyGovernor                     = "governor"
+	AttributeKeyDelegator                    = "delegator"
+	AttributeKeyGovernorStatus               = "governor_status"
+	AttributeKeyProposer                     = "proposer"
+	AttributeKeyLawID                        = "law_id"
+	AttributeKeyFundingStreamID              = "funding_stream_id"
+	AttributeKeyRecipient                    = "recipient"
+	AttributeKeyPayoutResult                 = "payout_result"
+	AttributeKeyConflictingProposalID        = "conflicting_proposal_id"
+	AttributeKeyConstitutionVersion          = "constitution_version"
+	AttributeKeyHunkOffsets                  = "hunk_offsets"
+	AttributeKeyHunkFuzz                     = "hunk_fuzz"
+	AttributeKeyExecutionTime                = "execution_time"
+	AttributeKeyGasUsed                      = "gas_used"
+	AttributeValuePayoutSucceeded            = "payout_succeeded"
+	AttributeValuePayoutFailed               = "payout_failed" // error on community pool spend
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited vote threshold, converted to a regular proposal
+	AttributeValueProposalPendingExecution  = "proposal_pending_execution"  // met vote quorum, messages executed after the execution delay
 )
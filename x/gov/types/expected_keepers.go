@@ -26,6 +26,11 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+
+	BondDenom(ctx sdk.Context) string
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -51,6 +56,17 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// PhotonKeeper defines the expected interface needed to value photon deposits.
+type PhotonKeeper interface {
+	GetConversionRate(ctx sdk.Context) sdk.Dec
+}
+
+// DistributionKeeper defines the expected interface needed to pay funding
+// streams from the community pool.
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a governance keeper and another
 // keepers.
@@ -62,6 +78,7 @@ type GovHooks interface {
 	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote on a proposal is cast
 	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
+	AfterProposalCanceled(ctx sdk.Context, proposalID uint64)                              // Must be called when proposal is canceled by its proposer
 }
 
 type GovHooksWrapper struct{ GovHooks }
@@ -42,3 +42,9 @@ func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalI
 		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
 	}
 }
+
+func (h MultiGovHooks) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalCanceled(ctx, proposalID)
+	}
+}
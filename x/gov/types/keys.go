@@ -33,11 +33,67 @@ const (
 //
 // - 0x04<proposalID_Bytes>: []byte{0x01} if proposalID is in the voting period
 //
+// - 0x05<endTime_Bytes><proposalID_Bytes>: QuorumCheckQueueEntry
+//
+// - 0x06: activeProposalsNumber
+//
+// - 0x07: inactiveProposalsNumber
+//
+// - 0x08<executionTime_Bytes><proposalID_Bytes>: proposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
+// - 0x21<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: FinalVote
+//
+// - 0x22<pruneTime_Bytes><proposalID_Bytes>: proposalID
+//
+// - 0x23<proposalID_Bytes><sequence_Bytes>: VoteHistoryEntry
+//
+// - 0x24<proposalID_Bytes>: nextVoteHistorySequence
+//
+// - 0x25<proposalID_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: votedShares
+//
+// - 0x26<proposalID_Bytes><governorAddrLen (1 Byte)><governorAddr_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: governorDeductions
+//
+// - 0x27<proposalID_Bytes><governorAddrLen (1 Byte)><governorAddr_Bytes>: []byte{0x01} if the governor voted on proposalID
+//
 // - 0x30: Params
+//
+// - 0x40: Constitution
+//
+// - 0x41<version_Bytes>: ConstitutionVersion
+//
+// - 0x42: StructuredConstitution
+//
+// - 0x50: LastMinDeposit
+//
+// - 0x51: LastMinInitialDeposit
+//
+// - 0x60<governorAddrLen (1 Byte)><governorAddr_Bytes>: Governor
+//
+// - 0x61<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: GovernanceDelegation
+//
+// - 0x62<governorAddrLen (1 Byte)><governorAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: []byte{0x01}
+//
+// - 0x63<governorAddrLen (1 Byte)><governorAddr_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: GovernorValShares
+//
+// - 0x70: ParticipationEMA
+//
+// - 0x71: ConstitutionAmendmentParticipationEMA
+//
+// - 0x72: LawParticipationEMA
+//
+// - 0x80<lawID_Bytes>: Law
+//
+// - 0x81: nextLawID
+//
+// - 0x90<streamID_Bytes>: FundingStream
+//
+// - 0x91: nextFundingStreamID
+//
+// - 0x92<payoutTime_Bytes><streamID_Bytes>: streamID
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -45,16 +101,61 @@ var (
 	ProposalIDKey                 = []byte{0x03}
 	VotingPeriodProposalKeyPrefix = []byte{0x04}
 	QuorumCheckQueuePrefix        = []byte{0x05}
+	ActiveProposalsNumberKey      = []byte{0x06}
+	InactiveProposalsNumberKey    = []byte{0x07}
+	ExecutionQueuePrefix          = []byte{0x08}
 
 	DepositsKeyPrefix = []byte{0x10}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix              = []byte{0x20}
+	FinalVotesKeyPrefix         = []byte{0x21}
+	FinalVotesPruneQueuePrefix  = []byte{0x22}
+	VoteHistoryKeyPrefix        = []byte{0x23}
+	VoteHistorySequencePrefix   = []byte{0x24}
+	VotedSharesKeyPrefix        = []byte{0x25}
+	GovernorDeductionsKeyPrefix = []byte{0x26}
+	VotingGovernorsKeyPrefix    = []byte{0x27}
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
 
 	// KeyConstitution is the key string used to store the chain's constitution
 	KeyConstitution = []byte{0x40}
+
+	// ConstitutionHistoryKeyPrefix is the prefix used to store the versions of
+	// the chain's constitution
+	ConstitutionHistoryKeyPrefix = []byte{0x41}
+
+	// KeyStructuredConstitution is the key used to store the chain's
+	// structured constitution
+	KeyStructuredConstitution = []byte{0x42}
+
+	// LastMinDepositKey is the key used to store the last updated value of the
+	// dynamic min deposit
+	LastMinDepositKey = []byte{0x50}
+
+	// LastMinInitialDepositKey is the key used to store the last updated value
+	// of the dynamic min initial deposit
+	LastMinInitialDepositKey = []byte{0x51}
+
+	GovernorKeyPrefix                        = []byte{0x60}
+	GovernanceDelegationKeyPrefix            = []byte{0x61}
+	GovernanceDelegationsByGovernorKeyPrefix = []byte{0x62}
+	GovernorValSharesKeyPrefix               = []byte{0x63}
+
+	// ParticipationEMAKey, ConstitutionAmendmentParticipationEMAKey and
+	// LawParticipationEMAKey are the keys used to store the participation
+	// exponential moving averages of each kind of proposal
+	ParticipationEMAKey                      = []byte{0x70}
+	ConstitutionAmendmentParticipationEMAKey = []byte{0x71}
+	LawParticipationEMAKey                   = []byte{0x72}
+
+	LawsKeyPrefix = []byte{0x80}
+	LawIDKey      = []byte{0x81}
+
+	FundingStreamsKeyPrefix        = []byte{0x90}
+	FundingStreamIDKey             = []byte{0x91}
+	FundingStreamPayoutQueuePrefix = []byte{0x92}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -76,6 +177,34 @@ func ProposalKey(proposalID uint64) []byte {
 	return append(ProposalsKeyPrefix, GetProposalIDBytes(proposalID)...)
 }
 
+// ConstitutionVersionKey gets a specific version of the constitution from the
+// store
+func ConstitutionVersionKey(version uint64) []byte {
+	return append(ConstitutionHistoryKeyPrefix, sdk.Uint64ToBigEndian(version)...)
+}
+
+// LawKey gets a specific law from the store
+func LawKey(lawID uint64) []byte {
+	return append(LawsKeyPrefix, sdk.Uint64ToBigEndian(lawID)...)
+}
+
+// FundingStreamKey gets a specific funding stream from the store
+func FundingStreamKey(streamID uint64) []byte {
+	return append(FundingStreamsKeyPrefix, sdk.Uint64ToBigEndian(streamID)...)
+}
+
+// FundingStreamPayoutQueueByTimeKey gets the funding stream payout queue key
+// by payoutTime
+func FundingStreamPayoutQueueByTimeKey(payoutTime time.Time) []byte {
+	return append(FundingStreamPayoutQueuePrefix, sdk.FormatTimeBytes(payoutTime)...)
+}
+
+// FundingStreamPayoutQueueKey returns the key for a streamID in the funding
+// stream payout queue
+func FundingStreamPayoutQueueKey(streamID uint64, payoutTime time.Time) []byte {
+	return append(FundingStreamPayoutQueueByTimeKey(payoutTime), sdk.Uint64ToBigEndian(streamID)...)
+}
+
 // VotingPeriodProposalKey gets if a proposal is in voting period.
 func VotingPeriodProposalKey(proposalID uint64) []byte {
 	return append(VotingPeriodProposalKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -111,6 +240,16 @@ func QuorumCheckQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(QuorumCheckByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// ExecutionQueueByTimeKey gets the execution queue key by executionTime
+func ExecutionQueueByTimeKey(executionTime time.Time) []byte {
+	return append(ExecutionQueuePrefix, sdk.FormatTimeBytes(executionTime)...)
+}
+
+// ExecutionQueueKey returns the key for a proposalID in the execution queue
+func ExecutionQueueKey(proposalID uint64, executionTime time.Time) []byte {
+	return append(ExecutionQueueByTimeKey(executionTime), GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -131,6 +270,121 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// FinalVotesKey gets the first part of the final votes key based on the
+// proposalID
+func FinalVotesKey(proposalID uint64) []byte {
+	return append(FinalVotesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// FinalVoteKey key of a specific final vote from the store
+func FinalVoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(FinalVotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// FinalVotesPruneQueueByTimeKey gets the final votes prune queue key by
+// pruneTime
+func FinalVotesPruneQueueByTimeKey(pruneTime time.Time) []byte {
+	return append(FinalVotesPruneQueuePrefix, sdk.FormatTimeBytes(pruneTime)...)
+}
+
+// FinalVotesPruneQueueKey returns the key for a proposalID in the final votes
+// prune queue
+func FinalVotesPruneQueueKey(proposalID uint64, pruneTime time.Time) []byte {
+	return append(FinalVotesPruneQueueByTimeKey(pruneTime), GetProposalIDBytes(proposalID)...)
+}
+
+// VoteHistoryKey gets the first part of the vote history key based on the
+// proposalID
+func VoteHistoryKey(proposalID uint64) []byte {
+	return append(VoteHistoryKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VoteHistoryEntryKey key of a specific vote history entry from the store
+func VoteHistoryEntryKey(proposalID, sequence uint64) []byte {
+	return append(VoteHistoryKey(proposalID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// VoteHistorySequenceKey gets the key of the next vote history sequence of a
+// proposal
+func VoteHistorySequenceKey(proposalID uint64) []byte {
+	return append(VoteHistorySequencePrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VotedSharesKey gets the first part of the voted shares key based on the
+// proposalID
+func VotedSharesKey(proposalID uint64) []byte {
+	return append(VotedSharesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VotedSharesByValidatorKey gets the key of the shares of a validator held by
+// the voters of a proposal
+func VotedSharesByValidatorKey(proposalID uint64, validatorAddr sdk.ValAddress) []byte {
+	return append(VotedSharesKey(proposalID), address.MustLengthPrefix(validatorAddr.Bytes())...)
+}
+
+// GovernorDeductionsKey gets the first part of the governor deductions key
+// based on the proposalID
+func GovernorDeductionsKey(proposalID uint64) []byte {
+	return append(GovernorDeductionsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// GovernorDeductionsByGovernorKey gets the first part of the governor
+// deductions key based on the proposalID and the governor address
+func GovernorDeductionsByGovernorKey(proposalID uint64, governorAddr sdk.AccAddress) []byte {
+	return append(GovernorDeductionsKey(proposalID), address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernorDeductionKey gets the key of the shares of a validator deducted
+// from a governor on a proposal
+func GovernorDeductionKey(proposalID uint64, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) []byte {
+	return append(GovernorDeductionsByGovernorKey(proposalID, governorAddr), address.MustLengthPrefix(validatorAddr.Bytes())...)
+}
+
+// VotingGovernorsKey gets the first part of the voting governors key based on
+// the proposalID
+func VotingGovernorsKey(proposalID uint64) []byte {
+	return append(VotingGovernorsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VotingGovernorKey gets the key marking a governor who voted on a proposal
+func VotingGovernorKey(proposalID uint64, governorAddr sdk.AccAddress) []byte {
+	return append(VotingGovernorsKey(proposalID), address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernorKey gets the key of a governor
+func GovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernorKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationKey gets the key of the governance delegation of a
+// delegator
+func GovernanceDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// GovernanceDelegationsByGovernorKey gets the first part of the governance
+// delegations index key based on the governor address
+func GovernanceDelegationsByGovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationByGovernorKey gets the governance delegations index key
+// of a delegator for a governor
+func GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKey(governorAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// GovernorValSharesByGovernorKey gets the first part of the governor
+// validator shares key based on the governor address
+func GovernorValSharesByGovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernorValSharesKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernorValSharesKey gets the key of the validator shares of a governor
+func GovernorValSharesKey(governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) []byte {
+	return append(GovernorValSharesByGovernorKey(governorAddr), address.MustLengthPrefix(validatorAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -155,6 +409,24 @@ func SplitQuorumQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
 	return splitKeyWithTime(key)
 }
 
+// SplitExecutionQueueKey split the execution queue key and returns the
+// proposal id and executionTime
+func SplitExecutionQueueKey(key []byte) (proposalID uint64, executionTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitFinalVotesPruneQueueKey split the final votes prune queue key and
+// returns the proposal id and pruneTime
+func SplitFinalVotesPruneQueueKey(key []byte) (proposalID uint64, pruneTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitFundingStreamPayoutQueueKey split the funding stream payout queue key
+// and returns the stream id and payoutTime
+func SplitFundingStreamPayoutQueueKey(key []byte) (streamID uint64, payoutTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -57,3 +58,16 @@ func TestVoteKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 2)
 	require.Equal(t, addr, voterAddr)
 }
+
+func TestGovernorKeys(t *testing.T) {
+	govAddr := sdk.AccAddress("governor")
+	valAddr := sdk.ValAddress("validator")
+
+	key := GovernanceDelegationByGovernorKey(govAddr, addr)
+	require.True(t, bytes.HasPrefix(key, GovernanceDelegationsByGovernorKey(govAddr)))
+	require.Equal(t, addr, sdk.AccAddress(key[len(GovernanceDelegationsByGovernorKey(govAddr))+1:]))
+
+	key = GovernorValSharesKey(govAddr, valAddr)
+	require.True(t, bytes.HasPrefix(key, GovernorValSharesByGovernorKey(govAddr)))
+	require.False(t, bytes.HasPrefix(GovernorKey(govAddr), GovernanceDelegationKey(govAddr)))
+}
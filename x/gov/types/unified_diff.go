@@ -197,73 +197,166 @@ func validateHunk(hunk *Hunk) error {
 	return nil
 }
 
-// applyHunks applies the parsed hunks to the source lines.
-func applyHunks(srcStr string, hunks []Hunk) ([]string, error) {
+// PatchOptions defines how far from their original position the hunks of a
+// unified diff can be applied, like the offset and fuzz of GNU patch.
+type PatchOptions struct {
+	// MaxOffset is the maximum number of lines a hunk can be moved from the
+	// position given by its header to find a match.
+	MaxOffset int
+	// MaxFuzz is the maximum number of leading and trailing context lines of
+	// a hunk that can be ignored to find a match.
+	MaxFuzz int
+}
+
+// HunkResult reports how a hunk has been applied.
+type HunkResult struct {
+	// Offset is the number of lines between the position given by the hunk
+	// header and the position where the hunk has been applied.
+	Offset int
+	// Fuzz is the number of leading and trailing context lines of the hunk
+	// which have been ignored.
+	Fuzz int
+}
+
+// applyHunks applies the parsed hunks to the source lines. Each hunk is
+// searched first at the position given by its header, adjusted by the offset
+// of the previous hunk, then further away up to opts.MaxOffset lines, and
+// then with less context lines up to opts.MaxFuzz.
+func applyHunks(srcStr string, hunks []Hunk, opts PatchOptions) ([]string, []HunkResult, error) {
 	srcLines := strings.Split(srcStr, "\n")
 	result := make([]string, 0)
+	hunkResults := make([]HunkResult, 0, len(hunks))
 	srcIndex := 0
+	lastOffset := 0
 
-	for _, hunk := range hunks {
-		// Add unchanged lines before the hunk
-		for srcIndex < hunk.SrcLine {
-			result = append(result, srcLines[srcIndex])
-			srcIndex++
+	for i, hunk := range hunks {
+		lines, pos, hunkResult, found := locateHunk(srcLines, srcIndex, hunk, lastOffset, opts)
+		if !found {
+			return nil, nil, fmt.Errorf("hunk %d does not apply at line %d", i+1, hunk.SrcLine+1)
 		}
 
-		// Apply hunk lines
-		for _, line := range hunk.Lines {
-			if len(line) == 0 {
-				continue
-			}
-			prefix := line[0]
-			content := line[1:]
+		// Add unchanged lines before the hunk
+		result = append(result, srcLines[srcIndex:pos]...)
+		srcIndex = pos
 
-			switch prefix {
+		// Apply hunk lines
+		for _, line := range lines {
+			switch line[0] {
 			case ' ':
-				// Context line, should match source
-				if srcIndex >= len(srcLines) || srcLines[srcIndex] != content {
-					return nil, fmt.Errorf("context line mismatch at line %d", srcIndex+1)
-				}
-				result = append(result, content)
+				// Context line, already matched against the source
+				result = append(result, srcLines[srcIndex])
 				srcIndex++
 			case '-':
 				// Deletion, skip source line
-				if srcIndex >= len(srcLines) || srcLines[srcIndex] != content {
-					return nil, fmt.Errorf("deletion line mismatch at line %d", srcIndex+1)
-				}
 				srcIndex++
 			case '+':
 				// Insertion, add to result
-				result = append(result, content)
-			default:
-				return nil, fmt.Errorf("invalid diff line: %s", line)
+				result = append(result, line[1:])
 			}
 		}
+
+		hunkResults = append(hunkResults, hunkResult)
+		lastOffset = hunkResult.Offset
 	}
 
 	// Add any remaining lines
-	for srcIndex < len(srcLines) {
-		result = append(result, srcLines[srcIndex])
-		srcIndex++
+	result = append(result, srcLines[srcIndex:]...)
+
+	return result, hunkResults, nil
+}
+
+// locateHunk searches the position of the source lines where the hunk
+// applies, not before srcIndex. It returns the lines of the hunk to apply,
+// without the context lines ignored by the fuzz, and the position where they
+// apply.
+func locateHunk(srcLines []string, srcIndex int, hunk Hunk, lastOffset int, opts PatchOptions) ([]string, int, HunkResult, bool) {
+	var lines []string
+	for _, line := range hunk.Lines {
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	leadingContext := 0
+	for leadingContext < len(lines) && lines[leadingContext][0] == ' ' {
+		leadingContext++
 	}
+	trailingContext := 0
+	for trailingContext < len(lines)-leadingContext && lines[len(lines)-1-trailingContext][0] == ' ' {
+		trailingContext++
+	}
+
+	// a hunk header with a 0 line number, like -0,0, applies at the start
+	srcLine := max(hunk.SrcLine, 0)
 
-	return result, nil
+	for fuzz := 0; fuzz <= opts.MaxFuzz; fuzz++ {
+		trimStart := min(fuzz, leadingContext)
+		trimEnd := min(fuzz, trailingContext)
+		if fuzz > 0 && trimStart < fuzz && trimEnd < fuzz {
+			// no more context lines to ignore
+			break
+		}
+		fuzzedLines := lines[trimStart : len(lines)-trimEnd]
+
+		// search the nearest offset first, alternating after and before
+		for distance := 0; ; distance++ {
+			tried := false
+			for _, offset := range []int{lastOffset + distance, lastOffset - distance} {
+				if offset > opts.MaxOffset || offset < -opts.MaxOffset || (distance == 0 && offset != lastOffset) {
+					continue
+				}
+				tried = true
+				pos := srcLine + trimStart + offset
+				if pos >= srcIndex && hunkMatches(srcLines, pos, fuzzedLines) {
+					return fuzzedLines, pos, HunkResult{Offset: offset, Fuzz: fuzz}, true
+				}
+			}
+			if !tried {
+				break
+			}
+		}
+	}
+	return nil, 0, HunkResult{}, false
+}
+
+// hunkMatches returns true if the context and deletion lines of the hunk
+// match the source lines starting at pos.
+func hunkMatches(srcLines []string, pos int, lines []string) bool {
+	for _, line := range lines {
+		if line[0] == '+' {
+			continue
+		}
+		if pos >= len(srcLines) || srcLines[pos] != line[1:] {
+			return false
+		}
+		pos++
+	}
+	return true
 }
 
 // ApplyUnifiedDiff applies a unified diff patch to the src string and returns the result.
+// Hunks must apply exactly at the position given by their header.
 // Does not make use of any external libraries to ensure deterministic behavior.
 func ApplyUnifiedDiff(src, diffStr string) (string, error) {
+	result, _, err := ApplyUnifiedDiffWithOptions(src, diffStr, PatchOptions{})
+	return result, err
+}
+
+// ApplyUnifiedDiffWithOptions applies a unified diff patch to the src string
+// and returns the result, along with the offset and fuzz used to apply each
+// hunk. Hunks can apply away from the position given by their header or with
+// less context lines, within the limits of opts.
+func ApplyUnifiedDiffWithOptions(src, diffStr string, opts PatchOptions) (string, []HunkResult, error) {
 	// Parse the unified diff into hunks
 	hunks, err := ParseUnifiedDiff(diffStr)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	// Apply the hunks to the source lines
-	resultLines, err := applyHunks(src, hunks)
+	resultLines, hunkResults, err := applyHunks(src, hunks, opts)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	return strings.Join(resultLines, "\n"), nil
+	return strings.Join(resultLines, "\n"), hunkResults, nil
 }
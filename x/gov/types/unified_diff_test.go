@@ -149,6 +149,149 @@ func TestApplyUnifiedDiff(t *testing.T) {
 	}
 }
 
+func TestApplyUnifiedDiffWithOptions(t *testing.T) {
+	src := "Line one\nLine two\nLine three\nLine four\nLine five\nLine six"
+	tests := []struct {
+		name        string
+		src         string
+		diffStr     string
+		opts        PatchOptions
+		expected    string
+		expHunks    []HunkResult
+		expectedErr string
+	}{
+		{
+			name: "exact position",
+			src:  src,
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line three
++Line 3
+ Line four
+`,
+			opts:     PatchOptions{MaxOffset: 10, MaxFuzz: 2},
+			expected: "Line one\nLine two\nLine 3\nLine four\nLine five\nLine six",
+			expHunks: []HunkResult{{Offset: 0, Fuzz: 0}},
+		},
+		{
+			name: "lines inserted before the hunk",
+			src:  "Line zero\nLine zero bis\n" + src,
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line three
++Line 3
+ Line four
+`,
+			opts:     PatchOptions{MaxOffset: 10},
+			expected: "Line zero\nLine zero bis\nLine one\nLine two\nLine 3\nLine four\nLine five\nLine six",
+			expHunks: []HunkResult{{Offset: 2, Fuzz: 0}},
+		},
+		{
+			name: "lines deleted before the hunk",
+			src:  "Line two\nLine three\nLine four\nLine five\nLine six",
+			diffStr: `@@ -4,2 +4,2 @@
+ Line four
+-Line five
++Line 5
+`,
+			opts:     PatchOptions{MaxOffset: 10},
+			expected: "Line two\nLine three\nLine four\nLine 5\nLine six",
+			expHunks: []HunkResult{{Offset: -1, Fuzz: 0}},
+		},
+		{
+			name: "offset over the limit",
+			src:  "Line zero\nLine zero bis\n" + src,
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line three
++Line 3
+ Line four
+`,
+			opts:        PatchOptions{MaxOffset: 1},
+			expectedErr: "hunk 1 does not apply at line 2",
+		},
+		{
+			name: "offset of the previous hunk carried over",
+			src:  "Line zero\n" + src,
+			diffStr: `@@ -1,2 +1,2 @@
+-Line one
++Line 1
+ Line two
+@@ -5,2 +5,2 @@
+ Line five
+-Line six
++Line 6
+`,
+			opts:     PatchOptions{MaxOffset: 1},
+			expected: "Line zero\nLine 1\nLine two\nLine three\nLine four\nLine five\nLine 6",
+			expHunks: []HunkResult{{Offset: 1, Fuzz: 0}, {Offset: 1, Fuzz: 0}},
+		},
+		{
+			name: "context changed",
+			src:  "Line one\nLine 2\nLine three\nLine four\nLine five\nLine six",
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line three
++Line 3
+ Line four
+`,
+			opts:     PatchOptions{MaxFuzz: 1},
+			expected: "Line one\nLine 2\nLine 3\nLine four\nLine five\nLine six",
+			expHunks: []HunkResult{{Offset: 0, Fuzz: 1}},
+		},
+		{
+			name: "context changed without fuzz",
+			src:  "Line one\nLine 2\nLine three\nLine four\nLine five\nLine six",
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line three
++Line 3
+ Line four
+`,
+			opts:        PatchOptions{MaxOffset: 10},
+			expectedErr: "hunk 1 does not apply at line 2",
+		},
+		{
+			name: "context changed and lines inserted before the hunk",
+			src:  "Line zero\nLine one\nLine 2\nLine three\nLine four\nLine five\nLine six",
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line three
++Line 3
+ Line four
+`,
+			opts:     PatchOptions{MaxOffset: 10, MaxFuzz: 2},
+			expected: "Line zero\nLine one\nLine 2\nLine 3\nLine four\nLine five\nLine six",
+			expHunks: []HunkResult{{Offset: 1, Fuzz: 1}},
+		},
+		{
+			name: "deleted line changed",
+			src:  src,
+			diffStr: `@@ -2,3 +2,3 @@
+ Line two
+-Line 3
++Line three
+ Line four
+`,
+			opts:        PatchOptions{MaxOffset: 10, MaxFuzz: 2},
+			expectedErr: "hunk 1 does not apply at line 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, hunks, err := ApplyUnifiedDiffWithOptions(tt.src, tt.diffStr, tt.opts)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+			require.Equal(t, tt.expHunks, hunks)
+		})
+	}
+}
+
 func TestParseUnifiedDiff(t *testing.T) {
 	diffStr := `@@ -1,3 +1,4 @@
 +Line zero
@@ -31,7 +31,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "atomone/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "atomone/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgProposeConstitutionAmendment{}, "atomone/x/gov/v1/MsgProposeAmendment")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeStructuredConstitutionAmendment{}, "atomone/x/gov/v1/MsgProposeArticles")
 	legacy.RegisterAminoMsg(cdc, &MsgProposeLaw{}, "atomone/x/gov/v1/MsgProposeLaw")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGovernor{}, "atomone/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgEditGovernor{}, "atomone/v1/MsgEditGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernor{}, "atomone/v1/MsgDelegateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovernor{}, "atomone/v1/MsgUndelegateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "atomone/v1/MsgCancelProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateFundingStream{}, "atomone/x/gov/v1/MsgCreateFundingStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelFundingStream{}, "atomone/x/gov/v1/MsgCancelFundingStream")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -44,7 +52,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
 		&MsgProposeConstitutionAmendment{},
+		&MsgProposeStructuredConstitutionAmendment{},
 		&MsgProposeLaw{},
+		&MsgCreateGovernor{},
+		&MsgEditGovernor{},
+		&MsgDelegateGovernor{},
+		&MsgUndelegateGovernor{},
+		&MsgCancelProposal{},
+		&MsgCreateFundingStream{},
+		&MsgCancelFundingStream{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
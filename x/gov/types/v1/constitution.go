@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// maxHeadingDepth is the deepest markdown heading level, deeper sections are
+// rendered with this level.
+const maxHeadingDepth = 6
+
+// articleIDRegexp defines the allowed characters of an article id.
+var articleIDRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// ValidateBasic checks the ids of the articles of the structured constitution
+// are valid and unique.
+func (c StructuredConstitution) ValidateBasic() error {
+	seen := make(map[string]bool)
+	var validate func(articles []*ConstitutionArticle) error
+	validate = func(articles []*ConstitutionArticle) error {
+		for _, a := range articles {
+			if err := validateArticleID(a.Id); err != nil {
+				return err
+			}
+			if seen[a.Id] {
+				return types.ErrInvalidConstitutionAmendment.Wrapf("duplicate article id %s", a.Id)
+			}
+			seen[a.Id] = true
+			if err := validate(a.Sections); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return validate(c.Articles)
+}
+
+// Render returns the markdown rendering of the structured constitution. Each
+// article is rendered as a heading, whose level is its depth in the tree,
+// followed by its text.
+func (c StructuredConstitution) Render() string {
+	var blocks []string
+	var render func(articles []*ConstitutionArticle, depth int)
+	render = func(articles []*ConstitutionArticle, depth int) {
+		for _, a := range articles {
+			if a.Title != "" {
+				blocks = append(blocks, strings.Repeat("#", min(depth, maxHeadingDepth))+" "+a.Title)
+			}
+			if a.Text != "" {
+				blocks = append(blocks, strings.TrimSpace(a.Text))
+			}
+			render(a.Sections, depth+1)
+		}
+	}
+	render(c.Articles, 1)
+	if len(blocks) == 0 {
+		return ""
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+// FindArticle returns the article, or section, with the given id.
+func (c StructuredConstitution) FindArticle(id string) (*ConstitutionArticle, bool) {
+	root := ConstitutionArticle{Sections: c.Articles}
+	parent, i := root.locate(id)
+	if parent == nil {
+		return nil, false
+	}
+	return parent.Sections[i], true
+}
+
+// ApplyOperations applies the operations in order to a copy of the structured
+// constitution and returns the result. c is left untouched.
+func (c StructuredConstitution) ApplyOperations(operations []*ArticleOperation) (StructuredConstitution, error) {
+	root := &ConstitutionArticle{Sections: cloneArticles(c.Articles)}
+	for i, op := range operations {
+		if err := root.applyOperation(*op); err != nil {
+			return StructuredConstitution{}, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+	amended := StructuredConstitution{Articles: root.Sections}
+	if err := amended.ValidateBasic(); err != nil {
+		return StructuredConstitution{}, err
+	}
+	return amended, nil
+}
+
+// ValidateBasic performs basic validation of an article operation.
+func (op ArticleOperation) ValidateBasic() error {
+	switch op.Type {
+	case ArticleOperationReplace:
+		if err := validateArticleID(op.ArticleId); err != nil {
+			return err
+		}
+		if op.Article == nil {
+			return types.ErrInvalidConstitutionAmendment.Wrap("replace operation must have an article")
+		}
+		if op.Article.Id != "" && op.Article.Id != op.ArticleId {
+			return types.ErrInvalidConstitutionAmendment.Wrapf("replace operation cannot change the id of article %s", op.ArticleId)
+		}
+		if len(op.Article.Sections) > 0 {
+			return types.ErrInvalidConstitutionAmendment.Wrap("replace operation cannot have sections")
+		}
+	case ArticleOperationInsert:
+		if op.Article == nil {
+			return types.ErrInvalidConstitutionAmendment.Wrap("insert operation must have an article")
+		}
+		return StructuredConstitution{Articles: []*ConstitutionArticle{op.Article}}.ValidateBasic()
+	case ArticleOperationDelete:
+		return validateArticleID(op.ArticleId)
+	default:
+		return types.ErrInvalidConstitutionAmendment.Wrapf("invalid operation type %s", op.Type)
+	}
+	return nil
+}
+
+// applyOperation applies op to the sections of the article a.
+func (a *ConstitutionArticle) applyOperation(op ArticleOperation) error {
+	switch op.Type {
+	case ArticleOperationReplace:
+		parent, i := a.locate(op.ArticleId)
+		if parent == nil {
+			return types.ErrUnknownConstitutionArticle.Wrap(op.ArticleId)
+		}
+		parent.Sections[i].Title = op.Article.Title
+		parent.Sections[i].Text = op.Article.Text
+
+	case ArticleOperationInsert:
+		if p, _ := a.locate(op.Article.Id); p != nil {
+			return types.ErrInvalidConstitutionAmendment.Wrapf("article %s already exists", op.Article.Id)
+		}
+		parent := a
+		if op.ParentId != "" {
+			p, i := a.locate(op.ParentId)
+			if p == nil {
+				return types.ErrUnknownConstitutionArticle.Wrap(op.ParentId)
+			}
+			parent = p.Sections[i]
+		}
+		pos := 0
+		if op.AfterId != "" {
+			pos = -1
+			for i, s := range parent.Sections {
+				if s.Id == op.AfterId {
+					pos = i + 1
+					break
+				}
+			}
+			if pos < 0 {
+				return types.ErrUnknownConstitutionArticle.Wrapf("%s in article %s", op.AfterId, op.ParentId)
+			}
+		}
+		inserted := cloneArticles([]*ConstitutionArticle{op.Article})
+		parent.Sections = append(parent.Sections[:pos], append(inserted, parent.Sections[pos:]...)...)
+
+	case ArticleOperationDelete:
+		parent, i := a.locate(op.ArticleId)
+		if parent == nil {
+			return types.ErrUnknownConstitutionArticle.Wrap(op.ArticleId)
+		}
+		parent.Sections = append(parent.Sections[:i], parent.Sections[i+1:]...)
+
+	default:
+		return types.ErrInvalidConstitutionAmendment.Wrapf("invalid operation type %s", op.Type)
+	}
+	return nil
+}
+
+// locate returns the article, among a and its descendants, holding the section
+// with the given id, and the index of the section. It returns nil if there is
+// no such section.
+func (a *ConstitutionArticle) locate(id string) (*ConstitutionArticle, int) {
+	for i, s := range a.Sections {
+		if s.Id == id {
+			return a, i
+		}
+		if parent, j := s.locate(id); parent != nil {
+			return parent, j
+		}
+	}
+	return nil, 0
+}
+
+// cloneArticles returns a deep copy of articles.
+func cloneArticles(articles []*ConstitutionArticle) []*ConstitutionArticle {
+	if articles == nil {
+		return nil
+	}
+	clone := make([]*ConstitutionArticle, len(articles))
+	for i, a := range articles {
+		clone[i] = &ConstitutionArticle{
+			Id:       a.Id,
+			Title:    a.Title,
+			Text:     a.Text,
+			Sections: cloneArticles(a.Sections),
+		}
+	}
+	return clone
+}
+
+// validateArticleID checks an article id is not empty and only contains
+// letters, digits, '.', '_' and '-'.
+func validateArticleID(id string) error {
+	if !articleIDRegexp.MatchString(id) {
+		return types.ErrInvalidConstitutionAmendment.Wrapf("invalid article id %q", id)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func testStructuredConstitution() v1.StructuredConstitution {
+	return v1.StructuredConstitution{
+		Articles: []*v1.ConstitutionArticle{
+			{
+				Id:    "preamble",
+				Title: "Preamble",
+				Text:  "We the people",
+			},
+			{
+				Id:    "art-1",
+				Title: "Article 1",
+				Text:  "Governance",
+				Sections: []*v1.ConstitutionArticle{
+					{Id: "art-1.1", Title: "Section 1", Text: "Proposals"},
+					{Id: "art-1.2", Text: "Votes"},
+				},
+			},
+		},
+	}
+}
+
+func TestStructuredConstitutionRender(t *testing.T) {
+	require.Equal(t, "", v1.StructuredConstitution{}.Render())
+	require.Equal(t, `# Preamble
+
+We the people
+
+# Article 1
+
+Governance
+
+## Section 1
+
+Proposals
+
+Votes
+`, testStructuredConstitution().Render())
+
+	// headings are capped at level 6
+	article := &v1.ConstitutionArticle{Title: "T"}
+	for i := 1; i < 7; i++ {
+		article = &v1.ConstitutionArticle{Title: "T", Sections: []*v1.ConstitutionArticle{article}}
+	}
+	require.Equal(t, "# T\n\n## T\n\n### T\n\n#### T\n\n##### T\n\n###### T\n\n###### T\n",
+		v1.StructuredConstitution{Articles: []*v1.ConstitutionArticle{article}}.Render())
+}
+
+func TestStructuredConstitutionValidateBasic(t *testing.T) {
+	require.NoError(t, testStructuredConstitution().ValidateBasic())
+
+	duplicate := testStructuredConstitution()
+	duplicate.Articles[1].Sections[1].Id = "preamble"
+	require.Error(t, duplicate.ValidateBasic())
+
+	empty := testStructuredConstitution()
+	empty.Articles[0].Id = ""
+	require.Error(t, empty.ValidateBasic())
+}
+
+func TestStructuredConstitutionApplyOperations(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations []*v1.ArticleOperation
+		expErr     bool
+		expIDs     []string
+	}{
+		{
+			name: "insert first",
+			operations: []*v1.ArticleOperation{{
+				Type:    v1.ArticleOperationInsert,
+				Article: &v1.ConstitutionArticle{Id: "title"},
+			}},
+			expIDs: []string{"title", "preamble", "art-1", "art-1.1", "art-1.2"},
+		},
+		{
+			name: "insert section after",
+			operations: []*v1.ArticleOperation{{
+				Type:     v1.ArticleOperationInsert,
+				ParentId: "art-1",
+				AfterId:  "art-1.1",
+				Article:  &v1.ConstitutionArticle{Id: "art-1.1a"},
+			}},
+			expIDs: []string{"preamble", "art-1", "art-1.1", "art-1.1a", "art-1.2"},
+		},
+		{
+			name: "insert existing id",
+			operations: []*v1.ArticleOperation{{
+				Type:    v1.ArticleOperationInsert,
+				Article: &v1.ConstitutionArticle{Id: "art-1.2"},
+			}},
+			expErr: true,
+		},
+		{
+			name: "insert with existing section id",
+			operations: []*v1.ArticleOperation{{
+				Type: v1.ArticleOperationInsert,
+				Article: &v1.ConstitutionArticle{
+					Id:       "art-2",
+					Sections: []*v1.ConstitutionArticle{{Id: "art-1.1"}},
+				},
+			}},
+			expErr: true,
+		},
+		{
+			name: "insert after unknown sibling",
+			operations: []*v1.ArticleOperation{{
+				Type:    v1.ArticleOperationInsert,
+				AfterId: "art-1.1",
+				Article: &v1.ConstitutionArticle{Id: "art-2"},
+			}},
+			expErr: true,
+		},
+		{
+			name: "delete article with its sections",
+			operations: []*v1.ArticleOperation{{
+				Type:      v1.ArticleOperationDelete,
+				ArticleId: "art-1",
+			}},
+			expIDs: []string{"preamble"},
+		},
+		{
+			name: "delete then replace",
+			operations: []*v1.ArticleOperation{
+				{Type: v1.ArticleOperationDelete, ArticleId: "art-1.1"},
+				{Type: v1.ArticleOperationReplace, ArticleId: "art-1.1", Article: &v1.ConstitutionArticle{}},
+			},
+			expErr: true,
+		},
+		{
+			name: "replace keeps sections",
+			operations: []*v1.ArticleOperation{{
+				Type:      v1.ArticleOperationReplace,
+				ArticleId: "art-1",
+				Article:   &v1.ConstitutionArticle{Title: "Article One"},
+			}},
+			expIDs: []string{"preamble", "art-1", "art-1.1", "art-1.2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			structured := testStructuredConstitution()
+			amended, err := structured.ApplyOperations(tc.operations)
+			// the operations are applied to a copy
+			require.Equal(t, testStructuredConstitution(), structured)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var ids []string
+			var collect func(articles []*v1.ConstitutionArticle)
+			collect = func(articles []*v1.ConstitutionArticle) {
+				for _, a := range articles {
+					ids = append(ids, a.Id)
+					collect(a.Sections)
+				}
+			}
+			collect(amended.Articles)
+			require.Equal(t, tc.expIDs, ids)
+		})
+	}
+
+	amended, err := testStructuredConstitution().ApplyOperations([]*v1.ArticleOperation{{
+		Type:      v1.ArticleOperationReplace,
+		ArticleId: "art-1.1",
+		Article:   &v1.ConstitutionArticle{Title: "Section One", Text: "Proposals and deposits"},
+	}})
+	require.NoError(t, err)
+	section, found := amended.FindArticle("art-1.1")
+	require.True(t, found)
+	require.Equal(t, &v1.ConstitutionArticle{Id: "art-1.1", Title: "Section One", Text: "Proposals and deposits"}, section)
+	_, found = amended.FindArticle("art-2")
+	require.False(t, found)
+}
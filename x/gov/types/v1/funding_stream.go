@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// FundingStreams is an array of funding streams
+type FundingStreams []*FundingStream
+
+// ValidateBasic performs basic validation of a funding stream.
+func (s FundingStream) ValidateBasic() error {
+	if s.Id == 0 {
+		return types.ErrInvalidFundingStream.Wrap("funding stream id cannot be 0")
+	}
+	if s.ProposalId == 0 {
+		return types.ErrInvalidFundingStream.Wrapf("proposal id of funding stream %d cannot be 0", s.Id)
+	}
+	if s.NextPayoutTime == nil {
+		return types.ErrInvalidFundingStream.Wrapf("next payout time of funding stream %d cannot be nil", s.Id)
+	}
+	if !sdk.Coins(s.TotalPaid).IsValid() {
+		return types.ErrInvalidFundingStream.Wrapf("invalid total paid of funding stream %d: %s", s.Id, sdk.Coins(s.TotalPaid))
+	}
+	return validateFundingStream(s.Recipient, s.Amount, s.Interval, s.EndTime)
+}
+
+// validateFundingStream checks the recipient, the payout amount, the interval
+// and the end time of a funding stream.
+func validateFundingStream(recipient string, amount sdk.Coins, interval *time.Duration, endTime *time.Time) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return types.ErrInvalidFundingStream.Wrapf("invalid recipient address: %s", err)
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return types.ErrInvalidFundingStream.Wrapf("invalid payout amount: %s", amount)
+	}
+	if interval == nil || *interval <= 0 {
+		return types.ErrInvalidFundingStream.Wrap("payout interval must be positive")
+	}
+	if endTime == nil {
+		return types.ErrInvalidFundingStream.Wrap("end time cannot be nil")
+	}
+	return nil
+}
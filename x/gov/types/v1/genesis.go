@@ -7,6 +7,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -19,10 +20,14 @@ func NewGenesisState(startingProposalID uint64, params Params) *GenesisState {
 
 // DefaultGenesisState defines the default governance genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genState := NewGenesisState(
 		DefaultStartingProposalID,
 		DefaultParams(),
 	)
+	genState.ParticipationEma = DefaultParticipationEMA.String()
+	genState.ConstitutionAmendmentParticipationEma = DefaultConstitutionAmendmentParticipationEMA.String()
+	genState.LawParticipationEma = DefaultLawParticipationEMA.String()
+	return genState
 }
 
 // Empty returns true if a GenesisState is empty
@@ -33,7 +38,7 @@ func (data GenesisState) Empty() bool {
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
 // It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// that there are no duplicate deposit, vote, final vote or vote history records and no vote or deposits for non-existent proposals
 func ValidateGenesis(data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -97,11 +102,226 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate final votes
+	errGroup.Go(func() error {
+		type voteKey struct {
+			ProposalId uint64
+			Voter      string
+		}
+		voteIds := make(map[voteKey]struct{})
+		for _, v := range data.FinalVotes {
+			if _, ok := proposalIds[v.ProposalId]; !ok {
+				return fmt.Errorf("final vote %v has non-existent proposal id: %d", v, v.ProposalId)
+			}
+
+			vk := voteKey{v.ProposalId, v.Voter}
+			if _, ok := voteIds[vk]; ok {
+				return fmt.Errorf("duplicate final vote: %v", v)
+			}
+
+			voteIds[vk] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate vote history entries
+	errGroup.Go(func() error {
+		type entryKey struct {
+			ProposalId uint64
+			Sequence   uint64
+		}
+		entryIds := make(map[entryKey]struct{})
+		for _, e := range data.VoteHistory {
+			if _, ok := proposalIds[e.ProposalId]; !ok {
+				return fmt.Errorf("vote history entry %v has non-existent proposal id: %d", e, e.ProposalId)
+			}
+			if e.Sequence == 0 {
+				return fmt.Errorf("vote history entry %v has a zero sequence", e)
+			}
+			if _, err := sdk.AccAddressFromBech32(e.Voter); err != nil {
+				return fmt.Errorf("invalid voter address %s in vote history: %w", e.Voter, err)
+			}
+
+			ek := entryKey{e.ProposalId, e.Sequence}
+			if _, ok := entryIds[ek]; ok {
+				return fmt.Errorf("duplicate vote history entry: %v", e)
+			}
+
+			entryIds[ek] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate laws and laws superseding unknown laws
+	errGroup.Go(func() error {
+		lawIds := make(map[uint64]struct{})
+		for _, l := range data.Laws {
+			if err := l.ValidateBasic(); err != nil {
+				return err
+			}
+			if _, ok := lawIds[l.Id]; ok {
+				return fmt.Errorf("duplicate law id: %d", l.Id)
+			}
+
+			lawIds[l.Id] = struct{}{}
+		}
+		for _, l := range data.Laws {
+			for _, lawID := range l.Supersedes {
+				if _, ok := lawIds[lawID]; !ok {
+					return fmt.Errorf("law %d supersedes non-existent law id: %d", l.Id, lawID)
+				}
+			}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate funding streams
+	errGroup.Go(func() error {
+		streamIds := make(map[uint64]struct{})
+		for _, s := range data.FundingStreams {
+			if err := s.ValidateBasic(); err != nil {
+				return err
+			}
+			if _, ok := streamIds[s.Id]; ok {
+				return fmt.Errorf("duplicate funding stream id: %d", s.Id)
+			}
+
+			streamIds[s.Id] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// verify constitution history, versions must be sequential from 0 and the
+	// last version must be the current constitution
+	errGroup.Go(func() error {
+		for i, v := range data.ConstitutionHistory {
+			if v.Version != uint64(i) {
+				return fmt.Errorf("invalid constitution version %d, expected %d", v.Version, i)
+			}
+		}
+		if n := len(data.ConstitutionHistory); n > 0 && data.ConstitutionHistory[n-1].Constitution != data.Constitution {
+			return errors.New("last constitution version does not match the constitution")
+		}
+
+		return nil
+	})
+
+	// verify structured constitution, the constitution must be empty or its
+	// markdown rendering
+	errGroup.Go(func() error {
+		if data.StructuredConstitution == nil {
+			return nil
+		}
+		if err := data.StructuredConstitution.ValidateBasic(); err != nil {
+			return err
+		}
+		if data.Constitution != "" && data.Constitution != data.StructuredConstitution.Render() {
+			return errors.New("constitution does not match the rendering of the structured constitution")
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
 	})
 
+	// verify last min deposit
+	errGroup.Go(func() error {
+		if data.LastMinDeposit == nil {
+			return nil
+		}
+		if data.LastMinDeposit.Time == nil {
+			return errors.New("last min deposit time must not be nil")
+		}
+		if minDeposit := sdk.Coins(data.LastMinDeposit.Value); !minDeposit.IsValid() {
+			return fmt.Errorf("invalid last min deposit: %s", minDeposit)
+		}
+		return nil
+	})
+
+	// verify last min initial deposit
+	errGroup.Go(func() error {
+		if data.LastMinInitialDeposit == nil {
+			return nil
+		}
+		if data.LastMinInitialDeposit.Time == nil {
+			return errors.New("last min initial deposit time must not be nil")
+		}
+		if minInitialDeposit := sdk.Coins(data.LastMinInitialDeposit.Value); !minInitialDeposit.IsValid() {
+			return fmt.Errorf("invalid last min initial deposit: %s", minInitialDeposit)
+		}
+		return nil
+	})
+
+	// verify participation EMAs, empty values are replaced by the defaults
+	errGroup.Go(func() error {
+		for name, ema := range map[string]string{
+			"participation EMA":                        data.ParticipationEma,
+			"constitution amendment participation EMA": data.ConstitutionAmendmentParticipationEma,
+			"law participation EMA":                    data.LawParticipationEma,
+		} {
+			if ema == "" {
+				continue
+			}
+			dec, err := sdk.NewDecFromStr(ema)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %w", name, err)
+			}
+			if dec.IsNegative() || dec.GT(sdk.OneDec()) {
+				return fmt.Errorf("%s must be between 0 and 1: %s", name, dec)
+			}
+		}
+		return nil
+	})
+
+	// weed out duplicate governors and governance delegations to unknown
+	// governors
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, err := sdk.AccAddressFromBech32(g.GovernorAddress); err != nil {
+				return fmt.Errorf("invalid governor address %s: %w", g.GovernorAddress, err)
+			}
+			if _, ok := governors[g.GovernorAddress]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.GovernorAddress)
+			}
+			if !ValidGovernorStatus(g.Status) {
+				return fmt.Errorf("invalid status for governor %s: %s", g.GovernorAddress, g.Status)
+			}
+			if err := g.Description.EnsureLength(); err != nil {
+				return fmt.Errorf("invalid description for governor %s: %w", g.GovernorAddress, err)
+			}
+
+			governors[g.GovernorAddress] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid delegator address %s: %w", d.DelegatorAddress, err)
+			}
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation for delegator: %s", d.DelegatorAddress)
+			}
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governance delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+			if _, ok := governors[d.DelegatorAddress]; ok && d.DelegatorAddress != d.GovernorAddress {
+				return fmt.Errorf("governor %s cannot delegate to another governor", d.DelegatorAddress)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	return errGroup.Wait()
 }
 
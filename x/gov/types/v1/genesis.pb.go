@@ -5,6 +5,7 @@ package v1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -49,6 +50,38 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// last updated value for the dynamic min deposit
+	LastMinDeposit *LastMinDeposit `protobuf:"bytes,10,opt,name=last_min_deposit,json=lastMinDeposit,proto3" json:"last_min_deposit,omitempty"`
+	// last updated value for the dynamic min initial deposit
+	LastMinInitialDeposit *LastMinDeposit `protobuf:"bytes,11,opt,name=last_min_initial_deposit,json=lastMinInitialDeposit,proto3" json:"last_min_initial_deposit,omitempty"`
+	// governors defines all the governors present at genesis.
+	Governors []*Governor `protobuf:"bytes,12,rep,name=governors,proto3" json:"governors,omitempty"`
+	// governance_delegations defines all the governance delegations present at
+	// genesis.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,13,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
+	// participation_ema is the exponential moving average of the participation
+	// in regular proposals.
+	ParticipationEma string `protobuf:"bytes,14,opt,name=participation_ema,json=participationEma,proto3" json:"participation_ema,omitempty"`
+	// constitution_amendment_participation_ema is the exponential moving
+	// average of the participation in constitution amendment proposals.
+	ConstitutionAmendmentParticipationEma string `protobuf:"bytes,15,opt,name=constitution_amendment_participation_ema,json=constitutionAmendmentParticipationEma,proto3" json:"constitution_amendment_participation_ema,omitempty"`
+	// law_participation_ema is the exponential moving average of the
+	// participation in law proposals.
+	LawParticipationEma string `protobuf:"bytes,16,opt,name=law_participation_ema,json=lawParticipationEma,proto3" json:"law_participation_ema,omitempty"`
+	// final_votes defines all the final votes present at genesis.
+	FinalVotes []*FinalVote `protobuf:"bytes,17,rep,name=final_votes,json=finalVotes,proto3" json:"final_votes,omitempty"`
+	// laws defines all the laws present at genesis.
+	Laws []*Law `protobuf:"bytes,18,rep,name=laws,proto3" json:"laws,omitempty"`
+	// vote_history defines the vote history entries present at genesis.
+	VoteHistory []*VoteHistoryEntry `protobuf:"bytes,19,rep,name=vote_history,json=voteHistory,proto3" json:"vote_history,omitempty"`
+	// funding_streams defines the active funding streams present at genesis.
+	FundingStreams []*FundingStream `protobuf:"bytes,20,rep,name=funding_streams,json=fundingStreams,proto3" json:"funding_streams,omitempty"`
+	// constitution_history defines all the versions of the constitution, in
+	// version order. The last version must match the constitution.
+	ConstitutionHistory []*ConstitutionVersion `protobuf:"bytes,21,rep,name=constitution_history,json=constitutionHistory,proto3" json:"constitution_history,omitempty"`
+	// structured_constitution defines the optional structured constitution. When
+	// set, the constitution must be empty or match its markdown rendering.
+	StructuredConstitution *StructuredConstitution `protobuf:"bytes,22,opt,name=structured_constitution,json=structuredConstitution,proto3" json:"structured_constitution,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +183,97 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetLastMinDeposit() *LastMinDeposit {
+	if m != nil {
+		return m.LastMinDeposit
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastMinInitialDeposit() *LastMinDeposit {
+	if m != nil {
+		return m.LastMinInitialDeposit
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernors() []*Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipationEma() string {
+	if m != nil {
+		return m.ParticipationEma
+	}
+	return ""
+}
+
+func (m *GenesisState) GetConstitutionAmendmentParticipationEma() string {
+	if m != nil {
+		return m.ConstitutionAmendmentParticipationEma
+	}
+	return ""
+}
+
+func (m *GenesisState) GetLawParticipationEma() string {
+	if m != nil {
+		return m.LawParticipationEma
+	}
+	return ""
+}
+
+func (m *GenesisState) GetFinalVotes() []*FinalVote {
+	if m != nil {
+		return m.FinalVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLaws() []*Law {
+	if m != nil {
+		return m.Laws
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteHistory() []*VoteHistoryEntry {
+	if m != nil {
+		return m.VoteHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingStreams() []*FundingStream {
+	if m != nil {
+		return m.FundingStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConstitutionHistory() []*ConstitutionVersion {
+	if m != nil {
+		return m.ConstitutionHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStructuredConstitution() *StructuredConstitution {
+	if m != nil {
+		return m.StructuredConstitution
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "atomone.gov.v1.GenesisState")
 }
@@ -157,31 +281,53 @@ func init() {
 func init() { proto.RegisterFile("atomone/gov/v1/genesis.proto", fileDescriptor_7737a96fb154b10d) }
 
 var fileDescriptor_7737a96fb154b10d = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0xaa, 0xda, 0x40,
-	0x14, 0xc6, 0x1d, 0xff, 0x55, 0xc7, 0xe8, 0x62, 0x90, 0x76, 0x68, 0x6d, 0x08, 0xae, 0x42, 0xc1,
-	0xa4, 0x2a, 0xf4, 0x01, 0xa4, 0xc5, 0x76, 0x27, 0x69, 0xe9, 0xa2, 0x1b, 0x89, 0x66, 0x88, 0x01,
-	0xcd, 0x09, 0x99, 0xe3, 0x50, 0xb7, 0x7d, 0x82, 0x3e, 0x56, 0x97, 0x2e, 0xbb, 0x2c, 0xfa, 0x22,
-	0xc5, 0x49, 0x72, 0xd5, 0xdc, 0x7b, 0x77, 0xc3, 0xf9, 0x7e, 0xdf, 0x77, 0x3e, 0x0e, 0x43, 0x07,
-	0x3e, 0xc2, 0x0e, 0x62, 0xe1, 0x86, 0xa0, 0x5c, 0x35, 0x76, 0x43, 0x11, 0x0b, 0x19, 0x49, 0x27,
-	0x49, 0x01, 0x81, 0xf5, 0x72, 0xd5, 0x09, 0x41, 0x39, 0x6a, 0xfc, 0x9a, 0x97, 0x69, 0x50, 0x19,
-	0x39, 0xfc, 0x55, 0xa7, 0xc6, 0x3c, 0xf3, 0x7e, 0x45, 0x1f, 0x05, 0x7b, 0x4f, 0xfb, 0x12, 0xfd,
-	0x14, 0xa3, 0x38, 0x5c, 0x26, 0x29, 0x24, 0x20, 0xfd, 0xed, 0x32, 0x0a, 0x38, 0xb1, 0x88, 0x5d,
-	0xf7, 0x58, 0xa1, 0x2d, 0x72, 0xe9, 0x4b, 0xc0, 0xa6, 0xb4, 0x15, 0x88, 0x04, 0x64, 0x84, 0x92,
-	0x57, 0xad, 0x9a, 0xdd, 0x99, 0xbc, 0x72, 0xee, 0xf7, 0x3b, 0x1f, 0x33, 0xdd, 0x7b, 0x00, 0xd9,
-	0x3b, 0xda, 0x50, 0x80, 0x42, 0xf2, 0x9a, 0x76, 0xf4, 0xcb, 0x8e, 0xef, 0x80, 0xc2, 0xcb, 0x10,
-	0xf6, 0x81, 0xb6, 0x8b, 0x26, 0x92, 0xd7, 0x35, 0xcf, 0xcb, 0x7c, 0xd1, 0xc7, 0xbb, 0xa2, 0xec,
-	0x33, 0xed, 0xe5, 0xfb, 0x96, 0x89, 0x9f, 0xfa, 0x3b, 0xc9, 0x1b, 0x16, 0xb1, 0x3b, 0x93, 0xb7,
-	0xcf, 0xd4, 0x5b, 0x68, 0x68, 0x56, 0xe5, 0xc4, 0xeb, 0x06, 0xb7, 0x23, 0xf6, 0x89, 0x76, 0x15,
-	0x64, 0x27, 0xc9, 0x82, 0x9a, 0x3a, 0x68, 0xf0, 0x44, 0xeb, 0xcb, 0x6d, 0xae, 0x39, 0x86, 0xba,
-	0x99, 0xb0, 0x19, 0x35, 0xd0, 0xdf, 0x6e, 0x0f, 0x45, 0xca, 0x0b, 0x9d, 0xf2, 0xa6, 0x9c, 0xf2,
-	0xed, 0xc2, 0xdc, 0x84, 0x74, 0xf0, 0x3a, 0x60, 0x0e, 0x6d, 0xe6, 0xee, 0x96, 0x76, 0xbf, 0x7c,
-	0x74, 0x09, 0xad, 0x7a, 0x39, 0xc5, 0x86, 0xd4, 0x58, 0x43, 0x2c, 0x31, 0xc2, 0x3d, 0x46, 0x10,
-	0xf3, 0xb6, 0x45, 0xec, 0xb6, 0x77, 0x37, 0x9b, 0xcd, 0xff, 0x9c, 0x4c, 0x72, 0x3c, 0x99, 0xe4,
-	0xdf, 0xc9, 0x24, 0xbf, 0xcf, 0x66, 0xe5, 0x78, 0x36, 0x2b, 0x7f, 0xcf, 0x66, 0xe5, 0xc7, 0x28,
-	0x8c, 0x70, 0xb3, 0x5f, 0x39, 0x6b, 0xd8, 0xb9, 0xf9, 0x9e, 0xd1, 0x66, 0xbf, 0x2a, 0xde, 0xee,
-	0x4f, 0xfd, 0xa3, 0xf0, 0x90, 0x08, 0xe9, 0xaa, 0xf1, 0xaa, 0xa9, 0x3f, 0xd5, 0xf4, 0x7f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x19, 0xfd, 0x88, 0xa5, 0x9e, 0x02, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0x80, 0x09, 0x7f, 0xa3, 0x6e, 0x29, 0xe0, 0xfe, 0x60, 0x18, 0xab, 0x2a, 0xf6, 0x57, 0x4d,
+	0xa2, 0x1d, 0x20, 0x71, 0xb1, 0x5d, 0xad, 0xfc, 0x4b, 0x9b, 0x84, 0xcc, 0xc4, 0xa4, 0xed, 0x22,
+	0x32, 0x8d, 0x1b, 0x2c, 0x25, 0x76, 0x15, 0xbb, 0xe9, 0xfa, 0x16, 0x7b, 0x98, 0x3d, 0xc4, 0xee,
+	0x86, 0x76, 0xb5, 0xcb, 0x09, 0x5e, 0x64, 0x8a, 0x93, 0xb4, 0x49, 0xc8, 0xa4, 0xdd, 0xd5, 0xc7,
+	0xdf, 0xf9, 0x7c, 0x72, 0x9c, 0xd3, 0x80, 0x2d, 0xa2, 0x84, 0x2b, 0x38, 0xed, 0xd8, 0xc2, 0xef,
+	0xf8, 0xbb, 0x1d, 0x9b, 0x72, 0x2a, 0x99, 0x6c, 0x0f, 0x3c, 0xa1, 0x04, 0x2c, 0x47, 0xbb, 0x6d,
+	0x5b, 0xf8, 0x6d, 0x7f, 0x77, 0x13, 0x65, 0x69, 0xe1, 0x87, 0xe4, 0xe6, 0x46, 0x4f, 0x48, 0x57,
+	0x48, 0x53, 0xaf, 0x3a, 0xe1, 0x22, 0xdc, 0xda, 0xfe, 0x59, 0x04, 0xa5, 0xd3, 0x50, 0x7b, 0xa9,
+	0x88, 0xa2, 0xf0, 0x35, 0xa8, 0x4a, 0x45, 0x3c, 0xc5, 0xb8, 0x1d, 0xf0, 0x03, 0x21, 0x89, 0x63,
+	0x32, 0x0b, 0x19, 0x4d, 0xa3, 0x35, 0x8f, 0x61, 0xbc, 0x77, 0x11, 0x6d, 0x9d, 0x5b, 0x70, 0x1f,
+	0x2c, 0x59, 0x74, 0x20, 0x24, 0x53, 0x12, 0xcd, 0x36, 0xe7, 0x5a, 0xc5, 0xbd, 0xf5, 0x76, 0xba,
+	0xb4, 0xf6, 0x51, 0xb8, 0x8f, 0x27, 0x20, 0x7c, 0x05, 0x16, 0x7c, 0xa1, 0xa8, 0x44, 0x73, 0x3a,
+	0xa3, 0x9a, 0xcd, 0xb8, 0x12, 0x8a, 0xe2, 0x10, 0x81, 0x07, 0xa0, 0x10, 0x57, 0x22, 0xd1, 0xbc,
+	0xe6, 0x51, 0x96, 0x8f, 0xeb, 0xc1, 0x53, 0x14, 0x9e, 0x81, 0x72, 0x74, 0x9e, 0x39, 0x20, 0x1e,
+	0x71, 0x25, 0x5a, 0x68, 0x1a, 0xad, 0xe2, 0xde, 0x93, 0x7f, 0x94, 0x77, 0xa1, 0xa1, 0xee, 0x2c,
+	0x32, 0xf0, 0xb2, 0x95, 0x0c, 0xc1, 0x63, 0xb0, 0xec, 0x8b, 0xb0, 0x25, 0xa1, 0x68, 0x51, 0x8b,
+	0xb6, 0x72, 0xaa, 0x0e, 0x7a, 0x33, 0xf5, 0x94, 0xfc, 0x44, 0x04, 0x76, 0x41, 0x49, 0x11, 0xc7,
+	0x19, 0xc7, 0x96, 0x47, 0xda, 0xf2, 0x38, 0x6b, 0xf9, 0x18, 0x30, 0x09, 0x49, 0x51, 0x4d, 0x03,
+	0xb0, 0x0d, 0x16, 0xa3, 0xec, 0x25, 0x9d, 0x5d, 0x7f, 0xd0, 0x09, 0xbd, 0x8b, 0x23, 0x0a, 0x6e,
+	0x83, 0x52, 0x4f, 0x70, 0xa9, 0x98, 0x1a, 0x2a, 0x26, 0x38, 0x2a, 0x34, 0x8d, 0x56, 0x01, 0xa7,
+	0x62, 0xf0, 0x0c, 0xac, 0x3a, 0x44, 0x2a, 0xd3, 0x65, 0xdc, 0x8c, 0x1e, 0x1c, 0x01, 0x6d, 0x6f,
+	0x64, 0xed, 0xef, 0x89, 0x54, 0x1f, 0x18, 0x8f, 0x2f, 0xb4, 0xec, 0xa4, 0xd6, 0xf0, 0x13, 0x40,
+	0x13, 0x13, 0xe3, 0x4c, 0x31, 0xe2, 0x4c, 0x8c, 0xc5, 0xff, 0x32, 0xd6, 0x22, 0xe3, 0x79, 0x98,
+	0x1d, 0x8b, 0x0f, 0x40, 0xc1, 0x16, 0x3e, 0xf5, 0xb8, 0xf0, 0x24, 0x2a, 0xe5, 0xbf, 0x03, 0xa7,
+	0x11, 0x80, 0xa7, 0x28, 0xfc, 0x02, 0xea, 0xe1, 0x82, 0xf0, 0x1e, 0x35, 0x2d, 0xea, 0x50, 0x9b,
+	0x04, 0xcf, 0x2c, 0xd1, 0xb2, 0x96, 0x3c, 0xcb, 0x97, 0x04, 0xf4, 0xd1, 0x04, 0xc6, 0x35, 0x3b,
+	0x27, 0x2a, 0xe1, 0x5b, 0xb0, 0x36, 0x08, 0xc6, 0xa1, 0xc7, 0x06, 0x3a, 0x62, 0x52, 0x97, 0xa0,
+	0x72, 0xd0, 0xe0, 0x6e, 0xf9, 0xd7, 0xf7, 0x1d, 0x10, 0x4d, 0xda, 0x11, 0xed, 0xe1, 0xd5, 0x14,
+	0x78, 0xec, 0x12, 0x68, 0x83, 0x56, 0xf2, 0x12, 0x4c, 0xe2, 0x52, 0x6e, 0xb9, 0x94, 0x2b, 0x33,
+	0x85, 0x6a, 0xe7, 0x4a, 0xae, 0xf3, 0x79, 0x32, 0xff, 0x5d, 0x9c, 0x7e, 0x91, 0x3d, 0xa8, 0x0b,
+	0x6a, 0x0e, 0x19, 0xe5, 0x58, 0x57, 0x73, 0xad, 0x15, 0x87, 0x8c, 0x1e, 0x38, 0xde, 0x80, 0x62,
+	0x9f, 0x71, 0xe2, 0x98, 0xe1, 0xd0, 0xae, 0xe9, 0xde, 0x6d, 0x64, 0x7b, 0x77, 0x12, 0x20, 0x7a,
+	0x72, 0x41, 0x3f, 0xfe, 0x29, 0xe1, 0x4b, 0x30, 0xef, 0x90, 0x91, 0x44, 0x50, 0x27, 0x55, 0x1e,
+	0xde, 0xff, 0x08, 0x6b, 0x00, 0x1e, 0x82, 0x60, 0x5c, 0xa8, 0x79, 0xc3, 0xa4, 0x12, 0xde, 0x18,
+	0x55, 0x74, 0x42, 0x33, 0xef, 0xaf, 0xe1, 0x2c, 0x44, 0x8e, 0xb9, 0xf2, 0xc6, 0xb8, 0xe8, 0x4f,
+	0x23, 0xf0, 0x04, 0xac, 0xf4, 0x87, 0xdc, 0x0a, 0x66, 0x55, 0x2a, 0x8f, 0x06, 0x83, 0x52, 0x6d,
+	0xce, 0xe5, 0x4d, 0xfd, 0x49, 0x88, 0x5d, 0x6a, 0x0a, 0x97, 0xfb, 0xc9, 0xa5, 0x84, 0x57, 0xa0,
+	0x9a, 0xba, 0x9e, 0xb8, 0xa8, 0x9a, 0x96, 0x3d, 0xcd, 0xca, 0x0e, 0x13, 0xec, 0x15, 0xf5, 0x64,
+	0xf0, 0xd6, 0x54, 0x92, 0x82, 0xb8, 0x3e, 0x13, 0xac, 0x4b, 0xe5, 0x0d, 0x7b, 0x6a, 0xe8, 0x51,
+	0xcb, 0x4c, 0x8d, 0x66, 0x5d, 0x0f, 0xc8, 0x8b, 0xac, 0xfa, 0x72, 0x82, 0x27, 0x0f, 0xc1, 0x75,
+	0x99, 0x1b, 0xef, 0x9e, 0xfe, 0xb8, 0x6b, 0x18, 0xb7, 0x77, 0x0d, 0xe3, 0xcf, 0x5d, 0xc3, 0xf8,
+	0x76, 0xdf, 0x98, 0xb9, 0xbd, 0x6f, 0xcc, 0xfc, 0xbe, 0x6f, 0xcc, 0x7c, 0xde, 0xb1, 0x99, 0xba,
+	0x19, 0x5e, 0xb7, 0x7b, 0xc2, 0xed, 0x44, 0x67, 0xec, 0xdc, 0x0c, 0xaf, 0xe3, 0xdf, 0x9d, 0xaf,
+	0xfa, 0xcb, 0xa1, 0xc6, 0x03, 0x2a, 0x3b, 0xfe, 0xee, 0xf5, 0xa2, 0xfe, 0x42, 0xec, 0xff, 0x1d,
+	0x00, 0x63, 0xd1, 0xaf, 0xd1, 0x86, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +350,175 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StructuredConstitution != nil {
+		{
+			size, err := m.StructuredConstitution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ConstitutionHistory) > 0 {
+		for iNdEx := len(m.ConstitutionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConstitutionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.FundingStreams) > 0 {
+		for iNdEx := len(m.FundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.VoteHistory) > 0 {
+		for iNdEx := len(m.VoteHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Laws) > 0 {
+		for iNdEx := len(m.Laws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Laws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.FinalVotes) > 0 {
+		for iNdEx := len(m.FinalVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.LawParticipationEma) > 0 {
+		i -= len(m.LawParticipationEma)
+		copy(dAtA[i:], m.LawParticipationEma)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LawParticipationEma)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ConstitutionAmendmentParticipationEma) > 0 {
+		i -= len(m.ConstitutionAmendmentParticipationEma)
+		copy(dAtA[i:], m.ConstitutionAmendmentParticipationEma)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConstitutionAmendmentParticipationEma)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ParticipationEma) > 0 {
+		i -= len(m.ParticipationEma)
+		copy(dAtA[i:], m.ParticipationEma)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ParticipationEma)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastMinInitialDeposit != nil {
+		{
+			size, err := m.LastMinInitialDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.LastMinDeposit != nil {
+		{
+			size, err := m.LastMinDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -367,6 +682,72 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.LastMinDeposit != nil {
+		l = m.LastMinDeposit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastMinInitialDeposit != nil {
+		l = m.LastMinInitialDeposit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ParticipationEma)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentParticipationEma)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.LawParticipationEma)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FinalVotes) > 0 {
+		for _, e := range m.FinalVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Laws) > 0 {
+		for _, e := range m.Laws {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteHistory) > 0 {
+		for _, e := range m.VoteHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingStreams) > 0 {
+		for _, e := range m.FundingStreams {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConstitutionHistory) > 0 {
+		for _, e := range m.ConstitutionHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StructuredConstitution != nil {
+		l = m.StructuredConstitution.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -702,6 +1083,448 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastMinDeposit == nil {
+				m.LastMinDeposit = &LastMinDeposit{}
+			}
+			if err := m.LastMinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMinInitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastMinInitialDeposit == nil {
+				m.LastMinInitialDeposit = &LastMinDeposit{}
+			}
+			if err := m.LastMinInitialDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, &Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationEma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipationEma = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentParticipationEma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentParticipationEma = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawParticipationEma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawParticipationEma = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalVotes = append(m.FinalVotes, &FinalVote{})
+			if err := m.FinalVotes[len(m.FinalVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Laws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Laws = append(m.Laws, &Law{})
+			if err := m.Laws[len(m.Laws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteHistory = append(m.VoteHistory, &VoteHistoryEntry{})
+			if err := m.VoteHistory[len(m.VoteHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreams = append(m.FundingStreams, &FundingStream{})
+			if err := m.FundingStreams[len(m.FundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionHistory = append(m.ConstitutionHistory, &ConstitutionVersion{})
+			if err := m.ConstitutionHistory[len(m.ConstitutionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredConstitution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredConstitution == nil {
+				m.StructuredConstitution = &StructuredConstitution{}
+			}
+			if err := m.StructuredConstitution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +21,7 @@ func TestEmptyGenesis(t *testing.T) {
 
 func TestValidateGenesis(t *testing.T) {
 	params := v1.DefaultParams()
+	govAddr, delAddr := sdk.AccAddress("governor"), sdk.AccAddress("delegator")
 
 	testCases := []struct {
 		name         string
@@ -40,17 +42,110 @@ func TestValidateGenesis(t *testing.T) {
 			expErrMsg: "starting proposal id must be greater than 0",
 		},
 		{
-			name: "invalid min deposit",
+			name: "min deposit set manually",
 			genesisState: func() *v1.GenesisState {
 				params1 := params
-				params1.MinDeposit = sdk.Coins{{
+				params1.MinDeposit = v1.DefaultMinDepositFloor
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "manually setting min deposit is deprecated in favor of a dynamic min deposit",
+		},
+		{
+			name: "min initial deposit ratio set manually",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MinInitialDepositRatio = "0.1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "manually setting min initial deposit ratio is deprecated in favor of a dynamic min initial deposit",
+		},
+		{
+			name: "nil min initial deposit throttler",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MinInitialDepositThrottler = nil
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "min initial deposit throttler must not be nil",
+		},
+		{
+			name: "invalid min initial deposit floor",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				throttler := *params.MinInitialDepositThrottler
+				throttler.FloorValue = nil
+				params1.MinInitialDepositThrottler = &throttler
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid minimum initial deposit floor",
+		},
+		{
+			name: "invalid min initial deposit increase ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				throttler := *params.MinInitialDepositThrottler
+				throttler.IncreaseRatio = "1"
+				params1.MinInitialDepositThrottler = &throttler
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "minimum initial deposit increase ratio must be less than 1",
+		},
+		{
+			name: "invalid min deposit floor",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				throttler := *params.MinDepositThrottler
+				throttler.FloorValue = sdk.Coins{{
 					Denom:  sdk.DefaultBondDenom,
 					Amount: sdk.NewInt(-100),
 				}}
+				params1.MinDepositThrottler = &throttler
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid minimum deposit floor",
+		},
+		{
+			name: "invalid min deposit update period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				throttler := *params.MinDepositThrottler
+				throttler.UpdatePeriod = nil
+				params1.MinDepositThrottler = &throttler
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "minimum deposit update period must not be nil",
+		},
+		{
+			name: "min deposit decrease ratio greater than increase ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				throttler := *params.MinDepositThrottler
+				throttler.DecreaseRatio = "0.5"
+				throttler.IncreaseRatio = "0.1"
+				params1.MinDepositThrottler = &throttler
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "must be less than the increase ratio",
+		},
+		{
+			name: "invalid min deposit sensitivity target distance",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				throttler := *params.MinDepositThrottler
+				throttler.SensitivityTargetDistance = 0
+				params1.MinDepositThrottler = &throttler
 
 				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
 			},
-			expErrMsg: "invalid minimum deposit",
+			expErrMsg: "minimum deposit sensitivity target distance must be positive",
 		},
 		{
 			name: "invalid max deposit period",
@@ -185,6 +280,251 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "vote proposal_id:1 voter:\"voter\"  has non-existent proposal id: 1",
 		},
+		{
+			name: "duplicate final votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.FinalVotes = append(state.FinalVotes,
+					&v1.FinalVote{
+						ProposalId: 1,
+						Voter:      "voter",
+					},
+					&v1.FinalVote{
+						ProposalId: 1,
+						Voter:      "voter",
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate final vote",
+		},
+		{
+			name: "non-existent proposal id in final votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.FinalVotes = append(state.FinalVotes,
+					&v1.FinalVote{
+						ProposalId: 1,
+						Voter:      "voter",
+					})
+
+				return state
+			},
+			expErrMsg: "has non-existent proposal id: 1",
+		},
+		{
+			name: "valid vote history",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VoteHistory = append(state.VoteHistory,
+					&v1.VoteHistoryEntry{ProposalId: 1, Sequence: 1, Voter: delAddr.String()},
+					&v1.VoteHistoryEntry{ProposalId: 1, Sequence: 2, Voter: delAddr.String()})
+
+				return state
+			},
+		},
+		{
+			name: "duplicate vote history entries",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VoteHistory = append(state.VoteHistory,
+					&v1.VoteHistoryEntry{ProposalId: 1, Sequence: 1, Voter: delAddr.String()},
+					&v1.VoteHistoryEntry{ProposalId: 1, Sequence: 1, Voter: govAddr.String()})
+
+				return state
+			},
+			expErrMsg: "duplicate vote history entry",
+		},
+		{
+			name: "zero vote history sequence",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VoteHistory = append(state.VoteHistory,
+					&v1.VoteHistoryEntry{ProposalId: 1, Voter: delAddr.String()})
+
+				return state
+			},
+			expErrMsg: "has a zero sequence",
+		},
+		{
+			name: "non-existent proposal id in vote history",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteHistory = append(state.VoteHistory,
+					&v1.VoteHistoryEntry{ProposalId: 1, Sequence: 1, Voter: delAddr.String()})
+
+				return state
+			},
+			expErrMsg: "has non-existent proposal id: 1",
+		},
+		{
+			name: "valid laws",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Laws = append(state.Laws,
+					&v1.Law{Id: 1, ProposalId: 1, Title: "title", Text: "text"},
+					&v1.Law{Id: 2, ProposalId: 2, Title: "title", Text: "text", Supersedes: []uint64{1}})
+
+				return state
+			},
+		},
+		{
+			name: "duplicate laws",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Laws = append(state.Laws,
+					&v1.Law{Id: 1, ProposalId: 1},
+					&v1.Law{Id: 1, ProposalId: 2})
+
+				return state
+			},
+			expErrMsg: "duplicate law id: 1",
+		},
+		{
+			name: "zero law id",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Laws = append(state.Laws, &v1.Law{ProposalId: 1})
+
+				return state
+			},
+			expErrMsg: "law id cannot be 0",
+		},
+		{
+			name: "non-existent superseded law",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Laws = append(state.Laws,
+					&v1.Law{Id: 1, ProposalId: 1},
+					&v1.Law{Id: 3, ProposalId: 2, Supersedes: []uint64{2}})
+
+				return state
+			},
+			expErrMsg: "law 3 supersedes non-existent law id: 2",
+		},
+		{
+			name: "valid constitution history",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Constitution = "Hi World"
+				state.ConstitutionHistory = append(state.ConstitutionHistory,
+					&v1.ConstitutionVersion{Version: 0, Constitution: "Hello World"},
+					&v1.ConstitutionVersion{Version: 1, ProposalId: 1, Constitution: "Hi World"})
+
+				return state
+			},
+		},
+		{
+			name: "non sequential constitution history",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Constitution = "Hi World"
+				state.ConstitutionHistory = append(state.ConstitutionHistory,
+					&v1.ConstitutionVersion{Version: 0, Constitution: "Hello World"},
+					&v1.ConstitutionVersion{Version: 2, ProposalId: 1, Constitution: "Hi World"})
+
+				return state
+			},
+			expErrMsg: "invalid constitution version 2, expected 1",
+		},
+		{
+			name: "constitution history not matching the constitution",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Constitution = "Hi World"
+				state.ConstitutionHistory = append(state.ConstitutionHistory,
+					&v1.ConstitutionVersion{Version: 0, Constitution: "Hello World"})
+
+				return state
+			},
+			expErrMsg: "last constitution version does not match the constitution",
+		},
+		{
+			name: "valid structured constitution",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.StructuredConstitution = &v1.StructuredConstitution{
+					Articles: []*v1.ConstitutionArticle{{Id: "art-1", Title: "Article 1", Text: "Hello World"}},
+				}
+
+				return state
+			},
+		},
+		{
+			name: "structured constitution not matching the constitution",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Constitution = "Hello World"
+				state.StructuredConstitution = &v1.StructuredConstitution{
+					Articles: []*v1.ConstitutionArticle{{Id: "art-1", Title: "Article 1", Text: "Hello World"}},
+				}
+
+				return state
+			},
+			expErrMsg: "constitution does not match the rendering of the structured constitution",
+		},
+		{
+			name: "structured constitution with duplicate article ids",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.StructuredConstitution = &v1.StructuredConstitution{
+					Articles: []*v1.ConstitutionArticle{{Id: "art-1"}, {Id: "art-1"}},
+				}
+
+				return state
+			},
+			expErrMsg: "duplicate article id art-1",
+		},
+		{
+			name: "valid funding streams",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.FundingStreams = append(state.FundingStreams,
+					newFundingStream(1, delAddr), newFundingStream(2, govAddr))
+
+				return state
+			},
+		},
+		{
+			name: "duplicate funding streams",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.FundingStreams = append(state.FundingStreams,
+					newFundingStream(1, delAddr), newFundingStream(1, govAddr))
+
+				return state
+			},
+			expErrMsg: "duplicate funding stream id: 1",
+		},
+		{
+			name: "funding stream with zero interval",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				stream := newFundingStream(1, delAddr)
+				zero := time.Duration(0)
+				stream.Interval = &zero
+				state.FundingStreams = append(state.FundingStreams, stream)
+
+				return state
+			},
+			expErrMsg: "payout interval must be positive",
+		},
+		{
+			name: "funding stream with invalid amount",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				stream := newFundingStream(1, delAddr)
+				stream.Amount = nil
+				state.FundingStreams = append(state.FundingStreams, stream)
+
+				return state
+			},
+			expErrMsg: "invalid payout amount",
+		},
 		{
 			name: "non-existent proposal id in deposits",
 			genesisState: func() *v1.GenesisState {
@@ -199,6 +539,386 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "invalid min governor self delegation",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MinGovernorSelfDelegation = "-1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "minimum governor self delegation must be positive",
+		},
+		{
+			name: "nil governor status change period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.GovernorStatusChangePeriod = nil
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "governor status change period must not be nil",
+		},
+		{
+			name: "nil quorum range",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.QuorumRange = nil
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "quorum range must not be nil",
+		},
+		{
+			name: "invalid law quorum range max",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.LawQuorumRange = &v1.QuorumRange{Min: "0.1", Max: "1.1"}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "law quorum range max too large",
+		},
+		{
+			name: "constitution amendment quorum range min greater than max",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ConstitutionAmendmentQuorumRange = &v1.QuorumRange{Min: "0.5", Max: "0.4"}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "constitution amendment quorum range min 0.500000000000000000 must be less than or equal to max 0.400000000000000000",
+		},
+		{
+			name: "zero final votes retention period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				retention := time.Duration(0)
+				params1.FinalVotesRetentionPeriod = &retention
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "final votes retention period must be positive: 0s",
+		},
+		{
+			name: "negative min vote staked tokens",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MinVoteStakedTokens = "-1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "minimum vote staked tokens must not be negative: -1",
+		},
+		{
+			name: "zero max delegations checked",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MaxDelegationsChecked = 0
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "maximum delegations checked must be positive",
+		},
+		{
+			name: "invalid min deposit staked tokens",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MinDepositStakedTokens = "abc"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid minimum deposit staked tokens: abc",
+		},
+		{
+			name: "invalid proposal cancel ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ProposalCancelRatio = "1.1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "proposal cancel ratio too large: 1.1",
+		},
+		{
+			name: "expedited voting period below the min expedited voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				expeditedVotingPeriod := time.Hour * 24
+				params1.ExpeditedVotingPeriod = &expeditedVotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "expedited voting period must be at least 168h0m0s: 24h0m0s",
+		},
+		{
+			name: "expedited voting period not less than voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedVotingPeriod = params1.VotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "expedited voting period 504h0m0s must be strictly less than the voting period 504h0m0s",
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedThreshold = params1.Threshold
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "expedited threshold must be greater than the governance threshold: 0.667000000000000000",
+		},
+		{
+			name: "expedited min deposit not greater than min deposit floor",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedMinDeposit = params1.MinDepositThrottler.FloorValue
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "expedited minimum deposit 10000000stake must be greater than the minimum deposit floor value 10000000stake",
+		},
+		{
+			name: "duplicate expedited allowed message type URL",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedAllowedMsgTypeUrls = []string{"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate expedited allowed message type URL: /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		},
+		{
+			name: "valid message tally params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageTallyParams = []v1.MessageTallyParams{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.5", Threshold: "0.8"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+		},
+		{
+			name: "message tally params quorum below governance quorum",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageTallyParams = []v1.MessageTallyParams{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.1", Threshold: "0.8"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "quorum of message /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade must be greater than or equal to governance quorum: 0.100000000000000000",
+		},
+		{
+			name: "message tally params quorum below governance quorum range max",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.DynamicQuorum = true
+				params1.MessageTallyParams = []v1.MessageTallyParams{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.4", Threshold: "0.8"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "quorum of message /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade must be greater than or equal to governance quorum range max: 0.400000000000000000",
+		},
+		{
+			name: "valid message tally params with dynamic quorum",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.DynamicQuorum = true
+				params1.MessageTallyParams = []v1.MessageTallyParams{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.5", Threshold: "0.8"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+		},
+		{
+			name: "message tally params threshold below governance threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageTallyParams = []v1.MessageTallyParams{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.5", Threshold: "0.5"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "threshold of message /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade must be greater than or equal to governance threshold: 0.500000000000000000",
+		},
+		{
+			name: "duplicate message tally params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageTallyParams = []v1.MessageTallyParams{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.5", Threshold: "0.8"},
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Quorum: "0.6", Threshold: "0.9"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate message tally params type URL: /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		},
+		{
+			name: "negative execution delay",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				executionDelay := -time.Hour
+				params1.ExecutionDelay = &executionDelay
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "execution delay must not be negative: -1h0m0s",
+		},
+		{
+			name: "valid message execution delays",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageExecutionDelays = []v1.MessageExecutionDelay{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Delay: 48 * time.Hour},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+		},
+		{
+			name: "duplicate message execution delays",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageExecutionDelays = []v1.MessageExecutionDelay{
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Delay: 48 * time.Hour},
+					{MsgTypeUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", Delay: time.Hour},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate message execution delay type URL: /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		},
+		{
+			name: "zero max proposal execution gas",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MaxProposalExecutionGas = 0
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "maximum proposal execution gas must be positive",
+		},
+		{
+			name: "zero max end blocker proposals",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MaxEndBlockerProposals = 0
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "maximum number of proposals processed per block must be positive",
+		},
+		{
+			name: "valid participation EMAs",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ParticipationEma = "0.5"
+				state.ConstitutionAmendmentParticipationEma = "1"
+				state.LawParticipationEma = "0"
+
+				return state
+			},
+		},
+		{
+			name: "invalid participation EMA",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.LawParticipationEma = "1.5"
+
+				return state
+			},
+			expErrMsg: "law participation EMA must be between 0 and 1",
+		},
+		{
+			name: "valid governors and governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				governor := v1.NewGovernor(govAddr, v1.GovernorDescription{}, time.Now())
+				state.Governors = append(state.Governors, &governor)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: govAddr.String(), GovernorAddress: govAddr.String()},
+					&v1.GovernanceDelegation{DelegatorAddress: delAddr.String(), GovernorAddress: govAddr.String()},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "duplicate governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				governor := v1.NewGovernor(govAddr, v1.GovernorDescription{}, time.Now())
+				state.Governors = append(state.Governors, &governor, &governor)
+
+				return state
+			},
+			expErrMsg: "duplicate governor",
+		},
+		{
+			name: "invalid governor status",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				governor := v1.NewGovernor(govAddr, v1.GovernorDescription{}, time.Now())
+				governor.Status = v1.GovernorStatusUnspecified
+				state.Governors = append(state.Governors, &governor)
+
+				return state
+			},
+			expErrMsg: "invalid status for governor",
+		},
+		{
+			name: "governance delegation to non-existent governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delAddr.String(), GovernorAddress: govAddr.String()},
+				)
+
+				return state
+			},
+			expErrMsg: "has non-existent governor",
+		},
+		{
+			name: "duplicate governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				governor := v1.NewGovernor(govAddr, v1.GovernorDescription{}, time.Now())
+				state.Governors = append(state.Governors, &governor)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delAddr.String(), GovernorAddress: govAddr.String()},
+					&v1.GovernanceDelegation{DelegatorAddress: delAddr.String(), GovernorAddress: govAddr.String()},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate governance delegation",
+		},
+		{
+			name: "governor delegates to another governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				governor1 := v1.NewGovernor(govAddr, v1.GovernorDescription{}, time.Now())
+				governor2 := v1.NewGovernor(delAddr, v1.GovernorDescription{}, time.Now())
+				state.Governors = append(state.Governors, &governor1, &governor2)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delAddr.String(), GovernorAddress: govAddr.String()},
+				)
+
+				return state
+			},
+			expErrMsg: "cannot delegate to another governor",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -214,3 +934,18 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func newFundingStream(id uint64, recipient sdk.AccAddress) *v1.FundingStream {
+	interval := time.Hour
+	nextPayoutTime := time.Now()
+	endTime := nextPayoutTime.Add(24 * time.Hour)
+	return &v1.FundingStream{
+		Id:             id,
+		ProposalId:     1,
+		Recipient:      recipient.String(),
+		Amount:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Interval:       &interval,
+		NextPayoutTime: &nextPayoutTime,
+		EndTime:        &endTime,
+	}
+}
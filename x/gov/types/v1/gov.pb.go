@@ -4,7 +4,9 @@
 package v1
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types2 "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -68,6 +70,40 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ecf0f9950ff6986c, []int{0}
 }
 
+// ProposalExecutionPolicy enumerates the execution policies of the messages
+// of a passed proposal.
+type ProposalExecutionPolicy int32
+
+const (
+	// PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING executes the messages atomically:
+	// if a message fails, the state changes of all the messages are reverted and
+	// the proposal fails.
+	ProposalExecutionPolicy_PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING ProposalExecutionPolicy = 0
+	// PROPOSAL_EXECUTION_POLICY_BEST_EFFORT executes each message independently:
+	// the state changes of a failing message are reverted, and the following
+	// messages are still executed. The proposal fails only if all its messages
+	// fail.
+	ProposalExecutionPolicy_PROPOSAL_EXECUTION_POLICY_BEST_EFFORT ProposalExecutionPolicy = 1
+)
+
+var ProposalExecutionPolicy_name = map[int32]string{
+	0: "PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING",
+	1: "PROPOSAL_EXECUTION_POLICY_BEST_EFFORT",
+}
+
+var ProposalExecutionPolicy_value = map[string]int32{
+	"PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING": 0,
+	"PROPOSAL_EXECUTION_POLICY_BEST_EFFORT":    1,
+}
+
+func (x ProposalExecutionPolicy) String() string {
+	return proto.EnumName(ProposalExecutionPolicy_name, int32(x))
+}
+
+func (ProposalExecutionPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{1}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -89,6 +125,10 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_PASSED_PENDING_EXECUTION defines a proposal status of a
+	// proposal that has passed, and whose messages are waiting in the execution
+	// queue for its execution delay to elapse.
+	ProposalStatus_PROPOSAL_STATUS_PASSED_PENDING_EXECUTION ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -98,15 +138,17 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION",
 }
 
 var ProposalStatus_value = map[string]int32{
-	"PROPOSAL_STATUS_UNSPECIFIED":    0,
-	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-	"PROPOSAL_STATUS_PASSED":         3,
-	"PROPOSAL_STATUS_REJECTED":       4,
-	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_UNSPECIFIED":              0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD":           1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":            2,
+	"PROPOSAL_STATUS_PASSED":                   3,
+	"PROPOSAL_STATUS_REJECTED":                 4,
+	"PROPOSAL_STATUS_FAILED":                   5,
+	"PROPOSAL_STATUS_PASSED_PENDING_EXECUTION": 6,
 }
 
 func (x ProposalStatus) String() string {
@@ -114,7 +156,79 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{1}
+	return fileDescriptor_ecf0f9950ff6986c, []int{2}
+}
+
+// ArticleOperationType enumerates the operations of a structured
+// constitution amendment.
+type ArticleOperationType int32
+
+const (
+	// ARTICLE_OPERATION_TYPE_UNSPECIFIED defines a no-op operation.
+	ArticleOperationUnspecified ArticleOperationType = 0
+	// ARTICLE_OPERATION_TYPE_REPLACE replaces the title and text of an article,
+	// its sections are left untouched.
+	ArticleOperationReplace ArticleOperationType = 1
+	// ARTICLE_OPERATION_TYPE_INSERT inserts a new article, with its sections.
+	ArticleOperationInsert ArticleOperationType = 2
+	// ARTICLE_OPERATION_TYPE_DELETE deletes an article, with its sections.
+	ArticleOperationDelete ArticleOperationType = 3
+)
+
+var ArticleOperationType_name = map[int32]string{
+	0: "ARTICLE_OPERATION_TYPE_UNSPECIFIED",
+	1: "ARTICLE_OPERATION_TYPE_REPLACE",
+	2: "ARTICLE_OPERATION_TYPE_INSERT",
+	3: "ARTICLE_OPERATION_TYPE_DELETE",
+}
+
+var ArticleOperationType_value = map[string]int32{
+	"ARTICLE_OPERATION_TYPE_UNSPECIFIED": 0,
+	"ARTICLE_OPERATION_TYPE_REPLACE":     1,
+	"ARTICLE_OPERATION_TYPE_INSERT":      2,
+	"ARTICLE_OPERATION_TYPE_DELETE":      3,
+}
+
+func (x ArticleOperationType) String() string {
+	return proto.EnumName(ArticleOperationType_name, int32(x))
+}
+
+func (ArticleOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{3}
+}
+
+// GovernorStatus is the status of a governor.
+type GovernorStatus int32
+
+const (
+	// GOVERNOR_STATUS_UNSPECIFIED defines an unspecified governor status.
+	GovernorStatus_GOVERNOR_STATUS_UNSPECIFIED GovernorStatus = 0
+	// GOVERNOR_STATUS_ACTIVE defines an active governor, whose voting power is
+	// used in tallies.
+	GovernorStatus_GOVERNOR_STATUS_ACTIVE GovernorStatus = 1
+	// GOVERNOR_STATUS_INACTIVE defines an inactive governor, whose voting power
+	// is not used in tallies.
+	GovernorStatus_GOVERNOR_STATUS_INACTIVE GovernorStatus = 2
+)
+
+var GovernorStatus_name = map[int32]string{
+	0: "GOVERNOR_STATUS_UNSPECIFIED",
+	1: "GOVERNOR_STATUS_ACTIVE",
+	2: "GOVERNOR_STATUS_INACTIVE",
+}
+
+var GovernorStatus_value = map[string]int32{
+	"GOVERNOR_STATUS_UNSPECIFIED": 0,
+	"GOVERNOR_STATUS_ACTIVE":      1,
+	"GOVERNOR_STATUS_INACTIVE":    2,
+}
+
+func (x GovernorStatus) String() string {
+	return proto.EnumName(GovernorStatus_name, int32(x))
+}
+
+func (GovernorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{4}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -274,6 +388,24 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited
+	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// conflicting_proposal_ids are the ids of the constitution amendment
+	// proposals whose amendment conflicts with the amendment of this proposal,
+	// i.e. after which the amendment of this proposal does not apply anymore.
+	ConflictingProposalIds []uint64 `protobuf:"varint,15,rep,packed,name=conflicting_proposal_ids,json=conflictingProposalIds,proto3" json:"conflicting_proposal_ids,omitempty"`
+	// failed_reason is the reason of the failure of the execution of the
+	// proposal, if its status is PROPOSAL_STATUS_FAILED.
+	FailedReason string `protobuf:"bytes,16,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// execution_policy defines how the messages of the proposal are executed if
+	// it passes.
+	ExecutionPolicy ProposalExecutionPolicy `protobuf:"varint,17,opt,name=execution_policy,json=executionPolicy,proto3,enum=atomone.gov.v1.ProposalExecutionPolicy" json:"execution_policy,omitempty"`
+	// msg_results are the results of the execution of the messages of the
+	// proposal, once it passed.
+	MsgResults []ProposalMsgResult `protobuf:"bytes,18,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
+	// execution_time is the time at which the messages of the proposal are
+	// executed, or scheduled to be executed, once it passed.
+	ExecutionTime *time.Time `protobuf:"bytes,19,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -400,6 +532,135 @@ func (m *Proposal) GetProposer() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetConflictingProposalIds() []uint64 {
+	if m != nil {
+		return m.ConflictingProposalIds
+	}
+	return nil
+}
+
+func (m *Proposal) GetFailedReason() string {
+	if m != nil {
+		return m.FailedReason
+	}
+	return ""
+}
+
+func (m *Proposal) GetExecutionPolicy() ProposalExecutionPolicy {
+	if m != nil {
+		return m.ExecutionPolicy
+	}
+	return ProposalExecutionPolicy_PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING
+}
+
+func (m *Proposal) GetMsgResults() []ProposalMsgResult {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
+// ProposalMsgResult defines the result of the execution, or of the simulated
+// execution, of a proposal message.
+type ProposalMsgResult struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// success is true if the message executed without error and its state
+	// changes were applied. It is false for the messages of a proposal which
+	// were reverted because another message failed.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the error returned by the message, if any, or the reason why its
+	// state changes were reverted.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas used by the message.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// events are the events emitted by the message. They are not stored with the
+	// results of executed proposals.
+	Events []types2.Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events"`
+}
+
+func (m *ProposalMsgResult) Reset()         { *m = ProposalMsgResult{} }
+func (m *ProposalMsgResult) String() string { return proto.CompactTextString(m) }
+func (*ProposalMsgResult) ProtoMessage()    {}
+func (*ProposalMsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{3}
+}
+func (m *ProposalMsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalMsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalMsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalMsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMsgResult.Merge(m, src)
+}
+func (m *ProposalMsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalMsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalMsgResult proto.InternalMessageInfo
+
+func (m *ProposalMsgResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ProposalMsgResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ProposalMsgResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ProposalMsgResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ProposalMsgResult) GetEvents() []types2.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -414,7 +675,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{3}
+	return fileDescriptor_ecf0f9950ff6986c, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,6 +725,107 @@ func (m *TallyResult) GetNoCount() string {
 	return ""
 }
 
+// TallyProjection defines the tally of a proposal in voting period, as if its
+// voting period ended now.
+type TallyProjection struct {
+	// tally_result is the voting power of each vote option.
+	TallyResult *TallyResult `protobuf:"bytes,1,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
+	// total_voting_power is the total voting power that voted on the proposal.
+	TotalVotingPower string `protobuf:"bytes,2,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// participation is the total voting power divided by the bonded tokens.
+	Participation string `protobuf:"bytes,3,opt,name=participation,proto3" json:"participation,omitempty"`
+	// quorum is the quorum that applies to the proposal.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the threshold that applies to the proposal.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// quorum_reached is true if the participation reaches the quorum.
+	QuorumReached bool `protobuf:"varint,6,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty"`
+	// passes is true if the proposal would pass if its voting period ended now.
+	Passes bool `protobuf:"varint,7,opt,name=passes,proto3" json:"passes,omitempty"`
+}
+
+func (m *TallyProjection) Reset()         { *m = TallyProjection{} }
+func (m *TallyProjection) String() string { return proto.CompactTextString(m) }
+func (*TallyProjection) ProtoMessage()    {}
+func (*TallyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{5}
+}
+func (m *TallyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyProjection.Merge(m, src)
+}
+func (m *TallyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyProjection proto.InternalMessageInfo
+
+func (m *TallyProjection) GetTallyResult() *TallyResult {
+	if m != nil {
+		return m.TallyResult
+	}
+	return nil
+}
+
+func (m *TallyProjection) GetTotalVotingPower() string {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return ""
+}
+
+func (m *TallyProjection) GetParticipation() string {
+	if m != nil {
+		return m.Participation
+	}
+	return ""
+}
+
+func (m *TallyProjection) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *TallyProjection) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *TallyProjection) GetQuorumReached() bool {
+	if m != nil {
+		return m.QuorumReached
+	}
+	return false
+}
+
+func (m *TallyProjection) GetPasses() bool {
+	if m != nil {
+		return m.Passes
+	}
+	return false
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -475,13 +837,15 @@ type Vote struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// changes is the number of times the voter changed its vote.
+	Changes uint64 `protobuf:"varint,6,opt,name=changes,proto3" json:"changes,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{4}
+	return fileDescriptor_ecf0f9950ff6986c, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,31 +902,41 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
-// QuorumCheckQueueEntry defines a quorum check queue entry.
-type QuorumCheckQueueEntry struct {
-	// quorum_timeout_time is the time after which quorum checks start happening
-	// and voting period is extended if proposal reaches quorum.
-	QuorumTimeoutTime *time.Time `protobuf:"bytes,1,opt,name=quorum_timeout_time,json=quorumTimeoutTime,proto3,stdtime" json:"quorum_timeout_time,omitempty"`
-	// quorum_check_count is the number of times quorum will be checked.
-	// This is a snapshot of the parameter value with the same name when the
-	// proposal is initially added to the queue.
-	QuorumCheckCount uint64 `protobuf:"varint,2,opt,name=quorum_check_count,json=quorumCheckCount,proto3" json:"quorum_check_count,omitempty"`
-	// quorum_checks_done is the number of quorum checks that have been done.
-	QuorumChecksDone uint64 `protobuf:"varint,3,opt,name=quorum_checks_done,json=quorumChecksDone,proto3" json:"quorum_checks_done,omitempty"`
+func (m *Vote) GetChanges() uint64 {
+	if m != nil {
+		return m.Changes
+	}
+	return 0
 }
 
-func (m *QuorumCheckQueueEntry) Reset()         { *m = QuorumCheckQueueEntry{} }
-func (m *QuorumCheckQueueEntry) String() string { return proto.CompactTextString(m) }
-func (*QuorumCheckQueueEntry) ProtoMessage()    {}
-func (*QuorumCheckQueueEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{5}
+// VoteHistoryEntry defines a vote cast or changed on a governance proposal,
+// recorded in the vote history of the proposal.
+type VoteHistoryEntry struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// sequence is the position of the entry in the vote history of the
+	// proposal, starting at 1.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	// height is the block height at which the vote was cast.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
-func (m *QuorumCheckQueueEntry) XXX_Unmarshal(b []byte) error {
+
+func (m *VoteHistoryEntry) Reset()         { *m = VoteHistoryEntry{} }
+func (m *VoteHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*VoteHistoryEntry) ProtoMessage()    {}
+func (*VoteHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{7}
+}
+func (m *VoteHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuorumCheckQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VoteHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuorumCheckQueueEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VoteHistoryEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -572,60 +946,79 @@ func (m *QuorumCheckQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QuorumCheckQueueEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuorumCheckQueueEntry.Merge(m, src)
+func (m *VoteHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteHistoryEntry.Merge(m, src)
 }
-func (m *QuorumCheckQueueEntry) XXX_Size() int {
+func (m *VoteHistoryEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuorumCheckQueueEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuorumCheckQueueEntry.DiscardUnknown(m)
+func (m *VoteHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteHistoryEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuorumCheckQueueEntry proto.InternalMessageInfo
+var xxx_messageInfo_VoteHistoryEntry proto.InternalMessageInfo
 
-func (m *QuorumCheckQueueEntry) GetQuorumTimeoutTime() *time.Time {
+func (m *VoteHistoryEntry) GetProposalId() uint64 {
 	if m != nil {
-		return m.QuorumTimeoutTime
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *QuorumCheckQueueEntry) GetQuorumCheckCount() uint64 {
+func (m *VoteHistoryEntry) GetSequence() uint64 {
 	if m != nil {
-		return m.QuorumCheckCount
+		return m.Sequence
 	}
 	return 0
 }
 
-func (m *QuorumCheckQueueEntry) GetQuorumChecksDone() uint64 {
+func (m *VoteHistoryEntry) GetVoter() string {
 	if m != nil {
-		return m.QuorumChecksDone
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteHistoryEntry) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *VoteHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
 	return 0
 }
 
-// DepositParams defines the params for deposits on governance proposals.
-type DepositParams struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+// FinalVote defines a vote on a governance proposal kept after the proposal
+// was tallied, along with the voting power it used.
+type FinalVote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// voting_power is the voting power used by the vote, including the voting
+	// power inherited from the delegators of a governor.
+	VotingPower string `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 }
 
-func (m *DepositParams) Reset()         { *m = DepositParams{} }
-func (m *DepositParams) String() string { return proto.CompactTextString(m) }
-func (*DepositParams) ProtoMessage()    {}
-func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{6}
+func (m *FinalVote) Reset()         { *m = FinalVote{} }
+func (m *FinalVote) String() string { return proto.CompactTextString(m) }
+func (*FinalVote) ProtoMessage()    {}
+func (*FinalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{8}
 }
-func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+func (m *FinalVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FinalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FinalVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -635,50 +1028,74 @@ func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DepositParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositParams.Merge(m, src)
+func (m *FinalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalVote.Merge(m, src)
 }
-func (m *DepositParams) XXX_Size() int {
+func (m *FinalVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+func (m *FinalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalVote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+var xxx_messageInfo_FinalVote proto.InternalMessageInfo
 
-func (m *DepositParams) GetMinDeposit() []types.Coin {
+func (m *FinalVote) GetProposalId() uint64 {
 	if m != nil {
-		return m.MinDeposit
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
+func (m *FinalVote) GetVoter() string {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *FinalVote) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
 	return nil
 }
 
-// VotingParams defines the params for voting on governance proposals.
-type VotingParams struct {
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+func (m *FinalVote) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
 }
 
-func (m *VotingParams) Reset()         { *m = VotingParams{} }
-func (m *VotingParams) String() string { return proto.CompactTextString(m) }
-func (*VotingParams) ProtoMessage()    {}
-func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{7}
+// Law defines a law ratified by a governance proposal.
+type Law struct {
+	// id defines the unique id of the law.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposal_id defines the unique id of the proposal which ratified the law.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// title is the title of the law.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// text is the body text of the law.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// supersedes is the list of the ids of the laws superseded by this law.
+	Supersedes []uint64 `protobuf:"varint,5,rep,packed,name=supersedes,proto3" json:"supersedes,omitempty"`
+	// ratification_time is the time the law was ratified.
+	RatificationTime *time.Time `protobuf:"bytes,6,opt,name=ratification_time,json=ratificationTime,proto3,stdtime" json:"ratification_time,omitempty"`
+}
+
+func (m *Law) Reset()         { *m = Law{} }
+func (m *Law) String() string { return proto.CompactTextString(m) }
+func (*Law) ProtoMessage()    {}
+func (*Law) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{9}
 }
-func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+func (m *Law) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Law) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Law.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -688,54 +1105,93 @@ func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VotingParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotingParams.Merge(m, src)
+func (m *Law) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Law.Merge(m, src)
 }
-func (m *VotingParams) XXX_Size() int {
+func (m *Law) XXX_Size() int {
 	return m.Size()
 }
-func (m *VotingParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotingParams.DiscardUnknown(m)
+func (m *Law) XXX_DiscardUnknown() {
+	xxx_messageInfo_Law.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+var xxx_messageInfo_Law proto.InternalMessageInfo
 
-func (m *VotingParams) GetVotingPeriod() *time.Duration {
+func (m *Law) GetId() uint64 {
 	if m != nil {
-		return m.VotingPeriod
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-// TallyParams defines the params for tallying votes on governance proposals.
-type TallyParams struct {
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 2/3.
-	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// quorum for constitution amendment proposals
-	ConstitutionAmendmentQuorum string `protobuf:"bytes,3,opt,name=constitution_amendment_quorum,json=constitutionAmendmentQuorum,proto3" json:"constitution_amendment_quorum,omitempty"`
-	// Minimum proportion of Yes votes for a Constitution Amendment proposal to pass. Default value: 0.9.
-	ConstitutionAmendmentThreshold string `protobuf:"bytes,4,opt,name=constitution_amendment_threshold,json=constitutionAmendmentThreshold,proto3" json:"constitution_amendment_threshold,omitempty"`
-	// quorum for law proposals
-	LawQuorum string `protobuf:"bytes,5,opt,name=law_quorum,json=lawQuorum,proto3" json:"law_quorum,omitempty"`
-	// Minimum proportion of Yes votes for a Law proposal to pass. Default value: 0.9.
-	LawThreshold string `protobuf:"bytes,6,opt,name=law_threshold,json=lawThreshold,proto3" json:"law_threshold,omitempty"`
+func (m *Law) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *TallyParams) Reset()         { *m = TallyParams{} }
-func (m *TallyParams) String() string { return proto.CompactTextString(m) }
-func (*TallyParams) ProtoMessage()    {}
-func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{8}
+func (m *Law) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
-func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+
+func (m *Law) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Law) GetSupersedes() []uint64 {
+	if m != nil {
+		return m.Supersedes
+	}
+	return nil
+}
+
+func (m *Law) GetRatificationTime() *time.Time {
+	if m != nil {
+		return m.RatificationTime
+	}
+	return nil
+}
+
+// ConstitutionVersion defines a version of the constitution, recorded each time
+// an amendment is applied.
+type ConstitutionVersion struct {
+	// version defines the version number of the constitution, the genesis
+	// constitution is version 0.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// proposal_id defines the unique id of the proposal which amended the
+	// constitution, 0 for the genesis constitution.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height defines the block height at which the version was recorded.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// amendment is the unified diff applied to the previous version.
+	Amendment string `protobuf:"bytes,4,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	// constitution is the text of the constitution at this version.
+	Constitution string `protobuf:"bytes,5,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// operations are the article operations applied to the previous version,
+	// for amendments of the structured constitution. amendment is empty in
+	// this case.
+	Operations []*ArticleOperation `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (m *ConstitutionVersion) Reset()         { *m = ConstitutionVersion{} }
+func (m *ConstitutionVersion) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionVersion) ProtoMessage()    {}
+func (*ConstitutionVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{10}
+}
+func (m *ConstitutionVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConstitutionVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConstitutionVersion.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -745,121 +1201,86 @@ func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TallyParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TallyParams.Merge(m, src)
+func (m *ConstitutionVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionVersion.Merge(m, src)
 }
-func (m *TallyParams) XXX_Size() int {
+func (m *ConstitutionVersion) XXX_Size() int {
 	return m.Size()
 }
-func (m *TallyParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_TallyParams.DiscardUnknown(m)
+func (m *ConstitutionVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionVersion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+var xxx_messageInfo_ConstitutionVersion proto.InternalMessageInfo
 
-func (m *TallyParams) GetQuorum() string {
+func (m *ConstitutionVersion) GetVersion() uint64 {
 	if m != nil {
-		return m.Quorum
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *TallyParams) GetThreshold() string {
+func (m *ConstitutionVersion) GetProposalId() uint64 {
 	if m != nil {
-		return m.Threshold
+		return m.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (m *TallyParams) GetConstitutionAmendmentQuorum() string {
+func (m *ConstitutionVersion) GetHeight() int64 {
 	if m != nil {
-		return m.ConstitutionAmendmentQuorum
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *TallyParams) GetConstitutionAmendmentThreshold() string {
+func (m *ConstitutionVersion) GetAmendment() string {
 	if m != nil {
-		return m.ConstitutionAmendmentThreshold
+		return m.Amendment
 	}
 	return ""
 }
 
-func (m *TallyParams) GetLawQuorum() string {
+func (m *ConstitutionVersion) GetConstitution() string {
 	if m != nil {
-		return m.LawQuorum
+		return m.Constitution
 	}
 	return ""
 }
 
-func (m *TallyParams) GetLawThreshold() string {
+func (m *ConstitutionVersion) GetOperations() []*ArticleOperation {
 	if m != nil {
-		return m.LawThreshold
+		return m.Operations
 	}
-	return ""
+	return nil
 }
 
-// Params defines the parameters for the x/gov module.
-//
-// Since: cosmos-sdk 0.47
-type Params struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid. Default value: 0.25.
-	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 2/3.
-	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
-	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
-	// burn deposits if a proposal does not meet quorum
-	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
-	// burn deposits if the proposal does not enter voting period
-	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
-	// The ratio representing the proportion of the deposit value minimum that
-	// must be met when making a deposit. Default value: 0.01. Meaning that for a
-	// chain with a min_deposit of 100stake, a deposit of 1stake would be
-	// required.
-	//
-	// Since: cosmos-sdk 0.50
-	// NOTE: backported from v50 (https://github.com/cosmos/cosmos-sdk/pull/18146)
-	MinDepositRatio string `protobuf:"bytes,15,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
-	// quorum for constitution amendment proposals
-	ConstitutionAmendmentQuorum string `protobuf:"bytes,16,opt,name=constitution_amendment_quorum,json=constitutionAmendmentQuorum,proto3" json:"constitution_amendment_quorum,omitempty"`
-	// Minimum proportion of Yes votes for a Constitution Amendment proposal to pass. Default value: 0.9.
-	ConstitutionAmendmentThreshold string `protobuf:"bytes,17,opt,name=constitution_amendment_threshold,json=constitutionAmendmentThreshold,proto3" json:"constitution_amendment_threshold,omitempty"`
-	// quorum for law proposals
-	LawQuorum string `protobuf:"bytes,18,opt,name=law_quorum,json=lawQuorum,proto3" json:"law_quorum,omitempty"`
-	// Minimum proportion of Yes votes for a Law proposal to pass. Default value: 0.9.
-	LawThreshold string `protobuf:"bytes,19,opt,name=law_threshold,json=lawThreshold,proto3" json:"law_threshold,omitempty"`
-	// Duration of time after a proposal enters the voting period, during which quorum
-	// must be achieved to not incur in a voting period extension.
-	QuorumTimeout *time.Duration `protobuf:"bytes,20,opt,name=quorum_timeout,json=quorumTimeout,proto3,stdduration" json:"quorum_timeout,omitempty"`
-	// Duration that expresses the maximum amount of time by which a proposal voting period
-	// can be extended.
-	MaxVotingPeriodExtension *time.Duration `protobuf:"bytes,21,opt,name=max_voting_period_extension,json=maxVotingPeriodExtension,proto3,stdduration" json:"max_voting_period_extension,omitempty"`
-	// Number of times a proposal should be checked for quorum after the quorum timeout
-	// has elapsed. Used to compute the amount of time in between quorum checks.
-	QuorumCheckCount uint64 `protobuf:"varint,22,opt,name=quorum_check_count,json=quorumCheckCount,proto3" json:"quorum_check_count,omitempty"`
+// ConstitutionArticle defines an article, or a section of an article, of the
+// structured constitution.
+type ConstitutionArticle struct {
+	// id defines the stable id of the article, used by amendments, laws and
+	// proposals to refer to it. It must be unique in the constitution.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the article.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// text is the body text of the article, in markdown.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// sections are the sections of the article.
+	Sections []*ConstitutionArticle `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{9}
+func (m *ConstitutionArticle) Reset()         { *m = ConstitutionArticle{} }
+func (m *ConstitutionArticle) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionArticle) ProtoMessage()    {}
+func (*ConstitutionArticle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{11}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *ConstitutionArticle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConstitutionArticle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConstitutionArticle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -869,1282 +1290,9504 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *ConstitutionArticle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionArticle.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *ConstitutionArticle) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *ConstitutionArticle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionArticle.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_ConstitutionArticle proto.InternalMessageInfo
 
-func (m *Params) GetMinDeposit() []types.Coin {
+func (m *ConstitutionArticle) GetId() string {
 	if m != nil {
-		return m.MinDeposit
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *Params) GetMaxDepositPeriod() *time.Duration {
+func (m *ConstitutionArticle) GetTitle() string {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.Title
 	}
-	return nil
+	return ""
 }
 
-func (m *Params) GetVotingPeriod() *time.Duration {
+func (m *ConstitutionArticle) GetText() string {
 	if m != nil {
-		return m.VotingPeriod
+		return m.Text
 	}
-	return nil
+	return ""
 }
 
-func (m *Params) GetQuorum() string {
+func (m *ConstitutionArticle) GetSections() []*ConstitutionArticle {
 	if m != nil {
-		return m.Quorum
+		return m.Sections
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetThreshold() string {
-	if m != nil {
-		return m.Threshold
+// StructuredConstitution defines the constitution as a tree of articles. When
+// set, the constitution string is its markdown rendering.
+type StructuredConstitution struct {
+	// articles are the top level articles of the constitution.
+	Articles []*ConstitutionArticle `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+}
+
+func (m *StructuredConstitution) Reset()         { *m = StructuredConstitution{} }
+func (m *StructuredConstitution) String() string { return proto.CompactTextString(m) }
+func (*StructuredConstitution) ProtoMessage()    {}
+func (*StructuredConstitution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{12}
+}
+func (m *StructuredConstitution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StructuredConstitution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StructuredConstitution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *StructuredConstitution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredConstitution.Merge(m, src)
+}
+func (m *StructuredConstitution) XXX_Size() int {
+	return m.Size()
+}
+func (m *StructuredConstitution) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredConstitution.DiscardUnknown(m)
 }
 
-func (m *Params) GetMinInitialDepositRatio() string {
+var xxx_messageInfo_StructuredConstitution proto.InternalMessageInfo
+
+func (m *StructuredConstitution) GetArticles() []*ConstitutionArticle {
 	if m != nil {
-		return m.MinInitialDepositRatio
+		return m.Articles
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetBurnVoteQuorum() bool {
-	if m != nil {
-		return m.BurnVoteQuorum
+// ArticleOperation defines an operation of a structured constitution
+// amendment.
+type ArticleOperation struct {
+	// type is the type of the operation.
+	Type ArticleOperationType `protobuf:"varint,1,opt,name=type,proto3,enum=atomone.gov.v1.ArticleOperationType" json:"type,omitempty"`
+	// article_id is the id of the article to replace or delete.
+	ArticleId string `protobuf:"bytes,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// parent_id is the id of the article in which the new article is inserted,
+	// empty to insert a top level article.
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// after_id is the id of the sibling article after which the new article is
+	// inserted, empty to insert it first.
+	AfterId string `protobuf:"bytes,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// article is the new article to insert, or the new title and text of the
+	// replaced article.
+	Article *ConstitutionArticle `protobuf:"bytes,5,opt,name=article,proto3" json:"article,omitempty"`
+}
+
+func (m *ArticleOperation) Reset()         { *m = ArticleOperation{} }
+func (m *ArticleOperation) String() string { return proto.CompactTextString(m) }
+func (*ArticleOperation) ProtoMessage()    {}
+func (*ArticleOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{13}
+}
+func (m *ArticleOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArticleOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArticleOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *ArticleOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArticleOperation.Merge(m, src)
+}
+func (m *ArticleOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArticleOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArticleOperation.DiscardUnknown(m)
 }
 
-func (m *Params) GetBurnProposalDepositPrevote() bool {
+var xxx_messageInfo_ArticleOperation proto.InternalMessageInfo
+
+func (m *ArticleOperation) GetType() ArticleOperationType {
 	if m != nil {
-		return m.BurnProposalDepositPrevote
+		return m.Type
 	}
-	return false
+	return ArticleOperationUnspecified
 }
 
-func (m *Params) GetMinDepositRatio() string {
+func (m *ArticleOperation) GetArticleId() string {
 	if m != nil {
-		return m.MinDepositRatio
+		return m.ArticleId
 	}
 	return ""
 }
 
-func (m *Params) GetConstitutionAmendmentQuorum() string {
+func (m *ArticleOperation) GetParentId() string {
 	if m != nil {
-		return m.ConstitutionAmendmentQuorum
+		return m.ParentId
 	}
 	return ""
 }
 
-func (m *Params) GetConstitutionAmendmentThreshold() string {
+func (m *ArticleOperation) GetAfterId() string {
 	if m != nil {
-		return m.ConstitutionAmendmentThreshold
+		return m.AfterId
 	}
 	return ""
 }
 
-func (m *Params) GetLawQuorum() string {
+func (m *ArticleOperation) GetArticle() *ConstitutionArticle {
 	if m != nil {
-		return m.LawQuorum
+		return m.Article
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetLawThreshold() string {
+// FundingStream defines a recurring payout from the community pool to a
+// recipient, created by a governance proposal.
+type FundingStream struct {
+	// id defines the unique id of the funding stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposal_id defines the unique id of the proposal which created the
+	// funding stream.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// recipient is the address of the recipient of the payouts.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid at each payout.
+	Amount []types.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount"`
+	// interval is the duration between two payouts.
+	Interval *time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// next_payout_time is the time of the next payout.
+	NextPayoutTime *time.Time `protobuf:"bytes,6,opt,name=next_payout_time,json=nextPayoutTime,proto3,stdtime" json:"next_payout_time,omitempty"`
+	// end_time is the time after which no more payouts are made.
+	EndTime *time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// total_paid is the total amount paid to the recipient so far.
+	TotalPaid []types.Coin `protobuf:"bytes,8,rep,name=total_paid,json=totalPaid,proto3" json:"total_paid"`
+}
+
+func (m *FundingStream) Reset()         { *m = FundingStream{} }
+func (m *FundingStream) String() string { return proto.CompactTextString(m) }
+func (*FundingStream) ProtoMessage()    {}
+func (*FundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{14}
+}
+func (m *FundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStream.Merge(m, src)
+}
+func (m *FundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStream proto.InternalMessageInfo
+
+func (m *FundingStream) GetId() uint64 {
 	if m != nil {
-		return m.LawThreshold
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FundingStream) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *FundingStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *Params) GetQuorumTimeout() *time.Duration {
+func (m *FundingStream) GetAmount() []types.Coin {
 	if m != nil {
-		return m.QuorumTimeout
+		return m.Amount
 	}
 	return nil
 }
 
-func (m *Params) GetMaxVotingPeriodExtension() *time.Duration {
+func (m *FundingStream) GetInterval() *time.Duration {
 	if m != nil {
-		return m.MaxVotingPeriodExtension
+		return m.Interval
 	}
 	return nil
 }
 
-func (m *Params) GetQuorumCheckCount() uint64 {
+func (m *FundingStream) GetNextPayoutTime() *time.Time {
 	if m != nil {
-		return m.QuorumCheckCount
+		return m.NextPayoutTime
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("atomone.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("atomone.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterType((*WeightedVoteOption)(nil), "atomone.gov.v1.WeightedVoteOption")
-	proto.RegisterType((*Deposit)(nil), "atomone.gov.v1.Deposit")
-	proto.RegisterType((*Proposal)(nil), "atomone.gov.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "atomone.gov.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "atomone.gov.v1.Vote")
-	proto.RegisterType((*QuorumCheckQueueEntry)(nil), "atomone.gov.v1.QuorumCheckQueueEntry")
-	proto.RegisterType((*DepositParams)(nil), "atomone.gov.v1.DepositParams")
-	proto.RegisterType((*VotingParams)(nil), "atomone.gov.v1.VotingParams")
-	proto.RegisterType((*TallyParams)(nil), "atomone.gov.v1.TallyParams")
-	proto.RegisterType((*Params)(nil), "atomone.gov.v1.Params")
+func (m *FundingStream) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("atomone/gov/v1/gov.proto", fileDescriptor_ecf0f9950ff6986c) }
+func (m *FundingStream) GetTotalPaid() []types.Coin {
+	if m != nil {
+		return m.TotalPaid
+	}
+	return nil
+}
 
-var fileDescriptor_ecf0f9950ff6986c = []byte{
-	// 1447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0xf5, 0xcb, 0xf2, 0xb3, 0x2d, 0xd3, 0x67, 0x27, 0xa1, 0xed, 0x58, 0x76, 0x85, 0x22,
-	0x70, 0xd3, 0x58, 0xaa, 0x9d, 0x22, 0x43, 0x91, 0x45, 0xb6, 0x98, 0x54, 0x41, 0x6a, 0x29, 0x94,
-	0xe2, 0x36, 0x1d, 0x4a, 0x9c, 0xc4, 0x8b, 0x4c, 0x44, 0xe4, 0x29, 0xe4, 0xd1, 0xb6, 0xfe, 0x8b,
-	0x4c, 0x45, 0xd1, 0xa9, 0x63, 0xc7, 0x0e, 0x01, 0xfa, 0x07, 0x14, 0x05, 0x32, 0x15, 0x41, 0xa6,
-	0x76, 0x49, 0x8b, 0x64, 0x28, 0x90, 0xbd, 0x7b, 0x71, 0xc7, 0xa3, 0x7e, 0x59, 0x81, 0x9c, 0xa0,
-	0x5d, 0x6c, 0xf2, 0xde, 0xf7, 0xbd, 0xf7, 0xee, 0xbd, 0xef, 0xdd, 0x89, 0xa0, 0x61, 0x46, 0x1d,
-	0xea, 0x92, 0x42, 0x8b, 0x1e, 0x17, 0x8e, 0x77, 0xf8, 0xbf, 0x7c, 0xc7, 0xa3, 0x8c, 0xa2, 0x8c,
-	0xb4, 0xe4, 0xf9, 0xd2, 0xf1, 0xce, 0x6a, 0xb6, 0x49, 0x7d, 0x87, 0xfa, 0x85, 0x06, 0xf6, 0x49,
-	0xe1, 0x78, 0xa7, 0x41, 0x18, 0xde, 0x29, 0x34, 0xa9, 0xed, 0x86, 0xf8, 0xd5, 0xe5, 0x16, 0x6d,
-	0x51, 0xf1, 0x58, 0xe0, 0x4f, 0x72, 0x75, 0xa3, 0x45, 0x69, 0xab, 0x4d, 0x0a, 0xe2, 0xad, 0x11,
-	0x3c, 0x2c, 0x30, 0xdb, 0x21, 0x3e, 0xc3, 0x4e, 0x47, 0x02, 0x56, 0x46, 0x01, 0xd8, 0xed, 0x4a,
-	0x53, 0x76, 0xd4, 0x64, 0x05, 0x1e, 0x66, 0x36, 0x8d, 0x22, 0xae, 0x84, 0x19, 0x99, 0x61, 0xd0,
-	0xf0, 0x45, 0x9a, 0x16, 0xb1, 0x63, 0xbb, 0xb4, 0x20, 0xfe, 0x86, 0x4b, 0xb9, 0x0e, 0xa0, 0x2f,
-	0x89, 0xdd, 0x3a, 0x62, 0xc4, 0x3a, 0xa4, 0x8c, 0x54, 0x3a, 0xdc, 0x13, 0xda, 0x85, 0x14, 0x15,
-	0x4f, 0x9a, 0xb2, 0xa9, 0x6c, 0x65, 0x76, 0x57, 0xf3, 0xc3, 0xdb, 0xce, 0xf7, 0xb1, 0x86, 0x44,
-	0xa2, 0x2b, 0x90, 0x3a, 0x11, 0x9e, 0xb4, 0xd8, 0xa6, 0xb2, 0x35, 0xb3, 0x97, 0x79, 0xf1, 0x74,
-	0x1b, 0x64, 0xf8, 0x12, 0x69, 0x1a, 0xd2, 0x9a, 0xfb, 0x41, 0x81, 0xe9, 0x12, 0xe9, 0x50, 0xdf,
-	0x66, 0x68, 0x03, 0x66, 0x3b, 0x1e, 0xed, 0x50, 0x1f, 0xb7, 0x4d, 0xdb, 0x12, 0xc1, 0x12, 0x06,
-	0x44, 0x4b, 0x65, 0x0b, 0xdd, 0x80, 0x19, 0x2b, 0xc4, 0x52, 0x4f, 0xfa, 0xd5, 0x5e, 0x3c, 0xdd,
-	0x5e, 0x96, 0x7e, 0x8b, 0x96, 0xe5, 0x11, 0xdf, 0xaf, 0x31, 0xcf, 0x76, 0x5b, 0x46, 0x1f, 0x8a,
-	0x6e, 0x42, 0x0a, 0x3b, 0x34, 0x70, 0x99, 0x16, 0xdf, 0x8c, 0x6f, 0xcd, 0xee, 0xae, 0xe4, 0x25,
-	0x83, 0xf7, 0x29, 0x2f, 0xfb, 0x94, 0xdf, 0xa7, 0xb6, 0xbb, 0x37, 0xf3, 0xec, 0xe5, 0xc6, 0xd4,
-	0x8f, 0x7f, 0xff, 0x74, 0x55, 0x31, 0x24, 0x27, 0xf7, 0x4b, 0x12, 0xd2, 0x55, 0x99, 0x04, 0xca,
-	0x40, 0xac, 0x97, 0x5a, 0xcc, 0xb6, 0xd0, 0x27, 0x90, 0x76, 0x88, 0xef, 0xe3, 0x16, 0xf1, 0xb5,
-	0x98, 0x70, 0xbe, 0x9c, 0x0f, 0x5b, 0x92, 0x8f, 0x5a, 0x92, 0x2f, 0xba, 0x5d, 0xa3, 0x87, 0x42,
-	0x37, 0x20, 0xe5, 0x33, 0xcc, 0x02, 0x5f, 0x8b, 0x8b, 0x6a, 0x66, 0x47, 0xab, 0x19, 0xc5, 0xaa,
-	0x09, 0x94, 0x21, 0xd1, 0xa8, 0x0c, 0xe8, 0xa1, 0xed, 0xe2, 0xb6, 0xc9, 0x70, 0xbb, 0xdd, 0x35,
-	0x3d, 0xe2, 0x07, 0x6d, 0xa6, 0x25, 0x36, 0x95, 0xad, 0xd9, 0xdd, 0xb5, 0x51, 0x1f, 0x75, 0x8e,
-	0x31, 0x04, 0xc4, 0x50, 0x05, 0x6d, 0x60, 0x05, 0x15, 0x61, 0xd6, 0x0f, 0x1a, 0x8e, 0xcd, 0x4c,
-	0xae, 0x34, 0x2d, 0x29, 0x7c, 0xac, 0x9e, 0xc9, 0xbb, 0x1e, 0xc9, 0x70, 0x2f, 0xf1, 0xe4, 0xcf,
-	0x0d, 0xc5, 0x80, 0x90, 0xc4, 0x97, 0xd1, 0x1d, 0x50, 0x65, 0x7d, 0x4d, 0xe2, 0x5a, 0xa1, 0x9f,
-	0xd4, 0x39, 0xfd, 0x64, 0x24, 0x53, 0x77, 0x2d, 0xe1, 0xab, 0x0c, 0xf3, 0x8c, 0x32, 0xdc, 0x36,
-	0xe5, 0xba, 0x36, 0xfd, 0x0e, 0x5d, 0x9a, 0x13, 0xd4, 0x48, 0x42, 0x77, 0x61, 0xf1, 0x98, 0x32,
-	0xdb, 0x6d, 0x99, 0x3e, 0xc3, 0x9e, 0xdc, 0x5f, 0xfa, 0x9c, 0x79, 0x2d, 0x84, 0xd4, 0x1a, 0x67,
-	0x8a, 0xc4, 0x3e, 0x07, 0xb9, 0xd4, 0xdf, 0xe3, 0xcc, 0x39, 0x7d, 0xcd, 0x87, 0xc4, 0x68, 0x8b,
-	0xab, 0x5c, 0x26, 0x0c, 0x5b, 0x98, 0x61, 0x0d, 0xb8, 0x70, 0x8d, 0xde, 0x3b, 0x5a, 0x86, 0x24,
-	0xb3, 0x59, 0x9b, 0x68, 0xb3, 0xc2, 0x10, 0xbe, 0x20, 0x0d, 0xa6, 0xfd, 0xc0, 0x71, 0xb0, 0xd7,
-	0xd5, 0xe6, 0xc4, 0x7a, 0xf4, 0x8a, 0x3e, 0x85, 0x74, 0x38, 0x13, 0xc4, 0xd3, 0xe6, 0x27, 0x0c,
-	0x41, 0x0f, 0x99, 0xfb, 0x5e, 0x81, 0xd9, 0x41, 0x0d, 0x7c, 0x0c, 0x33, 0x5d, 0xe2, 0x9b, 0x4d,
-	0x31, 0x16, 0xca, 0x99, 0x19, 0x2d, 0xbb, 0xcc, 0x48, 0x77, 0x89, 0xbf, 0xcf, 0xed, 0xe8, 0x3a,
-	0xcc, 0xe3, 0x86, 0xcf, 0xb0, 0xed, 0x4a, 0x42, 0x6c, 0x2c, 0x61, 0x4e, 0x82, 0x42, 0xd2, 0x47,
-	0x90, 0x76, 0xa9, 0xc4, 0xc7, 0xc7, 0xe2, 0xa7, 0x5d, 0x2a, 0xa0, 0xb9, 0x9f, 0x15, 0x48, 0xf0,
-	0x43, 0x64, 0xf2, 0x11, 0x90, 0x87, 0xe4, 0x31, 0x65, 0x64, 0xf2, 0xf8, 0x87, 0x30, 0x74, 0x13,
-	0xa6, 0xc3, 0x13, 0xc9, 0xd7, 0x12, 0x42, 0x55, 0xb9, 0xd1, 0x51, 0x39, 0x7b, 0xe0, 0x19, 0x11,
-	0x65, 0xa8, 0x6d, 0xc9, 0xe1, 0xb6, 0xdd, 0x49, 0xa4, 0xe3, 0x6a, 0x22, 0xf7, 0xab, 0x02, 0x17,
-	0xee, 0x05, 0xd4, 0x0b, 0x9c, 0xfd, 0x23, 0xd2, 0x7c, 0x74, 0x2f, 0x20, 0x01, 0xd1, 0x5d, 0xe6,
-	0x75, 0x51, 0x15, 0x96, 0x1e, 0x0b, 0x83, 0x10, 0x0e, 0x0d, 0xa4, 0x18, 0x95, 0x73, 0x0a, 0x68,
-	0x31, 0x24, 0xd7, 0x43, 0xae, 0x10, 0xd1, 0x35, 0x40, 0xd2, 0x63, 0x93, 0xc7, 0x1a, 0x68, 0x45,
-	0xc2, 0x50, 0x1f, 0xf7, 0x93, 0x08, 0xcb, 0x3f, 0x82, 0xf6, 0x4d, 0x8b, 0xba, 0x44, 0x34, 0x62,
-	0x18, 0xed, 0x97, 0xa8, 0x4b, 0x72, 0x7f, 0x28, 0x30, 0x2f, 0x87, 0xa8, 0x8a, 0x3d, 0xec, 0xf8,
-	0xe8, 0x01, 0xcc, 0x3a, 0xb6, 0xdb, 0x9b, 0x49, 0x65, 0xd2, 0x4c, 0xae, 0xf3, 0x99, 0x7c, 0xf3,
-	0x72, 0xe3, 0xc2, 0x00, 0xeb, 0x1a, 0x75, 0x6c, 0x46, 0x9c, 0x0e, 0xeb, 0x1a, 0xe0, 0xd8, 0x6e,
-	0x34, 0xa5, 0x0e, 0x20, 0x07, 0x9f, 0x46, 0x20, 0xb3, 0x43, 0x3c, 0x9b, 0x5a, 0x62, 0x23, 0x3c,
-	0xc2, 0x68, 0x65, 0x4a, 0xf2, 0x46, 0xdb, 0xfb, 0xf0, 0xcd, 0xcb, 0x8d, 0xcb, 0x67, 0x89, 0xfd,
-	0x20, 0xdf, 0xf1, 0xc2, 0xa9, 0x0e, 0x3e, 0x8d, 0x76, 0x22, 0xec, 0xb9, 0x3a, 0xcc, 0x1d, 0x8a,
-	0x69, 0x94, 0x3b, 0x2b, 0x81, 0x9c, 0xce, 0x28, 0xb2, 0x32, 0x29, 0x72, 0x42, 0x78, 0x9e, 0x0b,
-	0x59, 0xd2, 0xeb, 0x3f, 0x31, 0x39, 0x50, 0xd2, 0xeb, 0x15, 0x48, 0x85, 0x55, 0x1d, 0x33, 0x4d,
-	0xe2, 0xc6, 0x0b, 0xad, 0xe8, 0x1a, 0xcc, 0xb0, 0x23, 0x8f, 0xf8, 0x47, 0xb4, 0x6d, 0xbd, 0xe5,
-	0x72, 0xec, 0x03, 0x90, 0x01, 0xeb, 0x4d, 0xea, 0xfa, 0xcc, 0x66, 0x01, 0xcf, 0xc4, 0xc4, 0x0e,
-	0x71, 0x2d, 0x87, 0xb8, 0xcc, 0x94, 0xc1, 0xe2, 0x63, 0x3d, 0xac, 0x0d, 0x92, 0x8a, 0x11, 0x27,
-	0x14, 0x2a, 0xfa, 0x0a, 0x36, 0xdf, 0xe2, 0xb3, 0x9f, 0x58, 0x62, 0xac, 0xdb, 0xec, 0x58, 0xb7,
-	0xf5, 0x5e, 0xb6, 0xdb, 0x00, 0x6d, 0x7c, 0x12, 0xa5, 0x96, 0x1c, 0xbf, 0xb9, 0x36, 0x3e, 0x91,
-	0x89, 0x5c, 0x87, 0x79, 0x0e, 0xef, 0x47, 0x4d, 0x8d, 0x65, 0xcc, 0xb5, 0xf1, 0x49, 0x2f, 0x46,
-	0xee, 0xdb, 0x34, 0xa4, 0x64, 0xc9, 0xf5, 0x77, 0x94, 0xe8, 0xc0, 0xb5, 0x31, 0x28, 0xc7, 0x2f,
-	0xde, 0x4f, 0x8e, 0x89, 0xf1, 0x72, 0x3b, 0x2b, 0xaf, 0xf8, 0x7b, 0xc8, 0x6b, 0x40, 0x4e, 0x89,
-	0xf3, 0xcb, 0x29, 0x39, 0x49, 0x4e, 0x65, 0x58, 0xe1, 0x15, 0xb3, 0x5d, 0x9b, 0xd9, 0xfd, 0x0b,
-	0xd7, 0x14, 0x79, 0x68, 0xd3, 0x63, 0xd9, 0x17, 0x1d, 0xdb, 0x2d, 0x87, 0x78, 0xb9, 0x4f, 0x83,
-	0xa3, 0xd1, 0x16, 0xa8, 0x8d, 0xc0, 0x73, 0x4d, 0x7e, 0xce, 0x46, 0x1d, 0xe7, 0xd7, 0x51, 0xda,
-	0xc8, 0xf0, 0x75, 0x7e, 0x9c, 0xca, 0x36, 0x17, 0x61, 0x5d, 0x20, 0x7b, 0x27, 0x7b, 0xaf, 0xd2,
-	0x1e, 0xe1, 0x6c, 0x2d, 0x23, 0x68, 0xab, 0x1c, 0x14, 0xfd, 0xf8, 0x89, 0x4a, 0x1a, 0x22, 0xd0,
-	0x67, 0xb0, 0x38, 0xd0, 0x69, 0x99, 0xef, 0xc2, 0xd8, 0x7c, 0x17, 0xfa, 0x9d, 0x0d, 0x13, 0x9d,
-	0x38, 0x42, 0xea, 0xff, 0x33, 0x42, 0x8b, 0xff, 0xc1, 0x08, 0xa1, 0x77, 0x1e, 0xa1, 0xa5, 0xc9,
-	0x23, 0x84, 0x6e, 0x41, 0x66, 0xf8, 0x6a, 0xd2, 0x96, 0xcf, 0x27, 0xd1, 0xf9, 0xa1, 0x4b, 0x09,
-	0x7d, 0x03, 0x6b, 0x7c, 0x70, 0x86, 0xd4, 0x6e, 0x92, 0x53, 0x46, 0x5c, 0x9f, 0x7f, 0x2d, 0x5c,
-	0x38, 0x9f, 0x53, 0xcd, 0xc1, 0xa7, 0x87, 0x03, 0xd2, 0xd7, 0x23, 0x07, 0x6f, 0xb9, 0xf0, 0x2e,
-	0x8e, 0xbf, 0xf0, 0xae, 0x3e, 0x02, 0x18, 0xf8, 0x68, 0x59, 0x83, 0x4b, 0x87, 0x95, 0xba, 0x6e,
-	0x56, 0xaa, 0xf5, 0x72, 0xe5, 0xc0, 0xbc, 0x7f, 0x50, 0xab, 0xea, 0xfb, 0xe5, 0x5b, 0x65, 0xbd,
-	0xa4, 0x4e, 0xa1, 0x25, 0x58, 0x18, 0x34, 0x3e, 0xd0, 0x6b, 0xaa, 0x82, 0x2e, 0xc1, 0xd2, 0xe0,
-	0x62, 0x71, 0xaf, 0x56, 0x2f, 0x96, 0x0f, 0xd4, 0x18, 0x42, 0x90, 0x19, 0x34, 0x1c, 0x54, 0xd4,
-	0xf8, 0xd5, 0xdf, 0x14, 0xc8, 0x0c, 0xff, 0x50, 0x47, 0x1b, 0xb0, 0x56, 0x35, 0x2a, 0xd5, 0x4a,
-	0xad, 0x78, 0xd7, 0xac, 0xd5, 0x8b, 0xf5, 0xfb, 0xb5, 0x91, 0xa8, 0x39, 0xc8, 0x8e, 0x02, 0x4a,
-	0x7a, 0xb5, 0x52, 0x2b, 0xd7, 0xcd, 0xaa, 0x6e, 0x94, 0x2b, 0x25, 0x55, 0x41, 0x1f, 0xc0, 0xfa,
-	0x28, 0xe6, 0xb0, 0x52, 0x2f, 0x1f, 0xdc, 0x8e, 0x20, 0x31, 0xb4, 0x0a, 0x17, 0x47, 0x21, 0xd5,
-	0x62, 0xad, 0xa6, 0x97, 0xd4, 0x38, 0xba, 0x0c, 0xda, 0xa8, 0xcd, 0xd0, 0xef, 0xe8, 0xfb, 0x75,
-	0xbd, 0xa4, 0x26, 0xc6, 0x31, 0x6f, 0x15, 0xcb, 0x77, 0xf5, 0x92, 0x9a, 0xdc, 0xbb, 0xfd, 0xec,
-	0x55, 0x56, 0x79, 0xfe, 0x2a, 0xab, 0xfc, 0xf5, 0x2a, 0xab, 0x3c, 0x79, 0x9d, 0x9d, 0x7a, 0xfe,
-	0x3a, 0x3b, 0xf5, 0xfb, 0xeb, 0xec, 0xd4, 0xd7, 0xdb, 0x2d, 0x9b, 0x1d, 0x05, 0x8d, 0x7c, 0x93,
-	0x3a, 0x05, 0xf9, 0xdb, 0x69, 0xfb, 0x28, 0x68, 0x44, 0xcf, 0x85, 0x53, 0xf1, 0x5d, 0xcc, 0xba,
-	0x1d, 0xe2, 0xf3, 0x6f, 0xde, 0x94, 0xe8, 0xf3, 0xf5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x62,
-	0x28, 0x2b, 0x9a, 0x36, 0x0f, 0x00, 0x00,
+// QuorumCheckQueueEntry defines a quorum check queue entry.
+type QuorumCheckQueueEntry struct {
+	// quorum_timeout_time is the time after which quorum checks start happening
+	// and voting period is extended if proposal reaches quorum.
+	QuorumTimeoutTime *time.Time `protobuf:"bytes,1,opt,name=quorum_timeout_time,json=quorumTimeoutTime,proto3,stdtime" json:"quorum_timeout_time,omitempty"`
+	// quorum_check_count is the number of times quorum will be checked.
+	// This is a snapshot of the parameter value with the same name when the
+	// proposal is initially added to the queue.
+	QuorumCheckCount uint64 `protobuf:"varint,2,opt,name=quorum_check_count,json=quorumCheckCount,proto3" json:"quorum_check_count,omitempty"`
+	// quorum_checks_done is the number of quorum checks that have been done.
+	QuorumChecksDone uint64 `protobuf:"varint,3,opt,name=quorum_checks_done,json=quorumChecksDone,proto3" json:"quorum_checks_done,omitempty"`
 }
 
-func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuorumCheckQueueEntry) Reset()         { *m = QuorumCheckQueueEntry{} }
+func (m *QuorumCheckQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*QuorumCheckQueueEntry) ProtoMessage()    {}
+func (*QuorumCheckQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{15}
+}
+func (m *QuorumCheckQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumCheckQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumCheckQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuorumCheckQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumCheckQueueEntry.Merge(m, src)
+}
+func (m *QuorumCheckQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumCheckQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumCheckQueueEntry.DiscardUnknown(m)
 }
 
-func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QuorumCheckQueueEntry proto.InternalMessageInfo
+
+func (m *QuorumCheckQueueEntry) GetQuorumTimeoutTime() *time.Time {
+	if m != nil {
+		return m.QuorumTimeoutTime
 	}
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *QuorumCheckQueueEntry) GetQuorumCheckCount() uint64 {
+	if m != nil {
+		return m.QuorumCheckCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuorumCheckQueueEntry) GetQuorumChecksDone() uint64 {
+	if m != nil {
+		return m.QuorumChecksDone
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DepositParams defines the params for deposits on governance proposals.
+type DepositParams struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *DepositParams) Reset()         { *m = DepositParams{} }
+func (m *DepositParams) String() string { return proto.CompactTextString(m) }
+func (*DepositParams) ProtoMessage()    {}
+func (*DepositParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{16}
+}
+func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DepositParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositParams.Merge(m, src)
+}
+func (m *DepositParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+
+func (m *DepositParams) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VotingParams defines the params for voting on governance proposals.
+type VotingParams struct {
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.FinalTallyResult != nil {
-		{
-			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
+func (m *VotingParams) Reset()         { *m = VotingParams{} }
+func (m *VotingParams) String() string { return proto.CompactTextString(m) }
+func (*VotingParams) ProtoMessage()    {}
+func (*VotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{17}
+}
+func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *VotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingParams.Merge(m, src)
+}
+func (m *VotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+
+func (m *VotingParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+// TallyParams defines the params for tallying votes on governance proposals.
+type TallyParams struct {
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 2/3.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// quorum for constitution amendment proposals
+	ConstitutionAmendmentQuorum string `protobuf:"bytes,3,opt,name=constitution_amendment_quorum,json=constitutionAmendmentQuorum,proto3" json:"constitution_amendment_quorum,omitempty"`
+	// Minimum proportion of Yes votes for a Constitution Amendment proposal to pass. Default value: 0.9.
+	ConstitutionAmendmentThreshold string `protobuf:"bytes,4,opt,name=constitution_amendment_threshold,json=constitutionAmendmentThreshold,proto3" json:"constitution_amendment_threshold,omitempty"`
+	// quorum for law proposals
+	LawQuorum string `protobuf:"bytes,5,opt,name=law_quorum,json=lawQuorum,proto3" json:"law_quorum,omitempty"`
+	// Minimum proportion of Yes votes for a Law proposal to pass. Default value: 0.9.
+	LawThreshold string `protobuf:"bytes,6,opt,name=law_threshold,json=lawThreshold,proto3" json:"law_threshold,omitempty"`
+}
+
+func (m *TallyParams) Reset()         { *m = TallyParams{} }
+func (m *TallyParams) String() string { return proto.CompactTextString(m) }
+func (*TallyParams) ProtoMessage()    {}
+func (*TallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{18}
+}
+func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *TallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyParams.Merge(m, src)
+}
+func (m *TallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyParams.DiscardUnknown(m)
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+
+func (m *TallyParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TallyParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
-		i--
-		dAtA[i] = 0x12
+func (m *TallyParams) GetConstitutionAmendmentQuorum() string {
+	if m != nil {
+		return m.ConstitutionAmendmentQuorum
 	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TallyParams) GetConstitutionAmendmentThreshold() string {
+	if m != nil {
+		return m.ConstitutionAmendmentThreshold
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TallyParams) GetLawQuorum() string {
+	if m != nil {
+		return m.LawQuorum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TallyParams) GetLawThreshold() string {
+	if m != nil {
+		return m.LawThreshold
+	}
+	return ""
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+// Params defines the parameters for the x/gov module.
+//
+// Since: cosmos-sdk 0.47
+type Params struct {
+	// Minimum deposit for a proposal to enter voting period.
+	//
+	// Deprecated: the minimum deposit is now dynamically computed, see
+	// min_deposit_throttler and the Query/MinDeposit endpoint.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"` // Deprecated: Do not use.
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid. Default value: 0.25.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 2/3.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	// Deprecated: the minimum initial deposit is now dynamically computed, see
+	// min_initial_deposit_throttler and the Query/MinInitialDeposit endpoint.
+	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"` // Deprecated: Do not use.
+	// burn deposits if a proposal does not meet quorum
+	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
+	// burn deposits if the proposal does not enter voting period
+	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
+	// The ratio representing the proportion of the deposit value minimum that
+	// must be met when making a deposit. Default value: 0.01. Meaning that for a
+	// chain with a min_deposit of 100stake, a deposit of 1stake would be
+	// required.
+	//
+	// Since: cosmos-sdk 0.50
+	// NOTE: backported from v50 (https://github.com/cosmos/cosmos-sdk/pull/18146)
+	MinDepositRatio string `protobuf:"bytes,15,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// quorum for constitution amendment proposals
+	ConstitutionAmendmentQuorum string `protobuf:"bytes,16,opt,name=constitution_amendment_quorum,json=constitutionAmendmentQuorum,proto3" json:"constitution_amendment_quorum,omitempty"`
+	// Minimum proportion of Yes votes for a Constitution Amendment proposal to pass. Default value: 0.9.
+	ConstitutionAmendmentThreshold string `protobuf:"bytes,17,opt,name=constitution_amendment_threshold,json=constitutionAmendmentThreshold,proto3" json:"constitution_amendment_threshold,omitempty"`
+	// quorum for law proposals
+	LawQuorum string `protobuf:"bytes,18,opt,name=law_quorum,json=lawQuorum,proto3" json:"law_quorum,omitempty"`
+	// Minimum proportion of Yes votes for a Law proposal to pass. Default value: 0.9.
+	LawThreshold string `protobuf:"bytes,19,opt,name=law_threshold,json=lawThreshold,proto3" json:"law_threshold,omitempty"`
+	// Duration of time after a proposal enters the voting period, during which quorum
+	// must be achieved to not incur in a voting period extension.
+	QuorumTimeout *time.Duration `protobuf:"bytes,20,opt,name=quorum_timeout,json=quorumTimeout,proto3,stdduration" json:"quorum_timeout,omitempty"`
+	// Duration that expresses the maximum amount of time by which a proposal voting period
+	// can be extended.
+	MaxVotingPeriodExtension *time.Duration `protobuf:"bytes,21,opt,name=max_voting_period_extension,json=maxVotingPeriodExtension,proto3,stdduration" json:"max_voting_period_extension,omitempty"`
+	// Number of times a proposal should be checked for quorum after the quorum timeout
+	// has elapsed. Used to compute the amount of time in between quorum checks.
+	QuorumCheckCount uint64 `protobuf:"varint,22,opt,name=quorum_check_count,json=quorumCheckCount,proto3" json:"quorum_check_count,omitempty"`
+	// Parameters of the dynamic minimum deposit required for a proposal to
+	// enter the voting period.
+	MinDepositThrottler *MinDepositThrottler `protobuf:"bytes,23,opt,name=min_deposit_throttler,json=minDepositThrottler,proto3" json:"min_deposit_throttler,omitempty"`
+	// Parameters of the dynamic minimum initial deposit required at proposal
+	// submission.
+	MinInitialDepositThrottler *MinInitialDepositThrottler `protobuf:"bytes,24,opt,name=min_initial_deposit_throttler,json=minInitialDepositThrottler,proto3" json:"min_initial_deposit_throttler,omitempty"`
+	// Minimum amount of bonded tokens a governor must have self-delegated for
+	// its status to be active and for its voting power to be used in tallies.
+	MinGovernorSelfDelegation string `protobuf:"bytes,25,opt,name=min_governor_self_delegation,json=minGovernorSelfDelegation,proto3" json:"min_governor_self_delegation,omitempty"`
+	// Minimum duration that must elapse between two status changes of a
+	// governor.
+	GovernorStatusChangePeriod *time.Duration `protobuf:"bytes,26,opt,name=governor_status_change_period,json=governorStatusChangePeriod,proto3,stdduration" json:"governor_status_change_period,omitempty"`
+	// Defines if the quorums are derived from the participation exponential
+	// moving averages, within the quorum ranges below, instead of using the
+	// fixed quorum, constitution_amendment_quorum and law_quorum.
+	DynamicQuorum bool `protobuf:"varint,27,opt,name=dynamic_quorum,json=dynamicQuorum,proto3" json:"dynamic_quorum,omitempty"`
+	// Range of the dynamic quorum for regular proposals.
+	QuorumRange *QuorumRange `protobuf:"bytes,28,opt,name=quorum_range,json=quorumRange,proto3" json:"quorum_range,omitempty"`
+	// Range of the dynamic quorum for constitution amendment proposals.
+	ConstitutionAmendmentQuorumRange *QuorumRange `protobuf:"bytes,29,opt,name=constitution_amendment_quorum_range,json=constitutionAmendmentQuorumRange,proto3" json:"constitution_amendment_quorum_range,omitempty"`
+	// Range of the dynamic quorum for law proposals.
+	LawQuorumRange *QuorumRange `protobuf:"bytes,30,opt,name=law_quorum_range,json=lawQuorumRange,proto3" json:"law_quorum_range,omitempty"`
+	// Defines if the votes are kept, along with the voting power they used, as
+	// final votes once a proposal is tallied.
+	PersistFinalVotes bool `protobuf:"varint,31,opt,name=persist_final_votes,json=persistFinalVotes,proto3" json:"persist_final_votes,omitempty"`
+	// Duration after which the final votes of a proposal are pruned.
+	FinalVotesRetentionPeriod *time.Duration `protobuf:"bytes,32,opt,name=final_votes_retention_period,json=finalVotesRetentionPeriod,proto3,stdduration" json:"final_votes_retention_period,omitempty"`
+	// The ratio of the deposits that is burned when a proposal is canceled by
+	// its proposer, the remaining deposits are refunded to the depositors.
+	ProposalCancelRatio string `protobuf:"bytes,33,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	// Duration of the voting period of an expedited proposal. Since expedited
+	// proposals are limited to the messages of expedited_allowed_msg_type_urls,
+	// it can be shorter than the minimum voting period.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,34,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	// Minimum proportion of Yes votes for an expedited proposal to pass.
+	ExpeditedThreshold string `protobuf:"bytes,35,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	// Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,36,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	// Type URLs of the messages an expedited proposal can contain.
+	ExpeditedAllowedMsgTypeUrls []string `protobuf:"bytes,37,rep,name=expedited_allowed_msg_type_urls,json=expeditedAllowedMsgTypeUrls,proto3" json:"expedited_allowed_msg_type_urls,omitempty"`
+	// Quorum and threshold overrides for the proposals containing messages of
+	// specific types. They can only be greater than or equal to the base quorum
+	// and threshold.
+	MessageTallyParams []MessageTallyParams `protobuf:"bytes,38,rep,name=message_tally_params,json=messageTallyParams,proto3" json:"message_tally_params"`
+	// Defines if every vote cast or changed on a proposal is recorded in the
+	// vote history of the proposal. The vote history is pruned along with the
+	// final votes.
+	RecordVoteHistory bool `protobuf:"varint,39,opt,name=record_vote_history,json=recordVoteHistory,proto3" json:"record_vote_history,omitempty"`
+	// Maximum number of times a voter can change its vote on a proposal. Zero
+	// means unlimited.
+	MaxVoteChanges uint64 `protobuf:"varint,40,opt,name=max_vote_changes,json=maxVoteChanges,proto3" json:"max_vote_changes,omitempty"`
+	// Minimum amount of tokens an account must have staked to vote. Zero
+	// disables the check.
+	MinVoteStakedTokens string `protobuf:"bytes,41,opt,name=min_vote_staked_tokens,json=minVoteStakedTokens,proto3" json:"min_vote_staked_tokens,omitempty"`
+	// Maximum number of delegations of an account checked when looking for its
+	// minimum staked tokens.
+	MaxDelegationsChecked uint64 `protobuf:"varint,42,opt,name=max_delegations_checked,json=maxDelegationsChecked,proto3" json:"max_delegations_checked,omitempty"`
+	// Minimum amount of tokens an account must have staked to deposit on a
+	// proposal. Zero disables the check.
+	MinDepositStakedTokens string `protobuf:"bytes,43,opt,name=min_deposit_staked_tokens,json=minDepositStakedTokens,proto3" json:"min_deposit_staked_tokens,omitempty"`
+	// Duration between the end of the voting period of a passed proposal and
+	// the execution of its messages. Zero executes the messages as soon as the
+	// proposal passes.
+	ExecutionDelay *time.Duration `protobuf:"bytes,44,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay,omitempty"`
+	// Execution delay overrides for the proposals containing messages of
+	// specific types. A proposal is executed after the longest execution delay
+	// of its messages.
+	MessageExecutionDelays []MessageExecutionDelay `protobuf:"bytes,45,rep,name=message_execution_delays,json=messageExecutionDelays,proto3" json:"message_execution_delays"`
+	// Maximum amount of gas the execution of the messages of a passed proposal
+	// can consume. A proposal whose execution runs out of gas fails.
+	MaxProposalExecutionGas uint64 `protobuf:"varint,46,opt,name=max_proposal_execution_gas,json=maxProposalExecutionGas,proto3" json:"max_proposal_execution_gas,omitempty"`
+	// Maximum number of proposals processed per block in each of the EndBlocker
+	// queues: deleted at the end of their deposit period, checked for quorum,
+	// tallied at the end of their voting period or executed. The due proposals
+	// beyond this limit are processed in the following blocks.
+	MaxEndBlockerProposals uint64 `protobuf:"varint,47,opt,name=max_end_blocker_proposals,json=maxEndBlockerProposals,proto3" json:"max_end_blocker_proposals,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{19}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *QuorumCheckQueueEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *Params) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuorumCheckQueueEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
+	}
+	return nil
 }
 
-func (m *QuorumCheckQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QuorumChecksDone != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.QuorumChecksDone))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.QuorumCheckCount != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.QuorumTimeoutTime != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuorumTimeoutTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0xa
+func (m *Params) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDepositPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x12
+// Deprecated: Do not use.
+func (m *Params) GetMinInitialDepositRatio() string {
+	if m != nil {
+		return m.MinInitialDepositRatio
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *Params) GetBurnVoteQuorum() bool {
+	if m != nil {
+		return m.BurnVoteQuorum
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *VotingParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetBurnProposalDepositPrevote() bool {
+	if m != nil {
+		return m.BurnProposalDepositPrevote
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMinDepositRatio() string {
+	if m != nil {
+		return m.MinDepositRatio
+	}
+	return ""
 }
 
-func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0xa
+func (m *Params) GetConstitutionAmendmentQuorum() string {
+	if m != nil {
+		return m.ConstitutionAmendmentQuorum
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetConstitutionAmendmentThreshold() string {
+	if m != nil {
+		return m.ConstitutionAmendmentThreshold
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetLawQuorum() string {
+	if m != nil {
+		return m.LawQuorum
+	}
+	return ""
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LawThreshold) > 0 {
-		i -= len(m.LawThreshold)
-		copy(dAtA[i:], m.LawThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LawQuorum) > 0 {
-		i -= len(m.LawQuorum)
-		copy(dAtA[i:], m.LawQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
-		i--
-		dAtA[i] = 0x2a
+func (m *Params) GetLawThreshold() string {
+	if m != nil {
+		return m.LawThreshold
 	}
-	if len(m.ConstitutionAmendmentThreshold) > 0 {
-		i -= len(m.ConstitutionAmendmentThreshold)
-		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Params) GetQuorumTimeout() *time.Duration {
+	if m != nil {
+		return m.QuorumTimeout
 	}
-	if len(m.ConstitutionAmendmentQuorum) > 0 {
-		i -= len(m.ConstitutionAmendmentQuorum)
-		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *Params) GetMaxVotingPeriodExtension() *time.Duration {
+	if m != nil {
+		return m.MaxVotingPeriodExtension
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Params) GetQuorumCheckCount() uint64 {
+	if m != nil {
+		return m.QuorumCheckCount
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetMinDepositThrottler() *MinDepositThrottler {
+	if m != nil {
+		return m.MinDepositThrottler
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMinInitialDepositThrottler() *MinInitialDepositThrottler {
+	if m != nil {
+		return m.MinInitialDepositThrottler
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMinGovernorSelfDelegation() string {
+	if m != nil {
+		return m.MinGovernorSelfDelegation
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QuorumCheckCount != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+func (m *Params) GetGovernorStatusChangePeriod() *time.Duration {
+	if m != nil {
+		return m.GovernorStatusChangePeriod
 	}
-	if m.MaxVotingPeriodExtension != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriodExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return nil
+}
+
+func (m *Params) GetDynamicQuorum() bool {
+	if m != nil {
+		return m.DynamicQuorum
 	}
-	if m.QuorumTimeout != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.QuorumTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return false
+}
+
+func (m *Params) GetQuorumRange() *QuorumRange {
+	if m != nil {
+		return m.QuorumRange
 	}
-	if len(m.LawThreshold) > 0 {
-		i -= len(m.LawThreshold)
-		copy(dAtA[i:], m.LawThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	return nil
+}
+
+func (m *Params) GetConstitutionAmendmentQuorumRange() *QuorumRange {
+	if m != nil {
+		return m.ConstitutionAmendmentQuorumRange
 	}
-	if len(m.LawQuorum) > 0 {
-		i -= len(m.LawQuorum)
-		copy(dAtA[i:], m.LawQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	return nil
+}
+
+func (m *Params) GetLawQuorumRange() *QuorumRange {
+	if m != nil {
+		return m.LawQuorumRange
 	}
-	if len(m.ConstitutionAmendmentThreshold) > 0 {
-		i -= len(m.ConstitutionAmendmentThreshold)
-		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return nil
+}
+
+func (m *Params) GetPersistFinalVotes() bool {
+	if m != nil {
+		return m.PersistFinalVotes
 	}
-	if len(m.ConstitutionAmendmentQuorum) > 0 {
-		i -= len(m.ConstitutionAmendmentQuorum)
-		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return false
+}
+
+func (m *Params) GetFinalVotesRetentionPeriod() *time.Duration {
+	if m != nil {
+		return m.FinalVotesRetentionPeriod
 	}
-	if len(m.MinDepositRatio) > 0 {
-		i -= len(m.MinDepositRatio)
-		copy(dAtA[i:], m.MinDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
-		i--
-		dAtA[i] = 0x7a
+	return nil
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
 	}
-	if m.BurnProposalDepositPrevote {
-		i--
-		if m.BurnProposalDepositPrevote {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
 	}
-	if m.BurnVoteQuorum {
-		i--
-		if m.BurnVoteQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	return nil
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
 	}
-	if len(m.MinInitialDepositRatio) > 0 {
-		i -= len(m.MinInitialDepositRatio)
-		copy(dAtA[i:], m.MinInitialDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *Params) GetExpeditedAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.ExpeditedAllowedMsgTypeUrls
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *Params) GetMessageTallyParams() []MessageTallyParams {
+	if m != nil {
+		return m.MessageTallyParams
 	}
-	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
-		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *Params) GetRecordVoteHistory() bool {
+	if m != nil {
+		return m.RecordVoteHistory
 	}
-	if m.MaxDepositPeriod != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err12 != nil {
-			return 0, err12
-		}
-		i -= n12
-		i = encodeVarintGov(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *Params) GetMaxVoteChanges() uint64 {
+	if m != nil {
+		return m.MaxVoteChanges
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetMinVoteStakedTokens() string {
+	if m != nil {
+		return m.MinVoteStakedTokens
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetMaxDelegationsChecked() uint64 {
+	if m != nil {
+		return m.MaxDelegationsChecked
 	}
-	var l int
-	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
+	return 0
+}
+
+func (m *Params) GetMinDepositStakedTokens() string {
+	if m != nil {
+		return m.MinDepositStakedTokens
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *Params) GetExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
 	}
-	return n
+	return nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetMessageExecutionDelays() []MessageExecutionDelay {
+	if m != nil {
+		return m.MessageExecutionDelays
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	return nil
+}
+
+func (m *Params) GetMaxProposalExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxProposalExecutionGas
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+func (m *Params) GetMaxEndBlockerProposals() uint64 {
+	if m != nil {
+		return m.MaxEndBlockerProposals
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+// MessageExecutionDelay defines the execution delay of a proposal containing
+// a message of a given type.
+type MessageExecutionDelay struct {
+	// Type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Duration between the end of the voting period and the execution of the
+	// proposal.
+	Delay time.Duration `protobuf:"bytes,2,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *MessageExecutionDelay) Reset()         { *m = MessageExecutionDelay{} }
+func (m *MessageExecutionDelay) String() string { return proto.CompactTextString(m) }
+func (*MessageExecutionDelay) ProtoMessage()    {}
+func (*MessageExecutionDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{20}
+}
+func (m *MessageExecutionDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageExecutionDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageExecutionDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MessageExecutionDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageExecutionDelay.Merge(m, src)
+}
+func (m *MessageExecutionDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageExecutionDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageExecutionDelay.DiscardUnknown(m)
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MessageExecutionDelay proto.InternalMessageInfo
+
+func (m *MessageExecutionDelay) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	return ""
+}
+
+func (m *MessageExecutionDelay) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+// MessageTallyParams defines the quorum and threshold required for a proposal
+// containing a message of a given type to pass.
+type MessageTallyParams struct {
+	// Type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for the proposal to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *MessageTallyParams) Reset()         { *m = MessageTallyParams{} }
+func (m *MessageTallyParams) String() string { return proto.CompactTextString(m) }
+func (*MessageTallyParams) ProtoMessage()    {}
+func (*MessageTallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{21}
+}
+func (m *MessageTallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageTallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageTallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
-	}
-	if m.FinalTallyResult != nil {
-		l = m.FinalTallyResult.Size()
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *MessageTallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageTallyParams.Merge(m, src)
+}
+func (m *MessageTallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageTallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageTallyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageTallyParams proto.InternalMessageInfo
+
+func (m *MessageTallyParams) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *MessageTallyParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	if m.DepositEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *MessageTallyParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	if len(m.TotalDeposit) > 0 {
-		for _, e := range m.TotalDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+// QuorumRange defines the bounds of a dynamic quorum. The quorum is computed
+// as min + (max - min) * participation_ema.
+type QuorumRange struct {
+	// Minimum value of the quorum.
+	Min string `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Maximum value of the quorum.
+	Max string `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *QuorumRange) Reset()         { *m = QuorumRange{} }
+func (m *QuorumRange) String() string { return proto.CompactTextString(m) }
+func (*QuorumRange) ProtoMessage()    {}
+func (*QuorumRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{22}
+}
+func (m *QuorumRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.VotingStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.VotingEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *QuorumRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumRange.Merge(m, src)
+}
+func (m *QuorumRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumRange proto.InternalMessageInfo
+
+func (m *QuorumRange) GetMin() string {
+	if m != nil {
+		return m.Min
 	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *QuorumRange) GetMax() string {
+	if m != nil {
+		return m.Max
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+// MinDepositThrottler defines the parameters of the dynamic minimum deposit
+// required for a proposal to enter the voting period, as described in ADR-003.
+type MinDepositThrottler struct {
+	// Floor value for the minimum deposit required for a proposal to enter the
+	// voting period.
+	FloorValue []types.Coin `protobuf:"bytes,1,rep,name=floor_value,json=floorValue,proto3" json:"floor_value"`
+	// Duration that dictates after how long the dynamic minimum deposit should
+	// be recalculated for time-based updates.
+	UpdatePeriod *time.Duration `protobuf:"bytes,2,opt,name=update_period,json=updatePeriod,proto3,stdduration" json:"update_period,omitempty"`
+	// The number of active proposals the dynamic minimum deposit should target.
+	TargetActiveProposals uint64 `protobuf:"varint,3,opt,name=target_active_proposals,json=targetActiveProposals,proto3" json:"target_active_proposals,omitempty"`
+	// The ratio of increase for the minimum deposit when the number of active
+	// proposals exceeds the target by 1.
+	IncreaseRatio string `protobuf:"bytes,4,opt,name=increase_ratio,json=increaseRatio,proto3" json:"increase_ratio,omitempty"`
+	// The ratio of decrease for the minimum deposit when the number of active
+	// proposals is 1 less than the target.
+	DecreaseRatio string `protobuf:"bytes,5,opt,name=decrease_ratio,json=decreaseRatio,proto3" json:"decrease_ratio,omitempty"`
+	// A positive integer representing the sensitivity of the dynamic minimum
+	// deposit increase/decrease to the distance from the target number of active
+	// proposals. The higher the number, the lower the sensitivity. A value of 1
+	// represents the highest sensitivity.
+	SensitivityTargetDistance uint64 `protobuf:"varint,6,opt,name=sensitivity_target_distance,json=sensitivityTargetDistance,proto3" json:"sensitivity_target_distance,omitempty"`
+}
+
+func (m *MinDepositThrottler) Reset()         { *m = MinDepositThrottler{} }
+func (m *MinDepositThrottler) String() string { return proto.CompactTextString(m) }
+func (*MinDepositThrottler) ProtoMessage()    {}
+func (*MinDepositThrottler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{23}
+}
+func (m *MinDepositThrottler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinDepositThrottler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinDepositThrottler.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MinDepositThrottler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinDepositThrottler.Merge(m, src)
+}
+func (m *MinDepositThrottler) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinDepositThrottler) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinDepositThrottler.DiscardUnknown(m)
 }
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MinDepositThrottler proto.InternalMessageInfo
+
+func (m *MinDepositThrottler) GetFloorValue() []types.Coin {
+	if m != nil {
+		return m.FloorValue
 	}
-	var l int
-	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *MinDepositThrottler) GetUpdatePeriod() *time.Duration {
+	if m != nil {
+		return m.UpdatePeriod
 	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *MinDepositThrottler) GetTargetActiveProposals() uint64 {
+	if m != nil {
+		return m.TargetActiveProposals
 	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+func (m *MinDepositThrottler) GetIncreaseRatio() string {
+	if m != nil {
+		return m.IncreaseRatio
 	}
-	return n
+	return ""
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+func (m *MinDepositThrottler) GetDecreaseRatio() string {
+	if m != nil {
+		return m.DecreaseRatio
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *MinDepositThrottler) GetSensitivityTargetDistance() uint64 {
+	if m != nil {
+		return m.SensitivityTargetDistance
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+// MinInitialDepositThrottler defines the parameters of the dynamic minimum
+// initial deposit required at proposal submission, as described in ADR-003.
+type MinInitialDepositThrottler struct {
+	// Floor value for the minimum initial deposit required at proposal
+	// submission.
+	FloorValue []types.Coin `protobuf:"bytes,1,rep,name=floor_value,json=floorValue,proto3" json:"floor_value"`
+	// Duration that dictates after how long the dynamic minimum initial deposit
+	// should be recalculated for time-based updates.
+	UpdatePeriod *time.Duration `protobuf:"bytes,2,opt,name=update_period,json=updatePeriod,proto3,stdduration" json:"update_period,omitempty"`
+	// The number of proposals in deposit period the dynamic minimum initial
+	// deposit should target.
+	TargetProposals uint64 `protobuf:"varint,3,opt,name=target_proposals,json=targetProposals,proto3" json:"target_proposals,omitempty"`
+	// The ratio of increase for the minimum initial deposit when the number of
+	// proposals in deposit period exceeds the target by 1.
+	IncreaseRatio string `protobuf:"bytes,4,opt,name=increase_ratio,json=increaseRatio,proto3" json:"increase_ratio,omitempty"`
+	// The ratio of decrease for the minimum initial deposit when the number of
+	// proposals in deposit period is 1 less than the target.
+	DecreaseRatio string `protobuf:"bytes,5,opt,name=decrease_ratio,json=decreaseRatio,proto3" json:"decrease_ratio,omitempty"`
+	// A positive integer representing the sensitivity of the dynamic minimum
+	// initial deposit increase/decrease to the distance from the target number
+	// of proposals in deposit period. The higher the number, the lower the
+	// sensitivity. A value of 1 represents the highest sensitivity.
+	SensitivityTargetDistance uint64 `protobuf:"varint,6,opt,name=sensitivity_target_distance,json=sensitivityTargetDistance,proto3" json:"sensitivity_target_distance,omitempty"`
+}
+
+func (m *MinInitialDepositThrottler) Reset()         { *m = MinInitialDepositThrottler{} }
+func (m *MinInitialDepositThrottler) String() string { return proto.CompactTextString(m) }
+func (*MinInitialDepositThrottler) ProtoMessage()    {}
+func (*MinInitialDepositThrottler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{24}
+}
+func (m *MinInitialDepositThrottler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinInitialDepositThrottler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinInitialDepositThrottler.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *MinInitialDepositThrottler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinInitialDepositThrottler.Merge(m, src)
+}
+func (m *MinInitialDepositThrottler) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinInitialDepositThrottler) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinInitialDepositThrottler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinInitialDepositThrottler proto.InternalMessageInfo
+
+func (m *MinInitialDepositThrottler) GetFloorValue() []types.Coin {
+	if m != nil {
+		return m.FloorValue
 	}
-	return n
+	return nil
 }
 
-func (m *QuorumCheckQueueEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinInitialDepositThrottler) GetUpdatePeriod() *time.Duration {
+	if m != nil {
+		return m.UpdatePeriod
 	}
-	var l int
-	_ = l
-	if m.QuorumTimeoutTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime)
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *MinInitialDepositThrottler) GetTargetProposals() uint64 {
+	if m != nil {
+		return m.TargetProposals
 	}
-	if m.QuorumCheckCount != 0 {
-		n += 1 + sovGov(uint64(m.QuorumCheckCount))
+	return 0
+}
+
+func (m *MinInitialDepositThrottler) GetIncreaseRatio() string {
+	if m != nil {
+		return m.IncreaseRatio
 	}
-	if m.QuorumChecksDone != 0 {
-		n += 1 + sovGov(uint64(m.QuorumChecksDone))
+	return ""
+}
+
+func (m *MinInitialDepositThrottler) GetDecreaseRatio() string {
+	if m != nil {
+		return m.DecreaseRatio
 	}
-	return n
+	return ""
 }
 
-func (m *DepositParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinInitialDepositThrottler) GetSensitivityTargetDistance() uint64 {
+	if m != nil {
+		return m.SensitivityTargetDistance
 	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+// LastMinDeposit is a record of the last time the minimum deposit was
+// updated in the store, both its value and a timestamp.
+type LastMinDeposit struct {
+	// value is the value of the minimum deposit at the time of the last update.
+	Value []types.Coin `protobuf:"bytes,1,rep,name=value,proto3" json:"value"`
+	// time is the time of the last update.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *LastMinDeposit) Reset()         { *m = LastMinDeposit{} }
+func (m *LastMinDeposit) String() string { return proto.CompactTextString(m) }
+func (*LastMinDeposit) ProtoMessage()    {}
+func (*LastMinDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{25}
+}
+func (m *LastMinDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastMinDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastMinDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *LastMinDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastMinDeposit.Merge(m, src)
+}
+func (m *LastMinDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastMinDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastMinDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastMinDeposit proto.InternalMessageInfo
+
+func (m *LastMinDeposit) GetValue() []types.Coin {
+	if m != nil {
+		return m.Value
 	}
-	return n
+	return nil
 }
 
-func (m *VotingParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LastMinDeposit) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
 	}
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+// Governor defines an account that delegators can delegate their governance
+// voting power to. A governor's vote is inherited by its delegators, unless
+// they vote themselves.
+type Governor struct {
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,1,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+	// status is the status of the governor.
+	Status GovernorStatus `protobuf:"varint,2,opt,name=status,proto3,enum=atomone.gov.v1.GovernorStatus" json:"status,omitempty"`
+	// description defines the description terms for the governor.
+	Description GovernorDescription `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	// last_status_change_time is the time of the last status change of the
+	// governor.
+	LastStatusChangeTime *time.Time `protobuf:"bytes,4,opt,name=last_status_change_time,json=lastStatusChangeTime,proto3,stdtime" json:"last_status_change_time,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{26}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
 }
 
-func (m *TallyParams) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
 	}
-	var l int
-	_ = l
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *Governor) GetStatus() GovernorStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return GovernorStatus_GOVERNOR_STATUS_UNSPECIFIED
+}
+
+func (m *Governor) GetDescription() GovernorDescription {
+	if m != nil {
+		return m.Description
 	}
-	l = len(m.ConstitutionAmendmentQuorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return GovernorDescription{}
+}
+
+func (m *Governor) GetLastStatusChangeTime() *time.Time {
+	if m != nil {
+		return m.LastStatusChangeTime
 	}
-	l = len(m.ConstitutionAmendmentThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+// GovernorDescription defines a governor description.
+type GovernorDescription struct {
+	// moniker defines a human-readable name for the governor.
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// identity defines an optional identity signature (ex. UPort or Keybase).
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// website defines an optional website link.
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// security_contact defines an optional email for security contact.
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+	// details define other optional details.
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *GovernorDescription) Reset()         { *m = GovernorDescription{} }
+func (m *GovernorDescription) String() string { return proto.CompactTextString(m) }
+func (*GovernorDescription) ProtoMessage()    {}
+func (*GovernorDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{27}
+}
+func (m *GovernorDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.LawQuorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *GovernorDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorDescription.Merge(m, src)
+}
+func (m *GovernorDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorDescription proto.InternalMessageInfo
+
+func (m *GovernorDescription) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	l = len(m.LawThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *GovernorDescription) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return n
+	return ""
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GovernorDescription) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	return ""
+}
+
+func (m *GovernorDescription) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *GovernorDescription) GetDetails() string {
+	if m != nil {
+		return m.Details
 	}
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+// GovernanceDelegation defines a delegation of governance voting power from a
+// delegator to a governor.
+type GovernanceDelegation struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{28}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.MinInitialDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
 	}
-	if m.BurnVoteQuorum {
-		n += 2
+	return ""
+}
+
+// GovernorValShares holds the number of virtual shares of a validator that
+// are delegated to a governor through governance delegations.
+type GovernorValShares struct {
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,1,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares defines the number of validator shares delegated to the governor.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *GovernorValShares) Reset()         { *m = GovernorValShares{} }
+func (m *GovernorValShares) String() string { return proto.CompactTextString(m) }
+func (*GovernorValShares) ProtoMessage()    {}
+func (*GovernorValShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{29}
+}
+func (m *GovernorValShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorValShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorValShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BurnProposalDepositPrevote {
-		n += 2
+}
+func (m *GovernorValShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorValShares.Merge(m, src)
+}
+func (m *GovernorValShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorValShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorValShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorValShares proto.InternalMessageInfo
+
+func (m *GovernorValShares) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
 	}
-	l = len(m.MinDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *GovernorValShares) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	l = len(m.ConstitutionAmendmentQuorum)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("atomone.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("atomone.gov.v1.ProposalExecutionPolicy", ProposalExecutionPolicy_name, ProposalExecutionPolicy_value)
+	proto.RegisterEnum("atomone.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("atomone.gov.v1.ArticleOperationType", ArticleOperationType_name, ArticleOperationType_value)
+	proto.RegisterEnum("atomone.gov.v1.GovernorStatus", GovernorStatus_name, GovernorStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "atomone.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*Deposit)(nil), "atomone.gov.v1.Deposit")
+	proto.RegisterType((*Proposal)(nil), "atomone.gov.v1.Proposal")
+	proto.RegisterType((*ProposalMsgResult)(nil), "atomone.gov.v1.ProposalMsgResult")
+	proto.RegisterType((*TallyResult)(nil), "atomone.gov.v1.TallyResult")
+	proto.RegisterType((*TallyProjection)(nil), "atomone.gov.v1.TallyProjection")
+	proto.RegisterType((*Vote)(nil), "atomone.gov.v1.Vote")
+	proto.RegisterType((*VoteHistoryEntry)(nil), "atomone.gov.v1.VoteHistoryEntry")
+	proto.RegisterType((*FinalVote)(nil), "atomone.gov.v1.FinalVote")
+	proto.RegisterType((*Law)(nil), "atomone.gov.v1.Law")
+	proto.RegisterType((*ConstitutionVersion)(nil), "atomone.gov.v1.ConstitutionVersion")
+	proto.RegisterType((*ConstitutionArticle)(nil), "atomone.gov.v1.ConstitutionArticle")
+	proto.RegisterType((*StructuredConstitution)(nil), "atomone.gov.v1.StructuredConstitution")
+	proto.RegisterType((*ArticleOperation)(nil), "atomone.gov.v1.ArticleOperation")
+	proto.RegisterType((*FundingStream)(nil), "atomone.gov.v1.FundingStream")
+	proto.RegisterType((*QuorumCheckQueueEntry)(nil), "atomone.gov.v1.QuorumCheckQueueEntry")
+	proto.RegisterType((*DepositParams)(nil), "atomone.gov.v1.DepositParams")
+	proto.RegisterType((*VotingParams)(nil), "atomone.gov.v1.VotingParams")
+	proto.RegisterType((*TallyParams)(nil), "atomone.gov.v1.TallyParams")
+	proto.RegisterType((*Params)(nil), "atomone.gov.v1.Params")
+	proto.RegisterType((*MessageExecutionDelay)(nil), "atomone.gov.v1.MessageExecutionDelay")
+	proto.RegisterType((*MessageTallyParams)(nil), "atomone.gov.v1.MessageTallyParams")
+	proto.RegisterType((*QuorumRange)(nil), "atomone.gov.v1.QuorumRange")
+	proto.RegisterType((*MinDepositThrottler)(nil), "atomone.gov.v1.MinDepositThrottler")
+	proto.RegisterType((*MinInitialDepositThrottler)(nil), "atomone.gov.v1.MinInitialDepositThrottler")
+	proto.RegisterType((*LastMinDeposit)(nil), "atomone.gov.v1.LastMinDeposit")
+	proto.RegisterType((*Governor)(nil), "atomone.gov.v1.Governor")
+	proto.RegisterType((*GovernorDescription)(nil), "atomone.gov.v1.GovernorDescription")
+	proto.RegisterType((*GovernanceDelegation)(nil), "atomone.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*GovernorValShares)(nil), "atomone.gov.v1.GovernorValShares")
+}
+
+func init() { proto.RegisterFile("atomone/gov/v1/gov.proto", fileDescriptor_ecf0f9950ff6986c) }
+
+var fileDescriptor_ecf0f9950ff6986c = []byte{
+	// 3599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4b, 0x6c, 0x23, 0x47,
+	0x7a, 0x9e, 0x26, 0x39, 0x7a, 0xfc, 0x7a, 0x51, 0xa5, 0x19, 0x4d, 0x8b, 0x9a, 0x91, 0x64, 0xda,
+	0xb3, 0x2b, 0xcf, 0xce, 0x50, 0x19, 0xdb, 0x3b, 0xd8, 0x87, 0xd7, 0x1b, 0x8a, 0xec, 0xd1, 0xd0,
+	0xd1, 0x88, 0x74, 0x93, 0x23, 0x7b, 0xf6, 0x90, 0x4e, 0xa9, 0xbb, 0x44, 0xf5, 0x0e, 0xbb, 0x9b,
+	0xee, 0x2a, 0x6a, 0xc4, 0x6b, 0x4e, 0x0b, 0x23, 0x07, 0x1f, 0x83, 0x00, 0x06, 0x82, 0x24, 0x40,
+	0x82, 0x9c, 0x82, 0x60, 0x91, 0x7b, 0x0e, 0x01, 0xf6, 0x12, 0x64, 0xb3, 0x40, 0x80, 0x64, 0x0f,
+	0x4e, 0x60, 0x1f, 0x12, 0x18, 0xb9, 0xe6, 0x96, 0x43, 0x50, 0x8f, 0x7e, 0x90, 0x6a, 0x8d, 0xa8,
+	0x85, 0x1d, 0x24, 0x97, 0x19, 0x55, 0xfd, 0xdf, 0xff, 0xd7, 0x5f, 0xf5, 0x3f, 0xab, 0x9a, 0xa0,
+	0x63, 0x16, 0x78, 0x81, 0x4f, 0x76, 0xba, 0xc1, 0xe9, 0xce, 0xe9, 0x43, 0xfe, 0x5f, 0xa5, 0x1f,
+	0x06, 0x2c, 0x40, 0x8b, 0x8a, 0x52, 0xe1, 0x53, 0xa7, 0x0f, 0x4b, 0x1b, 0x76, 0x40, 0xbd, 0x80,
+	0xee, 0x1c, 0x61, 0x4a, 0x76, 0x4e, 0x1f, 0x1e, 0x11, 0x86, 0x1f, 0xee, 0xd8, 0x81, 0xeb, 0x4b,
+	0x7c, 0xe9, 0x46, 0x37, 0xe8, 0x06, 0xe2, 0xcf, 0x1d, 0xfe, 0x97, 0x9a, 0x5d, 0x67, 0xc4, 0x77,
+	0x48, 0xe8, 0xb9, 0x3e, 0xdb, 0xc1, 0x47, 0xb6, 0xbb, 0xc3, 0x86, 0x7d, 0x42, 0x15, 0x71, 0xb3,
+	0x1b, 0x04, 0xdd, 0x1e, 0xd9, 0x11, 0xa3, 0xa3, 0xc1, 0xf1, 0x0e, 0x73, 0x3d, 0x42, 0x19, 0xf6,
+	0xfa, 0x0a, 0xb0, 0x36, 0x0e, 0xc0, 0xfe, 0x50, 0x91, 0x36, 0xc6, 0x49, 0xce, 0x20, 0xc4, 0xcc,
+	0x0d, 0x22, 0x75, 0xd6, 0xa4, 0xba, 0x96, 0xd4, 0x48, 0x0e, 0x14, 0x69, 0x19, 0x7b, 0xae, 0x1f,
+	0xec, 0x88, 0x7f, 0xe5, 0x54, 0xb9, 0x0f, 0xe8, 0x43, 0xe2, 0x76, 0x4f, 0x18, 0x71, 0x0e, 0x03,
+	0x46, 0x9a, 0x7d, 0x2e, 0x09, 0xbd, 0x05, 0x53, 0x81, 0xf8, 0x4b, 0xd7, 0xb6, 0xb4, 0xed, 0xc5,
+	0xb7, 0x4a, 0x95, 0xd1, 0x33, 0xa9, 0x24, 0x58, 0x53, 0x21, 0xd1, 0xb7, 0x60, 0xea, 0xa5, 0x90,
+	0xa4, 0xe7, 0xb6, 0xb4, 0xed, 0xd9, 0xdd, 0xc5, 0x5f, 0xfd, 0xfc, 0x01, 0xa8, 0xe5, 0xeb, 0xc4,
+	0x36, 0x15, 0xb5, 0xfc, 0xc7, 0x1a, 0x4c, 0xd7, 0x49, 0x3f, 0xa0, 0x2e, 0x43, 0x9b, 0x30, 0xd7,
+	0x0f, 0x83, 0x7e, 0x40, 0x71, 0xcf, 0x72, 0x1d, 0xb1, 0x58, 0xc1, 0x84, 0x68, 0xaa, 0xe1, 0xa0,
+	0x47, 0x30, 0xeb, 0x48, 0x6c, 0x10, 0x2a, 0xb9, 0xfa, 0xaf, 0x7e, 0xfe, 0xe0, 0x86, 0x92, 0x5b,
+	0x75, 0x9c, 0x90, 0x50, 0xda, 0x66, 0xa1, 0xeb, 0x77, 0xcd, 0x04, 0x8a, 0xde, 0x85, 0x29, 0xec,
+	0x05, 0x03, 0x9f, 0xe9, 0xf9, 0xad, 0xfc, 0xf6, 0xdc, 0x5b, 0x6b, 0x15, 0xc5, 0xc1, 0x8d, 0x58,
+	0x51, 0x46, 0xac, 0xd4, 0x02, 0xd7, 0xdf, 0x9d, 0xfd, 0xc5, 0xe7, 0x9b, 0xd7, 0xfe, 0xe2, 0xdf,
+	0xff, 0xea, 0x9e, 0x66, 0x2a, 0x9e, 0xf2, 0x9f, 0xcf, 0xc0, 0x4c, 0x4b, 0x29, 0x81, 0x16, 0x21,
+	0x17, 0xab, 0x96, 0x73, 0x1d, 0xf4, 0x5b, 0x30, 0xe3, 0x11, 0x4a, 0x71, 0x97, 0x50, 0x3d, 0x27,
+	0x84, 0xdf, 0xa8, 0x48, 0x93, 0x54, 0x22, 0x93, 0x54, 0xaa, 0xfe, 0xd0, 0x8c, 0x51, 0xe8, 0x11,
+	0x4c, 0x51, 0x86, 0xd9, 0x80, 0xea, 0x79, 0x71, 0x9a, 0x1b, 0xe3, 0xa7, 0x19, 0xad, 0xd5, 0x16,
+	0x28, 0x53, 0xa1, 0x51, 0x03, 0xd0, 0xb1, 0xeb, 0xe3, 0x9e, 0xc5, 0x70, 0xaf, 0x37, 0xb4, 0x42,
+	0x42, 0x07, 0x3d, 0xa6, 0x17, 0xb6, 0xb4, 0xed, 0xb9, 0xb7, 0xd6, 0xc7, 0x65, 0x74, 0x38, 0xc6,
+	0x14, 0x10, 0xb3, 0x28, 0xd8, 0x52, 0x33, 0xa8, 0x0a, 0x73, 0x74, 0x70, 0xe4, 0xb9, 0xcc, 0xe2,
+	0x9e, 0xa6, 0x5f, 0x17, 0x32, 0x4a, 0xe7, 0xf4, 0xee, 0x44, 0x6e, 0xb8, 0x5b, 0xf8, 0xf4, 0x5f,
+	0x37, 0x35, 0x13, 0x24, 0x13, 0x9f, 0x46, 0xef, 0x43, 0x51, 0x9d, 0xaf, 0x45, 0x7c, 0x47, 0xca,
+	0x99, 0x9a, 0x50, 0xce, 0xa2, 0xe2, 0x34, 0x7c, 0x47, 0xc8, 0x6a, 0xc0, 0x02, 0x0b, 0x18, 0xee,
+	0x59, 0x6a, 0x5e, 0x9f, 0xbe, 0x82, 0x95, 0xe6, 0x05, 0x6b, 0xe4, 0x42, 0xfb, 0xb0, 0x7c, 0x1a,
+	0x30, 0xd7, 0xef, 0x5a, 0x94, 0xe1, 0x50, 0xed, 0x6f, 0x66, 0x42, 0xbd, 0x96, 0x24, 0x6b, 0x9b,
+	0x73, 0x0a, 0xc5, 0x9e, 0x80, 0x9a, 0x4a, 0xf6, 0x38, 0x3b, 0xa1, 0xac, 0x05, 0xc9, 0x18, 0x6d,
+	0xb1, 0xc4, 0xdd, 0x84, 0x61, 0x07, 0x33, 0xac, 0x03, 0x77, 0x5c, 0x33, 0x1e, 0xa3, 0x1b, 0x70,
+	0x9d, 0xb9, 0xac, 0x47, 0xf4, 0x39, 0x41, 0x90, 0x03, 0xa4, 0xc3, 0x34, 0x1d, 0x78, 0x1e, 0x0e,
+	0x87, 0xfa, 0xbc, 0x98, 0x8f, 0x86, 0xe8, 0x1d, 0x98, 0x91, 0x31, 0x41, 0x42, 0x7d, 0xe1, 0x92,
+	0x20, 0x88, 0x91, 0xe8, 0x36, 0xcc, 0x92, 0xb3, 0x3e, 0x71, 0x5c, 0x46, 0x1c, 0x7d, 0x71, 0x4b,
+	0xdb, 0x9e, 0x31, 0x93, 0x09, 0xf4, 0x3d, 0xd0, 0xed, 0xc0, 0x3f, 0xee, 0xb9, 0xb6, 0xd8, 0x6e,
+	0x2a, 0x0c, 0xa9, 0xbe, 0xb4, 0x95, 0xdf, 0x2e, 0x98, 0xab, 0x29, 0x7a, 0x2b, 0x0e, 0x49, 0x8a,
+	0x5e, 0x87, 0x85, 0x63, 0xec, 0xf6, 0x88, 0x63, 0x85, 0x04, 0xd3, 0xc0, 0xd7, 0x8b, 0x42, 0xdb,
+	0x79, 0x39, 0x69, 0x8a, 0x39, 0x64, 0x42, 0x91, 0x9c, 0x11, 0x7b, 0xc0, 0x53, 0x83, 0xd5, 0x0f,
+	0x7a, 0xae, 0x3d, 0xd4, 0x97, 0x85, 0xf7, 0x7f, 0xfb, 0x22, 0xef, 0x37, 0x22, 0x7c, 0x4b, 0xc0,
+	0xcd, 0x25, 0x32, 0x3a, 0x81, 0x9e, 0xc0, 0x9c, 0x47, 0xbb, 0x2a, 0x0e, 0xa8, 0x8e, 0x84, 0xcf,
+	0xbc, 0x76, 0x91, 0xb8, 0xa7, 0xb4, 0x2b, 0x9d, 0x7f, 0xb7, 0xc0, 0x7d, 0xc7, 0x04, 0x2f, 0x9a,
+	0xa0, 0x68, 0x0f, 0x16, 0x13, 0xed, 0x84, 0x95, 0x57, 0x26, 0xb5, 0x72, 0xcc, 0xc7, 0x29, 0xe5,
+	0xbf, 0xd1, 0x60, 0xf9, 0xdc, 0x82, 0x68, 0x0b, 0xe6, 0xb9, 0xa2, 0x3c, 0xe3, 0x5b, 0x83, 0xb0,
+	0x27, 0x92, 0xc7, 0xac, 0x50, 0xa0, 0x33, 0xec, 0x93, 0x67, 0x61, 0x4f, 0xda, 0xda, 0xb6, 0x09,
+	0xa5, 0x22, 0xab, 0xcd, 0x98, 0xd1, 0x90, 0xfb, 0x06, 0x09, 0xc3, 0x20, 0x14, 0xb9, 0x62, 0xd6,
+	0x94, 0x03, 0xb4, 0x06, 0x33, 0x5d, 0x4c, 0xad, 0x01, 0x25, 0x8e, 0x48, 0x00, 0x05, 0x73, 0xba,
+	0x8b, 0xe9, 0x33, 0x4a, 0x1c, 0xf4, 0x0e, 0x4c, 0x91, 0x53, 0xe2, 0x33, 0xaa, 0x5f, 0x17, 0x07,
+	0xb2, 0x5a, 0x49, 0x2a, 0x4f, 0x85, 0x57, 0x9e, 0x8a, 0xc1, 0xc9, 0xea, 0x14, 0x14, 0xb6, 0xfc,
+	0x47, 0x1a, 0xcc, 0xa5, 0x13, 0xc4, 0x77, 0x60, 0x76, 0x48, 0xa8, 0x65, 0x8b, 0x9c, 0xa9, 0x9d,
+	0x4b, 0xe0, 0x0d, 0x9f, 0x99, 0x33, 0x43, 0x42, 0x6b, 0x9c, 0x8e, 0xde, 0x86, 0x05, 0x7c, 0x44,
+	0x19, 0x76, 0x7d, 0xc5, 0x90, 0xcb, 0x64, 0x98, 0x57, 0x20, 0xc9, 0xf4, 0x26, 0xcc, 0xf8, 0x81,
+	0xc2, 0xe7, 0x33, 0xf1, 0xd3, 0x7e, 0x20, 0xa0, 0xe5, 0x5f, 0xe7, 0x60, 0x49, 0x28, 0xd7, 0x0a,
+	0x83, 0x9f, 0x12, 0x5b, 0x94, 0x97, 0xf7, 0x60, 0x7e, 0x24, 0x0d, 0x6a, 0x97, 0xa7, 0xc1, 0x39,
+	0x96, 0xda, 0xe0, 0xbb, 0x80, 0x64, 0xca, 0x51, 0xf1, 0xdd, 0x0f, 0x5e, 0x92, 0xf0, 0x02, 0xc5,
+	0x8b, 0x02, 0x79, 0x28, 0x80, 0x2d, 0x8e, 0x43, 0xef, 0xc0, 0x42, 0x1f, 0x87, 0xcc, 0xb5, 0xdd,
+	0xbe, 0xa8, 0xb5, 0x7a, 0x3e, 0xb3, 0xc6, 0x8d, 0x82, 0x78, 0x49, 0xfc, 0x78, 0x10, 0x84, 0x03,
+	0x4f, 0x2f, 0x64, 0xc2, 0x15, 0x15, 0xdd, 0x87, 0x59, 0x76, 0x12, 0x12, 0x7a, 0x12, 0xf4, 0x1c,
+	0xfd, 0x7a, 0x26, 0x34, 0x01, 0xa0, 0xbb, 0xb0, 0x28, 0xf9, 0x78, 0xfc, 0xd9, 0x27, 0xc4, 0x11,
+	0x69, 0x78, 0xc6, 0x5c, 0x90, 0xb3, 0xa6, 0x9c, 0x44, 0xab, 0x30, 0xd5, 0xc7, 0x94, 0x12, 0xaa,
+	0x4f, 0x0b, 0xb2, 0x1a, 0x95, 0xff, 0x51, 0x83, 0x02, 0x2f, 0xdf, 0x97, 0x17, 0xdf, 0x0a, 0x5c,
+	0x3f, 0x0d, 0x18, 0xb9, 0xbc, 0xf0, 0x4a, 0x18, 0x7a, 0x17, 0xa6, 0x65, 0x2f, 0x40, 0xf5, 0x82,
+	0x70, 0xc5, 0xf2, 0xb8, 0x75, 0xce, 0xb7, 0x1a, 0x66, 0xc4, 0x32, 0x92, 0x30, 0xaf, 0x8f, 0x25,
+	0x4c, 0x1d, 0xa6, 0xed, 0x13, 0xec, 0xf3, 0x92, 0x3b, 0x25, 0xbd, 0x5f, 0x0d, 0xdf, 0x2f, 0xcc,
+	0xe4, 0x8b, 0x85, 0xf2, 0x3f, 0x69, 0x50, 0xe4, 0x32, 0x9f, 0xb8, 0x94, 0x05, 0xe1, 0xd0, 0xf0,
+	0x59, 0x38, 0xbc, 0x7c, 0x7f, 0x25, 0x98, 0xa1, 0xe4, 0xe3, 0x01, 0xf1, 0x6d, 0x22, 0xb6, 0x58,
+	0x30, 0xe3, 0x71, 0xb2, 0xf7, 0xfc, 0xff, 0xc6, 0xde, 0x57, 0x61, 0xea, 0x44, 0x90, 0xc5, 0xce,
+	0xf3, 0xa6, 0x1a, 0x95, 0xff, 0x5e, 0x83, 0xd9, 0xc7, 0xbc, 0x96, 0x7f, 0xe3, 0x06, 0xcb, 0x5f,
+	0x5d, 0xe9, 0x87, 0x30, 0x3f, 0x12, 0x4b, 0xd9, 0x3e, 0x3e, 0x77, 0x9a, 0x84, 0x51, 0xf9, 0x1f,
+	0x34, 0xc8, 0xef, 0xe3, 0x97, 0xe7, 0x7a, 0xaa, 0xb1, 0x9d, 0xe5, 0xce, 0xed, 0x2c, 0xae, 0x98,
+	0xf9, 0x74, 0xc5, 0x44, 0x50, 0x60, 0xe4, 0x4c, 0xb6, 0x44, 0xb3, 0xa6, 0xf8, 0x1b, 0x6d, 0x00,
+	0xd0, 0x41, 0x9f, 0x84, 0x94, 0x38, 0x44, 0xa6, 0xc4, 0x82, 0x99, 0x9a, 0x41, 0x4f, 0x61, 0x99,
+	0xb7, 0xcb, 0xc7, 0xae, 0x8d, 0x93, 0xec, 0x3f, 0x69, 0x1f, 0x53, 0x4c, 0xb3, 0x8a, 0x02, 0xf0,
+	0x9f, 0x1a, 0xac, 0xd4, 0x02, 0x9f, 0x32, 0x97, 0x89, 0xaa, 0x70, 0x48, 0x42, 0xca, 0x43, 0x5f,
+	0x87, 0xe9, 0x53, 0xf9, 0xa7, 0xda, 0x66, 0x34, 0xbc, 0x7c, 0xaf, 0x89, 0x33, 0xe4, 0xd3, 0xce,
+	0xc0, 0xeb, 0x39, 0xf6, 0x88, 0xef, 0x78, 0xc4, 0x8f, 0xb6, 0x9c, 0x4c, 0xa0, 0x32, 0xcc, 0xdb,
+	0x29, 0x3d, 0x54, 0x08, 0x8d, 0xcc, 0xa1, 0xdf, 0x06, 0x08, 0xfa, 0x44, 0xde, 0x16, 0x78, 0x24,
+	0x71, 0x93, 0x6f, 0x8d, 0x9b, 0xbc, 0xca, 0x33, 0x58, 0x8f, 0x34, 0x23, 0xa0, 0x99, 0xe2, 0x29,
+	0x7f, 0x3a, 0xb6, 0x5d, 0x05, 0x4e, 0x19, 0x74, 0x56, 0x18, 0x34, 0xb6, 0x57, 0x2e, 0xcb, 0x5e,
+	0xf9, 0x94, 0xbd, 0x7e, 0xcc, 0x83, 0xd0, 0x4e, 0x47, 0xce, 0xeb, 0xe3, 0x1a, 0x65, 0x2c, 0x68,
+	0xc6, 0x4c, 0xe5, 0xe7, 0xb0, 0xda, 0x66, 0xe1, 0xc0, 0x66, 0x83, 0x90, 0x38, 0x69, 0x28, 0x17,
+	0x8d, 0x25, 0x9c, 0xea, 0xda, 0x15, 0x44, 0x47, 0x4c, 0xe5, 0x2f, 0x34, 0x28, 0x8e, 0x1f, 0x07,
+	0xfa, 0x1e, 0x14, 0x78, 0x61, 0x57, 0x37, 0xa3, 0x37, 0x2e, 0x3b, 0x3e, 0x5e, 0xf1, 0x4d, 0xc1,
+	0x81, 0xee, 0x00, 0x28, 0xd1, 0x91, 0xe1, 0xb9, 0x05, 0xe5, 0x4c, 0xc3, 0x41, 0xeb, 0x30, 0xdb,
+	0xc7, 0x21, 0xf1, 0x19, 0xa7, 0xca, 0x23, 0x9a, 0x91, 0x13, 0x0d, 0x87, 0x37, 0x00, 0xf8, 0x98,
+	0x91, 0x90, 0xd3, 0xa4, 0xed, 0xa7, 0xc5, 0xb8, 0xe1, 0xa0, 0x1f, 0xc1, 0xb4, 0x12, 0xa2, 0xfa,
+	0xfa, 0x89, 0x76, 0x19, 0xf1, 0x94, 0xff, 0x36, 0x0f, 0x0b, 0x8f, 0x07, 0xbe, 0x23, 0xfa, 0xe0,
+	0x90, 0x60, 0xef, 0xea, 0xd1, 0xf9, 0x08, 0x66, 0x43, 0x62, 0xbb, 0x7d, 0x97, 0xc4, 0xb5, 0xfd,
+	0x15, 0xb7, 0xb4, 0x18, 0x9a, 0xba, 0xa5, 0x15, 0xae, 0x7e, 0x4b, 0x43, 0x3f, 0x84, 0x19, 0xd7,
+	0x67, 0x24, 0x3c, 0xc5, 0x3d, 0xb5, 0xf1, 0xb5, 0x73, 0x01, 0x5c, 0x57, 0x77, 0xe3, 0xdd, 0xc2,
+	0x1f, 0xf2, 0xf8, 0x8d, 0x19, 0xf8, 0x6d, 0xc6, 0x27, 0x67, 0xcc, 0xea, 0xe3, 0x61, 0x30, 0x60,
+	0x57, 0xbc, 0xcd, 0x70, 0xce, 0x96, 0x60, 0xe4, 0x24, 0xae, 0x48, 0x7c, 0x5b, 0x98, 0x9e, 0x50,
+	0xc6, 0x34, 0x51, 0xf7, 0x84, 0x1a, 0x80, 0xec, 0x4b, 0xfa, 0xd8, 0x75, 0xf4, 0x99, 0x2b, 0x9c,
+	0xc3, 0xac, 0xe0, 0x6b, 0x61, 0xd7, 0x29, 0xff, 0x9d, 0x06, 0x37, 0x3f, 0x10, 0xd5, 0xbf, 0x76,
+	0x42, 0xec, 0x17, 0x1f, 0x0c, 0xc8, 0x80, 0xc8, 0x22, 0xd8, 0x82, 0x15, 0xd5, 0x2c, 0x70, 0xf5,
+	0xe2, 0xad, 0x6a, 0x13, 0xaa, 0xb9, 0x2c, 0x99, 0x3b, 0x92, 0x57, 0x28, 0x7c, 0x1f, 0x90, 0x92,
+	0x68, 0xf3, 0xb5, 0x52, 0x1d, 0x60, 0xc1, 0x2c, 0x7e, 0x9c, 0x28, 0x21, 0xbb, 0xbe, 0x31, 0x34,
+	0xb5, 0x9c, 0xc0, 0x97, 0x59, 0x7c, 0x14, 0x4d, 0xeb, 0x81, 0x4f, 0xca, 0xff, 0xa2, 0xc1, 0x82,
+	0xba, 0xd8, 0xb5, 0x70, 0x88, 0x3d, 0x8a, 0x9e, 0xc3, 0x9c, 0xe7, 0xfa, 0xf1, 0x3d, 0x51, 0xbb,
+	0xec, 0x7c, 0xee, 0xf0, 0xf3, 0xf9, 0xea, 0xf3, 0xcd, 0x9b, 0x29, 0xae, 0xfb, 0x81, 0xe7, 0x32,
+	0xe2, 0xf5, 0xd9, 0xd0, 0x04, 0xcf, 0xf5, 0xa3, 0x9b, 0xa3, 0x07, 0xc8, 0xc3, 0x67, 0x11, 0xc8,
+	0xea, 0x93, 0xd0, 0x0d, 0xa4, 0x77, 0xbf, 0xd2, 0x93, 0xde, 0xf8, 0xea, 0xf3, 0xcd, 0xdb, 0xe7,
+	0x19, 0x93, 0x45, 0x84, 0xa7, 0x15, 0x3d, 0x7c, 0x16, 0xed, 0x44, 0xd0, 0xcb, 0x1d, 0x98, 0x57,
+	0x1d, 0xa5, 0xdc, 0x59, 0x1d, 0x16, 0xa2, 0xf2, 0x29, 0x57, 0xd6, 0x26, 0xf3, 0x61, 0x55, 0x74,
+	0x95, 0xd4, 0xff, 0xca, 0xa9, 0x3e, 0x5e, 0x49, 0x4d, 0x5a, 0x4e, 0x6d, 0xf2, 0x96, 0x33, 0x77,
+	0x59, 0xcb, 0x69, 0xc2, 0x9d, 0x74, 0x21, 0xb1, 0xe2, 0xb2, 0x63, 0xa9, 0xc5, 0xb2, 0xdb, 0xe1,
+	0xf5, 0x34, 0x53, 0x35, 0xe2, 0x91, 0x8e, 0x8a, 0x3e, 0x82, 0xad, 0x0b, 0x64, 0x26, 0x8a, 0x65,
+	0xb7, 0x14, 0x1b, 0x99, 0x62, 0x3b, 0xb1, 0xb6, 0x0f, 0x00, 0x7a, 0xf8, 0x65, 0xa4, 0xda, 0x05,
+	0xfd, 0x74, 0x0f, 0xbf, 0x54, 0x8a, 0xbc, 0x0d, 0x0b, 0x1c, 0x9e, 0xac, 0x3a, 0x95, 0xc9, 0x31,
+	0xdf, 0xc3, 0x2f, 0xe3, 0x35, 0xca, 0xff, 0xb1, 0x0a, 0x53, 0xea, 0xc8, 0xf7, 0xae, 0xe8, 0xa2,
+	0x73, 0x71, 0x08, 0xeb, 0xda, 0x88, 0x43, 0x3e, 0xfd, 0xcd, 0x1c, 0xb2, 0x90, 0xed, 0x70, 0xe7,
+	0x1d, 0x2c, 0xff, 0x1b, 0x38, 0xd8, 0x37, 0x74, 0x87, 0xf9, 0x1d, 0x58, 0xe3, 0x67, 0xe6, 0xfa,
+	0x2e, 0x73, 0x93, 0x67, 0x20, 0x4b, 0xe8, 0x21, 0x72, 0xe8, 0xec, 0x6e, 0x71, 0x94, 0x5b, 0xd7,
+	0xcc, 0x55, 0xcf, 0xf5, 0x1b, 0x92, 0x43, 0xed, 0xd4, 0xe4, 0x78, 0xb4, 0x0d, 0xc5, 0xa3, 0x41,
+	0xe8, 0xf3, 0x9b, 0x1d, 0x89, 0xac, 0xbe, 0x20, 0xee, 0x3c, 0x8b, 0x7c, 0x9e, 0xf7, 0xae, 0xca,
+	0xd4, 0x55, 0xb8, 0x23, 0x90, 0x71, 0x39, 0x8b, 0xcf, 0x3a, 0x24, 0x9c, 0x5b, 0x3d, 0x93, 0x94,
+	0x38, 0x28, 0xba, 0xd6, 0x47, 0x87, 0x2a, 0x11, 0xe8, 0x07, 0xb0, 0x9c, 0xb2, 0xb6, 0xd2, 0x78,
+	0x29, 0x73, 0xbf, 0x4b, 0x89, 0x6d, 0xa5, 0xa2, 0x97, 0x86, 0x51, 0xf1, 0x9b, 0x09, 0xa3, 0xe5,
+	0xaf, 0x21, 0x8c, 0xd0, 0x95, 0xc3, 0x68, 0xe5, 0xf2, 0x30, 0x42, 0x8f, 0xe3, 0xbb, 0xac, 0x2a,
+	0x4f, 0xfa, 0x8d, 0xc9, 0x9c, 0x74, 0x61, 0xa4, 0x30, 0xa1, 0xdf, 0x85, 0x75, 0x1e, 0x3a, 0x23,
+	0xfe, 0x6e, 0x91, 0x33, 0x46, 0x7c, 0xd1, 0x82, 0xdf, 0x9c, 0x4c, 0xa8, 0xee, 0xe1, 0xb3, 0xc3,
+	0x94, 0xf3, 0x1b, 0x91, 0x80, 0x0b, 0x8a, 0xde, 0xea, 0x05, 0x45, 0xef, 0x43, 0x48, 0x97, 0x1f,
+	0x7e, 0x24, 0x01, 0x63, 0x3d, 0x12, 0xea, 0xb7, 0xb2, 0xfb, 0xb3, 0xa7, 0xb1, 0x9f, 0x74, 0x22,
+	0xa8, 0xb9, 0xe2, 0x9d, 0x9f, 0x44, 0x1e, 0xdc, 0xc9, 0x0a, 0x9b, 0x64, 0x01, 0x5d, 0x2c, 0x70,
+	0x2f, 0x63, 0x81, 0xd1, 0xc0, 0x49, 0xd6, 0x29, 0x79, 0x17, 0xd2, 0x50, 0x13, 0x6e, 0xf3, 0xe5,
+	0xba, 0xc1, 0x29, 0x09, 0xfd, 0x20, 0xb4, 0x28, 0xe9, 0x1d, 0x5b, 0x0e, 0xe9, 0x91, 0xae, 0x7c,
+	0x04, 0x59, 0xcb, 0x7c, 0x3d, 0xe1, 0x91, 0xbd, 0xa7, 0x58, 0xda, 0xa4, 0x77, 0x5c, 0x8f, 0x19,
+	0xd0, 0x11, 0xdc, 0x49, 0x84, 0x89, 0x47, 0x6e, 0x4b, 0x5e, 0xe4, 0xa3, 0x14, 0x55, 0x9a, 0xcc,
+	0x50, 0xa5, 0x48, 0x8a, 0x7c, 0x31, 0xaf, 0x09, 0x19, 0x2a, 0x61, 0xdd, 0x85, 0x45, 0x67, 0xe8,
+	0x63, 0xcf, 0xb5, 0x23, 0xd7, 0x5d, 0x97, 0xcf, 0x23, 0x6a, 0x56, 0xb9, 0xeb, 0x7b, 0x30, 0x1f,
+	0xbd, 0xa2, 0x70, 0x66, 0xfd, 0x76, 0xf6, 0x7b, 0x92, 0x44, 0x9b, 0x1c, 0x62, 0xce, 0x7d, 0x9c,
+	0x0c, 0xd0, 0x4f, 0xe1, 0xf5, 0x57, 0xc6, 0xb2, 0x12, 0x7b, 0xe7, 0x72, 0xb1, 0x5b, 0xaf, 0x08,
+	0x6f, 0xb9, 0x96, 0x01, 0xc5, 0x24, 0x12, 0x95, 0xe0, 0x8d, 0xcb, 0x05, 0x2f, 0xc6, 0xc1, 0x29,
+	0xc5, 0x54, 0x60, 0x85, 0x5f, 0x83, 0x5d, 0xca, 0x2c, 0xf9, 0x5d, 0x81, 0x27, 0x34, 0xaa, 0x6f,
+	0x8a, 0xe3, 0x59, 0x56, 0xa4, 0xf8, 0xb9, 0x81, 0xa2, 0xdf, 0x83, 0xdb, 0x29, 0x9c, 0x15, 0x12,
+	0x46, 0x7c, 0xb1, 0x57, 0x65, 0xac, 0xad, 0xc9, 0x8c, 0xb5, 0x76, 0x1c, 0x8b, 0x34, 0x23, 0x11,
+	0xca, 0x56, 0xbb, 0x70, 0x33, 0x4e, 0xc5, 0x36, 0xf6, 0x6d, 0xd2, 0x53, 0x09, 0xf5, 0xb5, 0xcc,
+	0xdc, 0xb1, 0x12, 0x81, 0x6b, 0x02, 0x2b, 0x93, 0xea, 0x87, 0x70, 0x2b, 0x7e, 0xd5, 0x1e, 0x4d,
+	0x00, 0x7a, 0x79, 0x32, 0x05, 0x6f, 0xc6, 0xfc, 0xe9, 0xe0, 0x47, 0x3f, 0x86, 0x95, 0x44, 0x70,
+	0x92, 0xd6, 0x5e, 0xcf, 0x54, 0x0d, 0xc5, 0xd0, 0x24, 0xb9, 0x7d, 0x04, 0x89, 0x64, 0x2b, 0xdd,
+	0x22, 0xbc, 0x71, 0x85, 0x2e, 0x3f, 0xd1, 0x21, 0xc9, 0x12, 0xa8, 0x0e, 0x9b, 0x89, 0x64, 0xdc,
+	0xeb, 0x05, 0x2f, 0xf9, 0x0a, 0xa9, 0x27, 0x67, 0xaa, 0xdf, 0xdd, 0xca, 0x6f, 0xcf, 0x9a, 0xeb,
+	0x31, 0xac, 0x2a, 0x51, 0x4f, 0xe3, 0x37, 0x68, 0x8a, 0x7e, 0x02, 0x37, 0xd4, 0x37, 0x2a, 0xf5,
+	0x85, 0xa9, 0x2f, 0x1a, 0x1a, 0xfd, 0x5b, 0xd9, 0x6f, 0x41, 0x4f, 0x25, 0x36, 0xd5, 0x6d, 0xaa,
+	0x37, 0x65, 0xe4, 0x9d, 0xa3, 0x70, 0x5f, 0x0b, 0x89, 0x1d, 0x84, 0x8e, 0xac, 0xca, 0x27, 0xf2,
+	0x61, 0x4e, 0xff, 0xb6, 0xf4, 0x35, 0x49, 0x4a, 0xbd, 0xd8, 0xf1, 0x1a, 0xae, 0x12, 0x38, 0xb1,
+	0xa2, 0xa7, 0xbe, 0x6d, 0x91, 0x5e, 0x17, 0x65, 0x52, 0x26, 0x32, 0xc8, 0x29, 0xaa, 0x01, 0xef,
+	0x03, 0x24, 0x92, 0x32, 0xfc, 0x82, 0x1b, 0x27, 0x78, 0x41, 0x7c, 0xaa, 0xbf, 0x99, 0x99, 0x8e,
+	0x78, 0x22, 0xe5, 0xfc, 0x6d, 0x81, 0xed, 0x08, 0x28, 0x7a, 0x04, 0xb7, 0x64, 0xab, 0x15, 0xa5,
+	0x26, 0x2a, 0x13, 0x3b, 0x71, 0xf4, 0x7b, 0x62, 0xd5, 0x9b, 0xa2, 0x9d, 0x8a, 0xa9, 0x35, 0x49,
+	0x44, 0x0d, 0x58, 0x4b, 0x19, 0x72, 0x6c, 0xfd, 0xef, 0x64, 0xae, 0xbf, 0x9a, 0x24, 0xf2, 0x11,
+	0x15, 0x9e, 0x40, 0xf2, 0x81, 0x83, 0x2b, 0x82, 0x87, 0xfa, 0xfd, 0xc9, 0xfc, 0x35, 0xf9, 0x76,
+	0x51, 0xe7, 0x6c, 0x88, 0x80, 0x1e, 0xd9, 0x71, 0x4c, 0x22, 0xd5, 0x1f, 0x08, 0x5b, 0xde, 0xbd,
+	0xc0, 0x96, 0xc6, 0x88, 0x20, 0x65, 0xce, 0x55, 0x2f, 0x8b, 0x48, 0xd1, 0x0f, 0xa1, 0xc4, 0xcf,
+	0x2c, 0x0e, 0xd8, 0x64, 0xad, 0x2e, 0xa6, 0x7a, 0x45, 0x1c, 0x1b, 0x3f, 0xd5, 0x73, 0xdf, 0x73,
+	0xf6, 0x30, 0x45, 0xdf, 0x87, 0x35, 0xce, 0xcc, 0xef, 0xc9, 0x47, 0xbd, 0xc0, 0x7e, 0x41, 0xc2,
+	0x58, 0x10, 0xd5, 0x77, 0x04, 0xef, 0xaa, 0x87, 0xcf, 0x0c, 0xdf, 0xd9, 0x95, 0xe4, 0x48, 0x0a,
+	0x2d, 0x33, 0xb8, 0x99, 0xa9, 0xee, 0x04, 0x9f, 0x59, 0xbe, 0x0f, 0xd7, 0xe5, 0xc9, 0x5e, 0xda,
+	0x44, 0xcf, 0xf0, 0xad, 0x8b, 0xd3, 0x95, 0x1c, 0xe5, 0x3f, 0xd0, 0x00, 0x9d, 0xf7, 0xf8, 0x09,
+	0xd6, 0x4c, 0x1a, 0xe6, 0xdc, 0xe4, 0x0d, 0x73, 0xfe, 0x92, 0x86, 0xb9, 0xfc, 0x01, 0xcc, 0xa5,
+	0x53, 0xf9, 0x16, 0xe4, 0x3d, 0xd7, 0xbf, 0xe0, 0x8e, 0xc7, 0x49, 0x02, 0x81, 0xcf, 0x2e, 0xd0,
+	0x81, 0x93, 0xca, 0x3f, 0xcb, 0xc3, 0x4a, 0x46, 0xe7, 0x81, 0x0c, 0x98, 0x3b, 0xee, 0x05, 0x41,
+	0x68, 0x9d, 0xe2, 0xde, 0x80, 0xe8, 0xda, 0x15, 0x92, 0x15, 0x08, 0xc6, 0x43, 0xce, 0xc7, 0xaf,
+	0x1f, 0x83, 0xbe, 0x83, 0x19, 0xb9, 0xe2, 0x45, 0x66, 0x5e, 0x72, 0xa9, 0x24, 0xfc, 0x08, 0x6e,
+	0x31, 0x1c, 0x76, 0x09, 0xb3, 0xb0, 0xcd, 0xdc, 0x53, 0x92, 0xf2, 0x1a, 0xf9, 0x88, 0x70, 0x53,
+	0x92, 0xab, 0x82, 0x1a, 0x3b, 0x0d, 0xfa, 0x2e, 0x2c, 0xba, 0xbe, 0x1d, 0x12, 0x4c, 0x89, 0x2a,
+	0x29, 0xd9, 0xd7, 0x97, 0x85, 0x08, 0x25, 0x8b, 0xc9, 0x77, 0x61, 0xd1, 0x21, 0x23, 0x6c, 0xd9,
+	0x57, 0x99, 0x05, 0x87, 0xa4, 0xd9, 0xde, 0x83, 0x75, 0xca, 0x3b, 0x45, 0xe6, 0x9e, 0xba, 0x6c,
+	0x68, 0x29, 0x8d, 0x1d, 0x97, 0x32, 0x5e, 0xa8, 0xd4, 0x37, 0x8b, 0xb5, 0x14, 0xa4, 0x23, 0x10,
+	0x75, 0x05, 0x28, 0xff, 0x7e, 0x1e, 0x4a, 0x17, 0xf7, 0x68, 0xff, 0xb7, 0x2c, 0xf2, 0x26, 0x14,
+	0xd5, 0xfe, 0xc6, 0x4d, 0xb1, 0x24, 0xe7, 0xff, 0xdf, 0x1a, 0x41, 0x83, 0xc5, 0x7d, 0x4c, 0x59,
+	0xaa, 0xce, 0xfe, 0x00, 0xae, 0x5f, 0xfd, 0xc8, 0x25, 0x0b, 0x7a, 0x07, 0x0a, 0xe2, 0xa9, 0x2d,
+	0x37, 0xe1, 0x53, 0x9b, 0x40, 0x97, 0xff, 0x3a, 0x07, 0x33, 0x51, 0xf3, 0x8c, 0x6a, 0x50, 0x8c,
+	0xdb, 0x65, 0x2c, 0x1f, 0x51, 0x75, 0xed, 0x92, 0xe7, 0xd5, 0xa5, 0x88, 0x43, 0x4d, 0xa7, 0x7e,
+	0x7d, 0x92, 0xcb, 0xfe, 0xf5, 0xc9, 0xde, 0x48, 0x2f, 0x1d, 0xff, 0xfa, 0xa4, 0x05, 0x73, 0x0e,
+	0xa1, 0x76, 0xe8, 0xf6, 0xe3, 0x0f, 0x9e, 0x19, 0x57, 0x97, 0x88, 0xb9, 0x9e, 0x40, 0xd3, 0x67,
+	0x91, 0x16, 0xc1, 0x3b, 0xb5, 0x1e, 0xa6, 0x6c, 0xac, 0xf3, 0x17, 0x87, 0x54, 0x98, 0xf0, 0x90,
+	0x6e, 0x70, 0x01, 0xe9, 0xa6, 0x5f, 0x7c, 0x84, 0xf9, 0x4b, 0x0d, 0x56, 0x32, 0x14, 0xe1, 0x1f,
+	0x61, 0xbc, 0xc0, 0x77, 0x5f, 0x90, 0x50, 0xe5, 0xe9, 0x68, 0xc8, 0x3f, 0xfd, 0xb9, 0x0e, 0x6f,
+	0x45, 0xd9, 0x50, 0x3d, 0xc4, 0xc7, 0x63, 0xce, 0xf5, 0x92, 0x1c, 0x51, 0x97, 0x45, 0x5f, 0x9b,
+	0xa2, 0x21, 0x77, 0x7d, 0x4a, 0xec, 0x41, 0xc8, 0xdd, 0xcb, 0x0e, 0x7c, 0x86, 0xed, 0xe8, 0x43,
+	0xcc, 0x52, 0x34, 0x5f, 0x93, 0xd3, 0x5c, 0x88, 0x43, 0x18, 0x76, 0x7b, 0x54, 0x7d, 0x89, 0x89,
+	0x86, 0xe5, 0x3f, 0xd1, 0xe0, 0x86, 0x54, 0x96, 0x7b, 0x5d, 0xea, 0x72, 0x64, 0xc0, 0xb2, 0xea,
+	0x47, 0xae, 0x60, 0xee, 0x62, 0xcc, 0x12, 0xd9, 0x3b, 0xcb, 0x69, 0x72, 0x57, 0x74, 0x9a, 0xf2,
+	0x57, 0x1a, 0x2c, 0x47, 0x27, 0x7a, 0x88, 0x7b, 0xed, 0x13, 0x1c, 0x12, 0xfa, 0xf5, 0xf8, 0xa3,
+	0x01, 0xcb, 0xa7, 0xb8, 0xe7, 0x3a, 0x98, 0x5d, 0x41, 0xc1, 0x62, 0xcc, 0x12, 0x89, 0x69, 0xc0,
+	0x14, 0x15, 0x5a, 0xa9, 0xda, 0xf9, 0x90, 0x3b, 0xdd, 0xaf, 0x3f, 0xdf, 0x5c, 0x97, 0xfc, 0xd4,
+	0x79, 0x51, 0x71, 0x83, 0x1d, 0x0f, 0xb3, 0x93, 0xca, 0x3e, 0xe9, 0x62, 0x7b, 0x58, 0x27, 0xf6,
+	0x78, 0x25, 0x96, 0x02, 0xee, 0xbd, 0x00, 0x48, 0xfd, 0xf6, 0x6d, 0x1d, 0x6e, 0x1d, 0x36, 0x3b,
+	0x86, 0xd5, 0x6c, 0x75, 0x1a, 0xcd, 0x03, 0xeb, 0xd9, 0x41, 0xbb, 0x65, 0xd4, 0x1a, 0x8f, 0x1b,
+	0x46, 0xbd, 0x78, 0x0d, 0xad, 0xc0, 0x52, 0x9a, 0xf8, 0xdc, 0x68, 0x17, 0x35, 0x74, 0x0b, 0x56,
+	0xd2, 0x93, 0xd5, 0xdd, 0x76, 0xa7, 0xda, 0x38, 0x28, 0xe6, 0x10, 0x82, 0xc5, 0x34, 0xe1, 0xa0,
+	0x59, 0xcc, 0xdf, 0x0b, 0xe1, 0xd6, 0x05, 0x3f, 0x78, 0x41, 0xf7, 0x61, 0xbb, 0x65, 0x36, 0x5b,
+	0xcd, 0x76, 0x75, 0xdf, 0x32, 0x3e, 0x32, 0x6a, 0xcf, 0x04, 0x57, 0xab, 0xb9, 0xdf, 0xa8, 0x3d,
+	0xb7, 0xaa, 0xfb, 0xfb, 0x56, 0xd3, 0xb4, 0x0e, 0x9a, 0x9d, 0x27, 0x8d, 0x83, 0xbd, 0xe2, 0x35,
+	0xf4, 0x26, 0xdc, 0xbd, 0x18, 0xbd, 0x6b, 0xb4, 0x3b, 0x96, 0xf1, 0xf8, 0x71, 0xd3, 0xec, 0x14,
+	0xb5, 0x7b, 0xff, 0xad, 0xc1, 0xe2, 0xe8, 0x6f, 0xcc, 0xd0, 0x26, 0xac, 0xc7, 0xdc, 0xed, 0x4e,
+	0xb5, 0xf3, 0xac, 0x3d, 0xb6, 0xd3, 0x32, 0x6c, 0x8c, 0x03, 0xea, 0x46, 0xab, 0xd9, 0x6e, 0x74,
+	0xac, 0x96, 0x61, 0x36, 0x9a, 0xf5, 0xa2, 0x86, 0x5e, 0x83, 0x3b, 0xe3, 0x98, 0xc3, 0x66, 0xa7,
+	0x71, 0xb0, 0x17, 0x41, 0x72, 0xa8, 0x04, 0xab, 0xe3, 0x90, 0x56, 0xb5, 0xdd, 0x36, 0xea, 0xc5,
+	0x3c, 0xba, 0x0d, 0xfa, 0x38, 0xcd, 0x34, 0xde, 0x37, 0x6a, 0x1d, 0xa3, 0x5e, 0x2c, 0x64, 0x71,
+	0x3e, 0xae, 0x36, 0xf6, 0x8d, 0x7a, 0xf1, 0xfa, 0xc8, 0x49, 0x8d, 0x48, 0xb5, 0x5a, 0xc6, 0x41,
+	0x9d, 0x2b, 0x10, 0x9f, 0x48, 0x71, 0xea, 0xde, 0x9f, 0xe5, 0xe0, 0x46, 0xd6, 0x67, 0x39, 0xb4,
+	0x07, 0xe5, 0xaa, 0xd9, 0x69, 0xd4, 0xf6, 0xb9, 0x89, 0x0c, 0xb3, 0x2a, 0x4e, 0xb0, 0xf3, 0xbc,
+	0x65, 0x8c, 0x9e, 0x45, 0x69, 0xf3, 0x93, 0xcf, 0xb6, 0xd6, 0xc7, 0x25, 0x3c, 0xf3, 0x69, 0x9f,
+	0xd8, 0xee, 0xb1, 0x4b, 0xf8, 0x55, 0x71, 0xe3, 0x02, 0x41, 0xa6, 0xd1, 0xda, 0xaf, 0xd6, 0x8c,
+	0xa2, 0x56, 0x5a, 0xff, 0xe4, 0xb3, 0xad, 0x5b, 0xe3, 0x42, 0x4c, 0xd2, 0xef, 0x61, 0x9b, 0xa0,
+	0x1f, 0xc1, 0x9d, 0x0b, 0x04, 0x34, 0x0e, 0xda, 0x86, 0xd9, 0x29, 0xe6, 0x4a, 0xa5, 0x4f, 0x3e,
+	0xdb, 0x5a, 0x1d, 0xe7, 0x6f, 0xf8, 0x94, 0x84, 0xec, 0x15, 0xec, 0x75, 0x63, 0xdf, 0xe8, 0x18,
+	0xc5, 0x7c, 0x36, 0x3b, 0xcf, 0x3e, 0x8c, 0x94, 0x0a, 0x3f, 0xfb, 0xd3, 0x8d, 0x6b, 0xf7, 0x5e,
+	0xc0, 0xe2, 0x68, 0x29, 0xe0, 0x4e, 0xb2, 0xd7, 0x3c, 0x34, 0xcc, 0x83, 0xa6, 0x99, 0xed, 0x24,
+	0x25, 0x58, 0x1d, 0x07, 0x54, 0x6b, 0x9d, 0xc6, 0xa1, 0x51, 0xd4, 0xb8, 0x75, 0xc7, 0x69, 0x8d,
+	0x03, 0x45, 0xcd, 0xed, 0xee, 0xfd, 0xe2, 0x8b, 0x0d, 0xed, 0x97, 0x5f, 0x6c, 0x68, 0xff, 0xf6,
+	0xc5, 0x86, 0xf6, 0xe9, 0x97, 0x1b, 0xd7, 0x7e, 0xf9, 0xe5, 0xc6, 0xb5, 0x7f, 0xfe, 0x72, 0xe3,
+	0xda, 0x4f, 0x1e, 0x74, 0x5d, 0x76, 0x32, 0x38, 0xaa, 0xd8, 0x81, 0xb7, 0xa3, 0x8a, 0xcd, 0x83,
+	0x93, 0xc1, 0x51, 0xf4, 0xf7, 0xce, 0x99, 0xf8, 0xc5, 0xae, 0xf8, 0x35, 0x2d, 0xff, 0x35, 0xee,
+	0x94, 0xa8, 0x15, 0x6f, 0xff, 0xcf, 0x00, 0xfc, 0x21, 0x38, 0x32, 0xd0, 0x2b, 0x00, 0x00,
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ConstitutionAmendmentThreshold)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.LawQuorum)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.LawThreshold)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.QuorumTimeout != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout)
-		n += 2 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.MaxVotingPeriodExtension != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension)
-		n += 2 + l + sovGov(uint64(l))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.QuorumCheckCount != 0 {
-		n += 2 + sovGov(uint64(m.QuorumCheckCount))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.ExecutionPolicy != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExecutionPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ConflictingProposalIds) > 0 {
+		dAtA3 := make([]byte, len(m.ConflictingProposalIds)*10)
+		var j2 int
+		for _, num := range m.ConflictingProposalIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGov(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.VotingStartTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DepositEndTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalMsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Passes {
+		i--
+		if m.Passes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QuorumReached {
+		i--
+		if m.QuorumReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Participation) > 0 {
+		i -= len(m.Participation)
+		copy(dAtA[i:], m.Participation)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Participation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalVotingPower) > 0 {
+		i -= len(m.TotalVotingPower)
+		copy(dAtA[i:], m.TotalVotingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalVotingPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TallyResult != nil {
+		{
+			size, err := m.TallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Changes != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Changes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Law) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Law) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Law) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RatificationTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RatificationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RatificationTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Supersedes) > 0 {
+		dAtA12 := make([]byte, len(m.Supersedes)*10)
+		var j11 int
+		for _, num := range m.Supersedes {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintGov(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstitutionVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amendment) > 0 {
+		i -= len(m.Amendment)
+		copy(dAtA[i:], m.Amendment)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Amendment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstitutionArticle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionArticle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionArticle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StructuredConstitution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StructuredConstitution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StructuredConstitution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Articles) > 0 {
+		for iNdEx := len(m.Articles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Articles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArticleOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArticleOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArticleOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Article != nil {
+		{
+			size, err := m.Article.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AfterId) > 0 {
+		i -= len(m.AfterId)
+		copy(dAtA[i:], m.AfterId)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AfterId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ArticleId) > 0 {
+		i -= len(m.ArticleId)
+		copy(dAtA[i:], m.ArticleId)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ArticleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalPaid) > 0 {
+		for iNdEx := len(m.TotalPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EndTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextPayoutTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextPayoutTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextPayoutTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumCheckQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumCheckQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumCheckQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumChecksDone != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumChecksDone))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuorumCheckCount != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QuorumTimeoutTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuorumTimeoutTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintGov(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGov(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintGov(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LawThreshold) > 0 {
+		i -= len(m.LawThreshold)
+		copy(dAtA[i:], m.LawThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LawQuorum) > 0 {
+		i -= len(m.LawQuorum)
+		copy(dAtA[i:], m.LawQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConstitutionAmendmentThreshold) > 0 {
+		i -= len(m.ConstitutionAmendmentThreshold)
+		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConstitutionAmendmentQuorum) > 0 {
+		i -= len(m.ConstitutionAmendmentQuorum)
+		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEndBlockerProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxEndBlockerProposals))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxProposalExecutionGas != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxProposalExecutionGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.MessageExecutionDelays) > 0 {
+		for iNdEx := len(m.MessageExecutionDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageExecutionDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.ExecutionDelay != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintGov(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.MinDepositStakedTokens) > 0 {
+		i -= len(m.MinDepositStakedTokens)
+		copy(dAtA[i:], m.MinDepositStakedTokens)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositStakedTokens)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.MaxDelegationsChecked != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxDelegationsChecked))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.MinVoteStakedTokens) > 0 {
+		i -= len(m.MinVoteStakedTokens)
+		copy(dAtA[i:], m.MinVoteStakedTokens)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinVoteStakedTokens)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.MaxVoteChanges != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxVoteChanges))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.RecordVoteHistory {
+		i--
+		if m.RecordVoteHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.MessageTallyParams) > 0 {
+		for iNdEx := len(m.MessageTallyParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageTallyParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ExpeditedAllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.ExpeditedAllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpeditedAllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.ExpeditedAllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedAllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintGov(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.FinalVotesRetentionPeriod != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.FinalVotesRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.FinalVotesRetentionPeriod):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintGov(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.PersistFinalVotes {
+		i--
+		if m.PersistFinalVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.LawQuorumRange != nil {
+		{
+			size, err := m.LawQuorumRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.ConstitutionAmendmentQuorumRange != nil {
+		{
+			size, err := m.ConstitutionAmendmentQuorumRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.QuorumRange != nil {
+		{
+			size, err := m.QuorumRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.DynamicQuorum {
+		i--
+		if m.DynamicQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.GovernorStatusChangePeriod != nil {
+		n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.GovernorStatusChangePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GovernorStatusChangePeriod):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintGov(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.MinGovernorSelfDelegation) > 0 {
+		i -= len(m.MinGovernorSelfDelegation)
+		copy(dAtA[i:], m.MinGovernorSelfDelegation)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinGovernorSelfDelegation)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.MinInitialDepositThrottler != nil {
+		{
+			size, err := m.MinInitialDepositThrottler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.MinDepositThrottler != nil {
+		{
+			size, err := m.MinDepositThrottler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.QuorumCheckCount != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxVotingPeriodExtension != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriodExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintGov(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.QuorumTimeout != nil {
+		n30, err30 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.QuorumTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintGov(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.LawThreshold) > 0 {
+		i -= len(m.LawThreshold)
+		copy(dAtA[i:], m.LawThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.LawQuorum) > 0 {
+		i -= len(m.LawQuorum)
+		copy(dAtA[i:], m.LawQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ConstitutionAmendmentThreshold) > 0 {
+		i -= len(m.ConstitutionAmendmentThreshold)
+		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ConstitutionAmendmentQuorum) > 0 {
+		i -= len(m.ConstitutionAmendmentQuorum)
+		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.MinDepositRatio) > 0 {
+		i -= len(m.MinDepositRatio)
+		copy(dAtA[i:], m.MinDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.BurnProposalDepositPrevote {
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n31, err31 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintGov(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDepositPeriod != nil {
+		n32, err32 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintGov(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageExecutionDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageExecutionDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageExecutionDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintGov(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageTallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageTallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageTallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinDepositThrottler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinDepositThrottler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SensitivityTargetDistance != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SensitivityTargetDistance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DecreaseRatio) > 0 {
+		i -= len(m.DecreaseRatio)
+		copy(dAtA[i:], m.DecreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DecreaseRatio)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IncreaseRatio) > 0 {
+		i -= len(m.IncreaseRatio)
+		copy(dAtA[i:], m.IncreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.IncreaseRatio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TargetActiveProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TargetActiveProposals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdatePeriod != nil {
+		n34, err34 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UpdatePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod):])
+		if err34 != nil {
+			return 0, err34
+		}
+		i -= n34
+		i = encodeVarintGov(dAtA, i, uint64(n34))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FloorValue) > 0 {
+		for iNdEx := len(m.FloorValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FloorValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinInitialDepositThrottler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinInitialDepositThrottler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinInitialDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SensitivityTargetDistance != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SensitivityTargetDistance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DecreaseRatio) > 0 {
+		i -= len(m.DecreaseRatio)
+		copy(dAtA[i:], m.DecreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DecreaseRatio)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IncreaseRatio) > 0 {
+		i -= len(m.IncreaseRatio)
+		copy(dAtA[i:], m.IncreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.IncreaseRatio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TargetProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TargetProposals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdatePeriod != nil {
+		n35, err35 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UpdatePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintGov(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FloorValue) > 0 {
+		for iNdEx := len(m.FloorValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FloorValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastMinDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastMinDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastMinDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n36, err36 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err36 != nil {
+			return 0, err36
+		}
+		i -= n36
+		i = encodeVarintGov(dAtA, i, uint64(n36))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastStatusChangeTime != nil {
+		n37, err37 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastStatusChangeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastStatusChangeTime):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintGov(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorValShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorValShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorValShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 2
+	}
+	if len(m.ConflictingProposalIds) > 0 {
+		l = 0
+		for _, e := range m.ConflictingProposalIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	l = len(m.FailedReason)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionPolicy != 0 {
+		n += 2 + sovGov(uint64(m.ExecutionPolicy))
+	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalMsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGov(uint64(m.GasUsed))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TallyResult != nil {
+		l = m.TallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TotalVotingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Participation)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.QuorumReached {
+		n += 2
+	}
+	if m.Passes {
+		n += 2
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Changes != 0 {
+		n += 1 + sovGov(uint64(m.Changes))
+	}
+	return n
+}
+
+func (m *VoteHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGov(uint64(m.Sequence))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FinalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Law) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Supersedes) > 0 {
+		l = 0
+		for _, e := range m.Supersedes {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	if m.RatificationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RatificationTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ConstitutionVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovGov(uint64(m.Version))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = len(m.Amendment)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConstitutionArticle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StructuredConstitution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Articles) > 0 {
+		for _, e := range m.Articles {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArticleOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovGov(uint64(m.Type))
+	}
+	l = len(m.ArticleId)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AfterId)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Article != nil {
+		l = m.Article.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *FundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.NextPayoutTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextPayoutTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalPaid) > 0 {
+		for _, e := range m.TotalPaid {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuorumCheckQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumTimeoutTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.QuorumCheckCount != 0 {
+		n += 1 + sovGov(uint64(m.QuorumCheckCount))
+	}
+	if m.QuorumChecksDone != 0 {
+		n += 1 + sovGov(uint64(m.QuorumChecksDone))
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	l = len(m.MinDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.QuorumTimeout != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxVotingPeriodExtension != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.QuorumCheckCount != 0 {
+		n += 2 + sovGov(uint64(m.QuorumCheckCount))
+	}
+	if m.MinDepositThrottler != nil {
+		l = m.MinDepositThrottler.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MinInitialDepositThrottler != nil {
+		l = m.MinInitialDepositThrottler.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinGovernorSelfDelegation)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.GovernorStatusChangePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GovernorStatusChangePeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.DynamicQuorum {
+		n += 3
+	}
+	if m.QuorumRange != nil {
+		l = m.QuorumRange.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ConstitutionAmendmentQuorumRange != nil {
+		l = m.ConstitutionAmendmentQuorumRange.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.LawQuorumRange != nil {
+		l = m.LawQuorumRange.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.PersistFinalVotes {
+		n += 3
+	}
+	if m.FinalVotesRetentionPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.FinalVotesRetentionPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.ExpeditedAllowedMsgTypeUrls) > 0 {
+		for _, s := range m.ExpeditedAllowedMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MessageTallyParams) > 0 {
+		for _, e := range m.MessageTallyParams {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.RecordVoteHistory {
+		n += 3
+	}
+	if m.MaxVoteChanges != 0 {
+		n += 2 + sovGov(uint64(m.MaxVoteChanges))
+	}
+	l = len(m.MinVoteStakedTokens)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxDelegationsChecked != 0 {
+		n += 2 + sovGov(uint64(m.MaxDelegationsChecked))
+	}
+	l = len(m.MinDepositStakedTokens)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.MessageExecutionDelays) > 0 {
+		for _, e := range m.MessageExecutionDelays {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxProposalExecutionGas != 0 {
+		n += 2 + sovGov(uint64(m.MaxProposalExecutionGas))
+	}
+	if m.MaxEndBlockerProposals != 0 {
+		n += 2 + sovGov(uint64(m.MaxEndBlockerProposals))
+	}
+	return n
+}
+
+func (m *MessageExecutionDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MessageTallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MinDepositThrottler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FloorValue) > 0 {
+		for _, e := range m.FloorValue {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.UpdatePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TargetActiveProposals != 0 {
+		n += 1 + sovGov(uint64(m.TargetActiveProposals))
+	}
+	l = len(m.IncreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DecreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SensitivityTargetDistance != 0 {
+		n += 1 + sovGov(uint64(m.SensitivityTargetDistance))
+	}
+	return n
+}
+
+func (m *MinInitialDepositThrottler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FloorValue) > 0 {
+		for _, e := range m.FloorValue {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.UpdatePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TargetProposals != 0 {
+		n += 1 + sovGov(uint64(m.TargetProposals))
+	}
+	l = len(m.IncreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DecreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SensitivityTargetDistance != 0 {
+		n += 1 + sovGov(uint64(m.SensitivityTargetDistance))
+	}
+	return n
+}
+
+func (m *LastMinDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		for _, e := range m.Value {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.LastStatusChangeTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastStatusChangeTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernorDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernorValShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConflictingProposalIds = append(m.ConflictingProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ConflictingProposalIds) == 0 {
+					m.ConflictingProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConflictingProposalIds = append(m.ConflictingProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingProposalIds", wireType)
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPolicy", wireType)
+			}
+			m.ExecutionPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionPolicy |= ProposalExecutionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, ProposalMsgResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalMsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalMsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalMsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types2.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TallyResult == nil {
+				m.TallyResult = &TallyResult{}
+			}
+			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalVotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumReached = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			m.Changes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Changes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Law) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Law: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Law: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Supersedes = append(m.Supersedes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Supersedes) == 0 {
+					m.Supersedes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Supersedes = append(m.Supersedes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supersedes", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatificationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RatificationTime == nil {
+				m.RatificationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RatificationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConstitutionVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConstitutionVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConstitutionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amendment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constitution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &ArticleOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConstitutionArticle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConstitutionArticle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConstitutionArticle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sections = append(m.Sections, &ConstitutionArticle{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StructuredConstitution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StructuredConstitution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredConstitution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Articles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Articles = append(m.Articles, &ConstitutionArticle{})
+			if err := m.Articles[len(m.Articles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArticleOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArticleOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArticleOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ArticleOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArticleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Article", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Article == nil {
+				m.Article = &ConstitutionArticle{}
+			}
+			if err := m.Article.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextPayoutTime == nil {
+				m.NextPayoutTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextPayoutTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPaid = append(m.TotalPaid, types.Coin{})
+			if err := m.TotalPaid[len(m.TotalPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumCheckQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumCheckQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeoutTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuorumTimeoutTime == nil {
+				m.QuorumTimeoutTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuorumTimeoutTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
+			}
+			m.QuorumCheckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumCheckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumChecksDone", wireType)
+			}
+			m.QuorumChecksDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumChecksDone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if iNdEx >= l {
+			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.QuorumTimeout == nil {
+				m.QuorumTimeout = new(time.Duration)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.QuorumTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPeriodExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxVotingPeriodExtension == nil {
+				m.MaxVotingPeriodExtension = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxVotingPeriodExtension, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
 			}
-			m.Option = 0
+			m.QuorumCheckCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2154,14 +10797,86 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.QuorumCheckCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositThrottler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinDepositThrottler == nil {
+				m.MinDepositThrottler = &MinDepositThrottler{}
+			}
+			if err := m.MinDepositThrottler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositThrottler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinInitialDepositThrottler == nil {
+				m.MinInitialDepositThrottler = &MinInitialDepositThrottler{}
+			}
+			if err := m.MinInitialDepositThrottler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGovernorSelfDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2189,63 +10904,49 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.MinGovernorSelfDelegation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorStatusChangePeriod", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGov
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Deposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.GovernorStatusChangePeriod == nil {
+				m.GovernorStatusChangePeriod = new(time.Duration)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.GovernorStatusChangePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicQuorum", wireType)
 			}
-			m.ProposalId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2255,16 +10956,53 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicQuorum = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuorumRange == nil {
+				m.QuorumRange = &QuorumRange{}
+			}
+			if err := m.QuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorumRange", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2274,27 +11012,31 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if m.ConstitutionAmendmentQuorumRange == nil {
+				m.ConstitutionAmendmentQuorumRange = &QuorumRange{}
+			}
+			if err := m.ConstitutionAmendmentQuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorumRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2321,66 +11063,18 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.LawQuorumRange == nil {
+				m.LawQuorumRange = &QuorumRange{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LawQuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistFinalVotes", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2390,14 +11084,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.PersistFinalVotes = bool(v != 0)
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalVotesRetentionPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2424,16 +11119,18 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinalVotesRetentionPeriod == nil {
+				m.FinalVotesRetentionPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.FinalVotesRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2443,14 +11140,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2477,18 +11187,18 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
 			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2498,31 +11208,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2549,18 +11255,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositEndTime == nil {
-				m.DepositEndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedAllowedMsgTypeUrls", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2570,29 +11274,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpeditedAllowedMsgTypeUrls = append(m.ExpeditedAllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTallyParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2619,18 +11321,55 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingStartTime == nil {
-				m.VotingStartTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.MessageTallyParams = append(m.MessageTallyParams, MessageTallyParams{})
+			if err := m.MessageTallyParams[len(m.MessageTallyParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVoteHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordVoteHistory = bool(v != 0)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteChanges", wireType)
+			}
+			m.MaxVoteChanges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteChanges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoteStakedTokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2640,31 +11379,46 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingEndTime == nil {
-				m.VotingEndTime = new(time.Time)
+			m.MinVoteStakedTokens = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationsChecked", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxDelegationsChecked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationsChecked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositStakedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2692,13 +11446,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.MinDepositStakedTokens = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2708,29 +11462,33 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if m.ExecutionDelay == nil {
+				m.ExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageExecutionDelays", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2740,29 +11498,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.MessageExecutionDelays = append(m.MessageExecutionDelays, MessageExecutionDelay{})
+			if err := m.MessageExecutionDelays[len(m.MessageExecutionDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalExecutionGas", wireType)
 			}
-			var stringLen uint64
+			m.MaxProposalExecutionGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2772,24 +11532,30 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxProposalExecutionGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndBlockerProposals", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxEndBlockerProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEndBlockerProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2811,7 +11577,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *MessageExecutionDelay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,15 +11600,15 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageExecutionDelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageExecutionDelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2870,13 +11636,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2886,55 +11652,24 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2957,7 +11692,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *MessageTallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2980,34 +11715,15 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageTallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageTallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3035,13 +11751,13 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3051,29 +11767,27 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3101,7 +11815,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3124,7 +11838,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
+func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3147,17 +11861,17 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuorumCheckQueueEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuorumRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuorumCheckQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuorumRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeoutTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3167,33 +11881,29 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuorumTimeoutTime == nil {
-				m.QuorumTimeoutTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuorumTimeoutTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Min = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			m.QuorumCheckCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3203,30 +11913,24 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QuorumCheckCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumChecksDone", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.QuorumChecksDone = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QuorumChecksDone |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3248,7 +11952,7 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3271,15 +11975,15 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinDepositThrottler: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinDepositThrottler: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3306,14 +12010,14 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FloorValue = append(m.FloorValue, types.Coin{})
+			if err := m.FloorValue[len(m.FloorValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3340,68 +12044,69 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
+			if m.UpdatePeriod == nil {
+				m.UpdatePeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UpdatePeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetActiveProposals", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			m.TargetActiveProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetActiveProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseRatio", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncreaseRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3411,28 +12116,43 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
+			m.DecreaseRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitivityTargetDistance", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SensitivityTargetDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SensitivityTargetDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3454,7 +12174,7 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
+func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3477,17 +12197,17 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinInitialDepositThrottler: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinInitialDepositThrottler: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3497,29 +12217,31 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.FloorValue = append(m.FloorValue, types.Coin{})
+			if err := m.FloorValue[len(m.FloorValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3529,27 +12251,50 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if m.UpdatePeriod == nil {
+				m.UpdatePeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UpdatePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetProposals", wireType)
+			}
+			m.TargetProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3577,11 +12322,11 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			m.IncreaseRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3609,13 +12354,82 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			m.DecreaseRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitivityTargetDistance", wireType)
+			}
+			m.SensitivityTargetDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SensitivityTargetDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastMinDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastMinDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastMinDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3625,29 +12439,31 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LawQuorum = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value, types.Coin{})
+			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3657,23 +12473,27 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3696,7 +12516,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Governor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3719,17 +12539,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3739,29 +12559,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= GovernorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3788,16 +12625,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatusChangeTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3821,19 +12655,69 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStatusChangeTime == nil {
+				m.LastStatusChangeTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastStatusChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3861,11 +12745,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3893,11 +12777,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3925,51 +12809,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.Website = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3997,11 +12841,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4029,11 +12873,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4061,11 +12955,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4093,11 +12987,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LawQuorum = string(dAtA[iNdEx:postIndex])
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorValShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorValShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4125,13 +13069,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4141,33 +13085,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuorumTimeout == nil {
-				m.QuorumTimeout = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.QuorumTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPeriodExtension", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4177,47 +13117,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxVotingPeriodExtension == nil {
-				m.MaxVotingPeriodExtension = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxVotingPeriodExtension, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
-			}
-			m.QuorumCheckCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QuorumCheckCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
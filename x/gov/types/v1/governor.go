@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// Governor status aliases
+const (
+	GovernorStatusUnspecified = GovernorStatus_GOVERNOR_STATUS_UNSPECIFIED
+	GovernorStatusActive      = GovernorStatus_GOVERNOR_STATUS_ACTIVE
+	GovernorStatusInactive    = GovernorStatus_GOVERNOR_STATUS_INACTIVE
+)
+
+// Maximum lengths of the governor description fields
+const (
+	MaxMonikerLength         = 70
+	MaxIdentityLength        = 3000
+	MaxWebsiteLength         = 140
+	MaxSecurityContactLength = 140
+	MaxDetailsLength         = 280
+)
+
+// NewGovernor creates a new active Governor instance
+//
+//nolint:interfacer
+func NewGovernor(address sdk.AccAddress, description GovernorDescription, creationTime time.Time) Governor {
+	return Governor{
+		GovernorAddress:      address.String(),
+		Status:               GovernorStatusActive,
+		Description:          description,
+		LastStatusChangeTime: &creationTime,
+	}
+}
+
+// GetAddress returns the account address of the governor
+func (g Governor) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(g.GovernorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// IsActive returns true if the governor is active
+func (g Governor) IsActive() bool {
+	return g.Status == GovernorStatusActive
+}
+
+// ValidGovernorStatus returns true if the governor status is valid and false
+// otherwise.
+func ValidGovernorStatus(status GovernorStatus) bool {
+	return status == GovernorStatusActive || status == GovernorStatusInactive
+}
+
+// GovernorStatusFromString returns a GovernorStatus from a string. It returns
+// an error if the string is invalid.
+func GovernorStatusFromString(str string) (GovernorStatus, error) {
+	status, ok := GovernorStatus_value[str]
+	if !ok || !ValidGovernorStatus(GovernorStatus(status)) {
+		return GovernorStatusUnspecified, fmt.Errorf("'%s' is not a valid governor status, available statuses: active/inactive", str)
+	}
+	return GovernorStatus(status), nil
+}
+
+// NewGovernorDescription creates a new GovernorDescription instance
+func NewGovernorDescription(moniker, identity, website, securityContact, details string) GovernorDescription {
+	return GovernorDescription{
+		Moniker:         moniker,
+		Identity:        identity,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
+	}
+}
+
+// EnsureLength ensures the length of a governor's description.
+func (d GovernorDescription) EnsureLength() error {
+	if len(d.Moniker) > MaxMonikerLength {
+		return types.ErrInvalidGovernorDescription.Wrapf("invalid moniker length; got: %d, max: %d", len(d.Moniker), MaxMonikerLength)
+	}
+	if len(d.Identity) > MaxIdentityLength {
+		return types.ErrInvalidGovernorDescription.Wrapf("invalid identity length; got: %d, max: %d", len(d.Identity), MaxIdentityLength)
+	}
+	if len(d.Website) > MaxWebsiteLength {
+		return types.ErrInvalidGovernorDescription.Wrapf("invalid website length; got: %d, max: %d", len(d.Website), MaxWebsiteLength)
+	}
+	if len(d.SecurityContact) > MaxSecurityContactLength {
+		return types.ErrInvalidGovernorDescription.Wrapf("invalid security contact length; got: %d, max: %d", len(d.SecurityContact), MaxSecurityContactLength)
+	}
+	if len(d.Details) > MaxDetailsLength {
+		return types.ErrInvalidGovernorDescription.Wrapf("invalid details length; got: %d, max: %d", len(d.Details), MaxDetailsLength)
+	}
+	return nil
+}
+
+// NewGovernanceDelegation creates a new GovernanceDelegation instance
+//
+//nolint:interfacer
+func NewGovernanceDelegation(delegatorAddr, governorAddr sdk.AccAddress) GovernanceDelegation {
+	return GovernanceDelegation{
+		DelegatorAddress: delegatorAddr.String(),
+		GovernorAddress:  governorAddr.String(),
+	}
+}
+
+// NewGovernorValShares creates a new GovernorValShares instance
+//
+//nolint:interfacer
+func NewGovernorValShares(governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) GovernorValShares {
+	if shares.IsNegative() {
+		panic(fmt.Sprintf("invalid governor val shares: %s", shares))
+	}
+	return GovernorValShares{
+		GovernorAddress:  governorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+		Shares:           shares,
+	}
+}
+
+// GovernorGovInfo is used for tallying, it holds the validator shares
+// delegated to a governor, the shares of the delegators who voted themselves,
+// and the governor's vote.
+type GovernorGovInfo struct {
+	Address             sdk.AccAddress            // address of the governor
+	ValShares           map[string]math.LegacyDec // validator shares delegated to the governor, by validator address
+	ValSharesDeductions map[string]math.LegacyDec // validator shares of delegators who voted themselves, by validator address
+	Vote                WeightedVoteOptions       // vote of the governor
+}
+
+// NewGovernorGovInfo creates a GovernorGovInfo instance
+func NewGovernorGovInfo(address sdk.AccAddress, valShares []GovernorValShares, options WeightedVoteOptions) GovernorGovInfo {
+	valSharesMap := make(map[string]math.LegacyDec)
+	valSharesDeductionsMap := make(map[string]math.LegacyDec)
+	for _, valShare := range valShares {
+		valSharesMap[valShare.ValidatorAddress] = valShare.Shares
+		valSharesDeductionsMap[valShare.ValidatorAddress] = math.LegacyZeroDec()
+	}
+
+	return GovernorGovInfo{
+		Address:             address,
+		ValShares:           valSharesMap,
+		ValSharesDeductions: valSharesDeductionsMap,
+		Vote:                options,
+	}
+}
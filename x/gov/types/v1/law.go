@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// Laws is an array of laws
+type Laws []*Law
+
+// ValidateBasic performs basic validation of a law.
+func (l Law) ValidateBasic() error {
+	if l.Id == 0 {
+		return types.ErrInvalidLaw.Wrap("law id cannot be 0")
+	}
+	if l.ProposalId == 0 {
+		return types.ErrInvalidLaw.Wrapf("proposal id of law %d cannot be 0", l.Id)
+	}
+	for _, lawID := range l.Supersedes {
+		if lawID >= l.Id {
+			return types.ErrInvalidLaw.Wrapf("law %d cannot supersede law %d", l.Id, lawID)
+		}
+	}
+	return validateSupersededLaws(l.Supersedes)
+}
+
+// validateSupersededLaws checks the ids of superseded laws are non-zero and
+// unique.
+func validateSupersededLaws(supersedes []uint64) error {
+	seen := make(map[uint64]bool, len(supersedes))
+	for _, lawID := range supersedes {
+		if lawID == 0 {
+			return types.ErrInvalidLaw.Wrap("superseded law id cannot be 0")
+		}
+		if seen[lawID] {
+			return types.ErrInvalidLaw.Wrapf("duplicate superseded law id %d", lawID)
+		}
+		seen[lawID] = true
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -16,20 +17,23 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgProposeConstitutionAmendment{}, &MsgProposeLaw{}
-	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgProposeConstitutionAmendment{}, &MsgProposeStructuredConstitutionAmendment{}, &MsgProposeLaw{}
+	_, _, _, _, _             sdk.Msg                            = &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}, &MsgCancelProposal{}
+	_, _                      sdk.Msg                            = &MsgCreateFundingStream{}, &MsgCancelFundingStream{}
+	_, _                      codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
 //
 //nolint:interfacer
-func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer, metadata, title, summary string) (*MsgSubmitProposal, error) {
+func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer, metadata, title, summary string, expedited bool) (*MsgSubmitProposal, error) {
 	m := &MsgSubmitProposal{
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer,
 		Metadata:       metadata,
 		Title:          title,
 		Summary:        summary,
+		Expedited:      expedited,
 	}
 
 	anys, err := sdktx.SetMsgs(messages)
@@ -87,6 +91,10 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String()) //nolint:staticcheck
 	}
 
+	if _, ok := ProposalExecutionPolicy_name[int32(m.ExecutionPolicy)]; !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalContent, "invalid execution policy %d", m.ExecutionPolicy) //nolint:staticcheck
+	}
+
 	// Check that either metadata or Msgs length is non nil.
 	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
 		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil") //nolint:staticcheck
@@ -366,6 +374,62 @@ func (msg MsgProposeConstitutionAmendment) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{authority}
 }
 
+func NewMsgProposeStructuredConstitutionAmendment(authority sdk.AccAddress, operations []*ArticleOperation) *MsgProposeStructuredConstitutionAmendment {
+	return &MsgProposeStructuredConstitutionAmendment{
+		Authority:  authority.String(),
+		Operations: operations,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgProposeStructuredConstitutionAmendment) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgProposeStructuredConstitutionAmendment) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgProposeStructuredConstitutionAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(msg.Operations) == 0 {
+		return types.ErrInvalidProposalContent.Wrap("operations cannot be empty")
+	}
+
+	for i, op := range msg.Operations {
+		if op == nil {
+			return types.ErrInvalidProposalContent.Wrapf("operation %d cannot be nil", i)
+		}
+		if err := op.ValidateBasic(); err != nil {
+			return types.ErrInvalidProposalContent.Wrapf("operation %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgProposeStructuredConstitutionAmendment) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgProposeStructuredConstitutionAmendment.
+func (msg MsgProposeStructuredConstitutionAmendment) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgProposeLaw(authority sdk.AccAddress, title, text string, supersedes []uint64) *MsgProposeLaw {
+	return &MsgProposeLaw{
+		Authority:  authority.String(),
+		Title:      title,
+		Text:       text,
+		Supersedes: supersedes,
+	}
+}
+
 // Route implements the sdk.Msg interface.
 func (msg MsgProposeLaw) Route() string { return types.RouterKey }
 
@@ -378,7 +442,15 @@ func (msg MsgProposeLaw) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
 	}
 
-	return nil
+	if msg.Title == "" {
+		return types.ErrInvalidLaw.Wrap("law title cannot be empty")
+	}
+
+	if msg.Text == "" {
+		return types.ErrInvalidLaw.Wrap("law text cannot be empty")
+	}
+
+	return validateSupersededLaws(msg.Supersedes)
 }
 
 // GetSignBytes returns the message bytes to sign over.
@@ -392,3 +464,260 @@ func (msg MsgProposeLaw) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgCreateFundingStream creates a new MsgCreateFundingStream instance
+//
+//nolint:interfacer
+func NewMsgCreateFundingStream(authority, recipient sdk.AccAddress, amount sdk.Coins, interval time.Duration, endTime time.Time) *MsgCreateFundingStream {
+	return &MsgCreateFundingStream{
+		Authority: authority.String(),
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Interval:  &interval,
+		EndTime:   &endTime,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateFundingStream) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateFundingStream) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateFundingStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateFundingStream(msg.Recipient, msg.Amount, msg.Interval, msg.EndTime)
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateFundingStream) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCreateFundingStream.
+func (msg MsgCreateFundingStream) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCancelFundingStream creates a new MsgCancelFundingStream instance
+//
+//nolint:interfacer
+func NewMsgCancelFundingStream(authority sdk.AccAddress, streamID uint64) *MsgCancelFundingStream {
+	return &MsgCancelFundingStream{
+		Authority: authority.String(),
+		StreamId:  streamID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelFundingStream) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelFundingStream) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelFundingStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.StreamId == 0 {
+		return types.ErrInvalidFundingStream.Wrap("funding stream id cannot be 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelFundingStream) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelFundingStream.
+func (msg MsgCancelFundingStream) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance
+//
+//nolint:interfacer
+func NewMsgCreateGovernor(address sdk.AccAddress, description GovernorDescription) *MsgCreateGovernor {
+	return &MsgCreateGovernor{Address: address.String(), Description: description}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	return msg.Description.EnsureLength()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCreateGovernor.
+func (msg MsgCreateGovernor) GetSigners() []sdk.AccAddress {
+	governor, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{governor}
+}
+
+// NewMsgEditGovernor creates a new MsgEditGovernor instance
+//
+//nolint:interfacer
+func NewMsgEditGovernor(address sdk.AccAddress, description GovernorDescription, status GovernorStatus) *MsgEditGovernor {
+	return &MsgEditGovernor{Address: address.String(), Description: description, Status: status}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgEditGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgEditGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEditGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+	if !ValidGovernorStatus(msg.Status) {
+		return types.ErrInvalidGovernorStatus.Wrap(msg.Status.String())
+	}
+
+	return msg.Description.EnsureLength()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgEditGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgEditGovernor.
+func (msg MsgEditGovernor) GetSigners() []sdk.AccAddress {
+	governor, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{governor}
+}
+
+// NewMsgDelegateGovernor creates a new MsgDelegateGovernor instance
+//
+//nolint:interfacer
+func NewMsgDelegateGovernor(delegatorAddr, governorAddr sdk.AccAddress) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{DelegatorAddress: delegatorAddr.String(), GovernorAddress: governorAddr.String()}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDelegateGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDelegateGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDelegateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegateGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgDelegateGovernor.
+func (msg MsgDelegateGovernor) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgUndelegateGovernor creates a new MsgUndelegateGovernor instance
+//
+//nolint:interfacer
+func NewMsgUndelegateGovernor(delegatorAddr sdk.AccAddress) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{DelegatorAddress: delegatorAddr.String()}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUndelegateGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUndelegateGovernor.
+func (msg MsgUndelegateGovernor) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{ProposalId: proposalID, Proposer: proposer.String()}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelProposal.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
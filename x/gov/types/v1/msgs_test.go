@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -163,7 +164,7 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, tc.title, tc.summary)
+		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, tc.title, tc.summary, false)
 		require.NoError(t, err)
 		if tc.expErr {
 			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
@@ -200,7 +201,7 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			msg, err := v1.NewMsgSubmitProposal(tc.proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), "", tc.title, tc.summary)
+			msg, err := v1.NewMsgSubmitProposal(tc.proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), "", tc.title, tc.summary, false)
 			require.NoError(t, err)
 			var bz []byte
 			require.NotPanics(t, func() {
@@ -235,3 +236,170 @@ func TestMsgProposeConstitutionAmendment_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgProposeStructuredConstitutionAmendment_ValidateBasic(t *testing.T) {
+	article := &v1.ConstitutionArticle{Id: "art-1", Title: "Article 1", Text: "text"}
+	tests := []struct {
+		name       string
+		authority  string
+		operations []*v1.ArticleOperation
+		expErr     bool
+	}{
+		{"invalid authority", "", []*v1.ArticleOperation{{Type: v1.ArticleOperationDelete, ArticleId: "art-1"}}, true},
+		{"empty operations", addrs[0].String(), nil, true},
+		{"unspecified operation", addrs[0].String(), []*v1.ArticleOperation{{ArticleId: "art-1"}}, true},
+		{"invalid article id", addrs[0].String(), []*v1.ArticleOperation{{Type: v1.ArticleOperationDelete, ArticleId: "art 1"}}, true},
+		{"insert without article", addrs[0].String(), []*v1.ArticleOperation{{Type: v1.ArticleOperationInsert}}, true},
+		{"replace with sections", addrs[0].String(), []*v1.ArticleOperation{{
+			Type: v1.ArticleOperationReplace, ArticleId: "art-1",
+			Article: &v1.ConstitutionArticle{Sections: []*v1.ConstitutionArticle{article}},
+		}}, true},
+		{"replace with another id", addrs[0].String(), []*v1.ArticleOperation{{Type: v1.ArticleOperationReplace, ArticleId: "art-2", Article: article}}, true},
+		{"valid", addrs[0].String(), []*v1.ArticleOperation{
+			{Type: v1.ArticleOperationInsert, Article: article},
+			{Type: v1.ArticleOperationReplace, ArticleId: "art-1", Article: article},
+			{Type: v1.ArticleOperationDelete, ArticleId: "art-1"},
+		}, false},
+	}
+
+	for _, tc := range tests {
+		msg := v1.MsgProposeStructuredConstitutionAmendment{
+			Authority:  tc.authority,
+			Operations: tc.operations,
+		}
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgProposeLaw_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		authority  string
+		title      string
+		text       string
+		supersedes []uint64
+		expErr     bool
+	}{
+		{"invalid authority", "", "title", "text", nil, true},
+		{"empty title", addrs[0].String(), "", "text", nil, true},
+		{"empty text", addrs[0].String(), "title", "", nil, true},
+		{"zero superseded law id", addrs[0].String(), "title", "text", []uint64{0}, true},
+		{"duplicate superseded law id", addrs[0].String(), "title", "text", []uint64{1, 1}, true},
+		{"valid", addrs[0].String(), "title", "text", nil, false},
+		{"valid with superseded laws", addrs[0].String(), "title", "text", []uint64{1, 2}, false},
+	}
+
+	for _, tc := range tests {
+		msg := v1.MsgProposeLaw{
+			Authority:  tc.authority,
+			Title:      tc.title,
+			Text:       tc.text,
+			Supersedes: tc.supersedes,
+		}
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCreateGovernor
+func TestMsgCreateGovernor(t *testing.T) {
+	tests := []struct {
+		address     sdk.AccAddress
+		description v1.GovernorDescription
+		expectPass  bool
+	}{
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), true},
+		{sdk.AccAddress{}, v1.NewGovernorDescription("moniker", "", "", "", ""), false},
+		{addrs[0], v1.NewGovernorDescription(strings.Repeat("a", v1.MaxMonikerLength+1), "", "", "", ""), false},
+		{addrs[0], v1.NewGovernorDescription("", "", "", "", strings.Repeat("a", v1.MaxDetailsLength+1)), false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCreateGovernor(tc.address, tc.description)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgEditGovernor
+func TestMsgEditGovernor(t *testing.T) {
+	tests := []struct {
+		address     sdk.AccAddress
+		description v1.GovernorDescription
+		status      v1.GovernorStatus
+		expectPass  bool
+	}{
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), v1.GovernorStatusActive, true},
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), v1.GovernorStatusInactive, true},
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), v1.GovernorStatusUnspecified, false},
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), v1.GovernorStatus(0x13), false},
+		{sdk.AccAddress{}, v1.NewGovernorDescription("moniker", "", "", "", ""), v1.GovernorStatusActive, false},
+		{addrs[0], v1.NewGovernorDescription("", "", strings.Repeat("a", v1.MaxWebsiteLength+1), "", ""), v1.GovernorStatusActive, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgEditGovernor(tc.address, tc.description, tc.status)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgDelegateGovernor and MsgUndelegateGovernor
+func TestMsgDelegateGovernor(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		governorAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{addrs[0], addrs[1], true},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgDelegateGovernor(tc.delegatorAddr, tc.governorAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	require.NoError(t, v1.NewMsgUndelegateGovernor(addrs[0]).ValidateBasic())
+	require.Error(t, v1.NewMsgUndelegateGovernor(sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestMsgCreateGovernorGetSignBytes(t *testing.T) {
+	msg := v1.NewMsgCreateGovernor(sdk.AccAddress("addr1"), v1.NewGovernorDescription("moniker", "", "", "", ""))
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"atomone/v1/MsgCreateGovernor","value":{"address":"cosmos1v9jxgu33kfsgr5","description":{"moniker":"moniker"}}}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	require.NoError(t, v1.NewMsgCancelProposal(1, addrs[0]).ValidateBasic())
+	require.Error(t, v1.NewMsgCancelProposal(1, sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestMsgCancelProposalGetSignBytes(t *testing.T) {
+	msg := v1.NewMsgCancelProposal(1, sdk.AccAddress("addr1"))
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"atomone/v1/MsgCancelProposal","value":{"proposal_id":"1","proposer":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(res))
+}
@@ -30,6 +30,7 @@ func init() {
 // Default governance params
 var (
 	minVotingPeriod, _                    = time.ParseDuration(MinVotingPeriod)
+	minExpeditedVotingPeriod              = minVotingPeriod / 3 // 7 days, follows the MinVotingPeriod overrides
 	DefaultMinDepositTokens               = sdk.NewInt(10000000)
 	DefaultQuorum                         = sdk.NewDecWithPrec(25, 2)
 	DefaultThreshold                      = sdk.NewDecWithPrec(667, 3)
@@ -37,7 +38,6 @@ var (
 	DefaultConstitutionAmendmentThreshold = sdk.NewDecWithPrec(9, 1)
 	DefaultLawQuorum                      = sdk.NewDecWithPrec(25, 2)
 	DefaultLawThreshold                   = sdk.NewDecWithPrec(9, 1)
-	DefaultMinInitialDepositRatio         = sdk.ZeroDec()
 	DefaultBurnProposalPrevote            = false                    // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom                 = false                    // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultMinDepositRatio                = sdk.NewDecWithPrec(1, 2) // NOTE: backport from v50
@@ -45,6 +45,65 @@ var (
 	DefaultQuorumTimeout            time.Duration = DefaultVotingPeriod - (time.Hour * 24 * 1) // disabled by default (DefaultQuorumCheckCount must be set to a non-zero value to enable)
 	DefaultMaxVotingPeriodExtension time.Duration = DefaultVotingPeriod - DefaultQuorumTimeout // disabled by default (DefaultQuorumCheckCount must be set to a non-zero value to enable)
 	DefaultQuorumCheckCount         uint64        = 0                                          // disabled by default (0 means no check)
+
+	DefaultMinDepositFloor                     sdk.Coins     = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens))
+	DefaultMinDepositUpdatePeriod              time.Duration = time.Hour * 24 * 7
+	DefaultMinDepositSensitivityTargetDistance uint64        = 2
+	DefaultMinDepositIncreaseRatio                           = sdk.NewDecWithPrec(5, 2)
+	DefaultMinDepositDecreaseRatio                           = sdk.NewDecWithPrec(25, 3)
+	DefaultTargetActiveProposals               uint64        = 2
+
+	DefaultMinInitialDepositTokens                                  = sdk.NewInt(100000)
+	DefaultMinInitialDepositFloor                     sdk.Coins     = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinInitialDepositTokens))
+	DefaultMinInitialDepositUpdatePeriod              time.Duration = time.Hour * 24
+	DefaultMinInitialDepositSensitivityTargetDistance uint64        = 2
+	DefaultMinInitialDepositIncreaseRatio                           = sdk.NewDecWithPrec(1, 2)
+	DefaultMinInitialDepositDecreaseRatio                           = sdk.NewDecWithPrec(5, 3)
+	DefaultTargetProposalsInDepositPeriod             uint64        = 5
+
+	DefaultMinGovernorSelfDelegation                = sdk.NewInt(10000000)
+	DefaultGovernorStatusChangePeriod time.Duration = time.Hour * 24 * 28 // 28 days
+
+	DefaultDynamicQuorum                         = false // disabled by default, the fixed quorums are used
+	DefaultQuorumRangeMin                        = sdk.NewDecWithPrec(1, 1)
+	DefaultQuorumRangeMax                        = sdk.NewDecWithPrec(5, 1)
+	DefaultConstitutionAmendmentQuorumRangeMin   = sdk.NewDecWithPrec(1, 1)
+	DefaultConstitutionAmendmentQuorumRangeMax   = sdk.NewDecWithPrec(5, 1)
+	DefaultLawQuorumRangeMin                     = sdk.NewDecWithPrec(1, 1)
+	DefaultLawQuorumRangeMax                     = sdk.NewDecWithPrec(5, 1)
+	DefaultParticipationEMA                      = sdk.NewDecWithPrec(375, 3) // dynamic quorum of 0.25 with the default ranges
+	DefaultConstitutionAmendmentParticipationEMA = sdk.NewDecWithPrec(375, 3)
+	DefaultLawParticipationEMA                   = sdk.NewDecWithPrec(375, 3)
+
+	DefaultPersistFinalVotes                       = false               // disabled by default, votes are deleted once tallied
+	DefaultFinalVotesRetentionPeriod time.Duration = time.Hour * 24 * 90 // 90 days
+
+	DefaultProposalCancelRatio = sdk.NewDecWithPrec(5, 1)
+
+	// The constitution sets the min voting period at 21 days for regular
+	// proposals. Expedited proposals are restricted to the
+	// ExpeditedAllowedMsgTypeURLs (software upgrades by default), so they can
+	// be voted in a third of that period, which is also the lowest expedited
+	// voting period allowed.
+	DefaultExpeditedVotingPeriod       time.Duration = time.Hour * 24 * 7 // 7 days
+	DefaultExpeditedThreshold                        = sdk.NewDecWithPrec(75, 2)
+	DefaultExpeditedMinDepositTokens                 = DefaultMinDepositTokens.MulRaw(5)
+	DefaultExpeditedMinDeposit                       = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens))
+	DefaultExpeditedAllowedMsgTypeURLs               = []string{
+		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		"/cosmos.upgrade.v1beta1.MsgCancelUpgrade",
+	}
+
+	DefaultRecordVoteHistory        = false // disabled by default, only the last vote of each voter is kept
+	DefaultMaxVoteChanges    uint64 = 0     // unlimited
+
+	DefaultMinVoteStakedTokens           = sdk.NewInt(1000000) // 1_000_000 uatone (or 1 atone)
+	DefaultMaxDelegationsChecked  uint64 = 100
+	DefaultMinDepositStakedTokens        = sdk.ZeroInt() // disabled by default
+
+	DefaultExecutionDelay          time.Duration = 0 // disabled by default, proposals are executed as soon as they pass
+	DefaultMaxProposalExecutionGas uint64        = 10_000_000
+	DefaultMaxEndBlockerProposals  uint64        = 100
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -72,13 +131,27 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 
 // NewParams creates a new Params instance with given values.
 func NewParams(
-	minDeposit sdk.Coins, maxDepositPeriod, votingPeriod time.Duration,
-	quorum, threshold, constitutionAmendmentQuorum, constitutionAmendmentThreshold, lawQuorum, lawThreshold, minInitialDepositRatio string,
+	maxDepositPeriod, votingPeriod time.Duration,
+	quorum, threshold, constitutionAmendmentQuorum, constitutionAmendmentThreshold, lawQuorum, lawThreshold string,
 	burnProposalDeposit, burnVoteQuorum bool, minDepositRatio string,
 	quorumTimeout, maxVotingPeriodExtension time.Duration, quorumCheckCount uint64,
+	minDepositFloor sdk.Coins, minDepositUpdatePeriod time.Duration, minDepositSensitivityTargetDistance uint64,
+	minDepositIncreaseRatio, minDepositDecreaseRatio string, targetActiveProposals uint64,
+	minInitialDepositFloor sdk.Coins, minInitialDepositUpdatePeriod time.Duration, minInitialDepositSensitivityTargetDistance uint64,
+	minInitialDepositIncreaseRatio, minInitialDepositDecreaseRatio string, targetProposalsInDepositPeriod uint64,
+	minGovernorSelfDelegation string, governorStatusChangePeriod time.Duration,
+	dynamicQuorum bool, quorumRangeMin, quorumRangeMax, constitutionAmendmentQuorumRangeMin, constitutionAmendmentQuorumRangeMax,
+	lawQuorumRangeMin, lawQuorumRangeMax string,
+	persistFinalVotes bool, finalVotesRetentionPeriod time.Duration,
+	proposalCancelRatio string,
+	expeditedVotingPeriod time.Duration, expeditedThreshold string, expeditedMinDeposit sdk.Coins,
+	expeditedAllowedMsgTypeURLs []string,
+	messageTallyParams []MessageTallyParams,
+	recordVoteHistory bool, maxVoteChanges uint64,
+	minVoteStakedTokens string, maxDelegationsChecked uint64, minDepositStakedTokens string,
+	executionDelay time.Duration, messageExecutionDelays []MessageExecutionDelay, maxProposalExecutionGas, maxEndBlockerProposals uint64,
 ) Params {
 	return Params{
-		MinDeposit:                     minDeposit,
 		MaxDepositPeriod:               &maxDepositPeriod,
 		VotingPeriod:                   &votingPeriod,
 		Quorum:                         quorum,
@@ -87,20 +160,60 @@ func NewParams(
 		ConstitutionAmendmentThreshold: constitutionAmendmentThreshold,
 		LawQuorum:                      lawQuorum,
 		LawThreshold:                   lawThreshold,
-		MinInitialDepositRatio:         minInitialDepositRatio,
 		BurnProposalDepositPrevote:     burnProposalDeposit,
 		BurnVoteQuorum:                 burnVoteQuorum,
 		MinDepositRatio:                minDepositRatio,
 		QuorumTimeout:                  &quorumTimeout,
 		MaxVotingPeriodExtension:       &maxVotingPeriodExtension,
 		QuorumCheckCount:               quorumCheckCount,
+		MinDepositThrottler: &MinDepositThrottler{
+			FloorValue:                minDepositFloor,
+			UpdatePeriod:              &minDepositUpdatePeriod,
+			TargetActiveProposals:     targetActiveProposals,
+			IncreaseRatio:             minDepositIncreaseRatio,
+			DecreaseRatio:             minDepositDecreaseRatio,
+			SensitivityTargetDistance: minDepositSensitivityTargetDistance,
+		},
+		MinInitialDepositThrottler: &MinInitialDepositThrottler{
+			FloorValue:                minInitialDepositFloor,
+			UpdatePeriod:              &minInitialDepositUpdatePeriod,
+			TargetProposals:           targetProposalsInDepositPeriod,
+			IncreaseRatio:             minInitialDepositIncreaseRatio,
+			DecreaseRatio:             minInitialDepositDecreaseRatio,
+			SensitivityTargetDistance: minInitialDepositSensitivityTargetDistance,
+		},
+		MinGovernorSelfDelegation:  minGovernorSelfDelegation,
+		GovernorStatusChangePeriod: &governorStatusChangePeriod,
+		DynamicQuorum:              dynamicQuorum,
+		QuorumRange:                &QuorumRange{Min: quorumRangeMin, Max: quorumRangeMax},
+		ConstitutionAmendmentQuorumRange: &QuorumRange{
+			Min: constitutionAmendmentQuorumRangeMin,
+			Max: constitutionAmendmentQuorumRangeMax,
+		},
+		LawQuorumRange:              &QuorumRange{Min: lawQuorumRangeMin, Max: lawQuorumRangeMax},
+		PersistFinalVotes:           persistFinalVotes,
+		FinalVotesRetentionPeriod:   &finalVotesRetentionPeriod,
+		ProposalCancelRatio:         proposalCancelRatio,
+		ExpeditedVotingPeriod:       &expeditedVotingPeriod,
+		ExpeditedThreshold:          expeditedThreshold,
+		ExpeditedMinDeposit:         expeditedMinDeposit,
+		ExpeditedAllowedMsgTypeUrls: expeditedAllowedMsgTypeURLs,
+		MessageTallyParams:          messageTallyParams,
+		RecordVoteHistory:           recordVoteHistory,
+		MaxVoteChanges:              maxVoteChanges,
+		MinVoteStakedTokens:         minVoteStakedTokens,
+		MaxDelegationsChecked:       maxDelegationsChecked,
+		MinDepositStakedTokens:      minDepositStakedTokens,
+		ExecutionDelay:              &executionDelay,
+		MessageExecutionDelays:      messageExecutionDelays,
+		MaxProposalExecutionGas:     maxProposalExecutionGas,
+		MaxEndBlockerProposals:      maxEndBlockerProposals,
 	}
 }
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
 	return NewParams(
-		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultDepositPeriod,
 		DefaultVotingPeriod,
 		DefaultQuorum.String(),
@@ -109,20 +222,57 @@ func DefaultParams() Params {
 		DefaultConstitutionAmendmentThreshold.String(),
 		DefaultLawQuorum.String(),
 		DefaultLawThreshold.String(),
-		DefaultMinInitialDepositRatio.String(),
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultMinDepositRatio.String(),
 		DefaultQuorumTimeout,
 		DefaultMaxVotingPeriodExtension,
 		DefaultQuorumCheckCount,
+		DefaultMinDepositFloor,
+		DefaultMinDepositUpdatePeriod,
+		DefaultMinDepositSensitivityTargetDistance,
+		DefaultMinDepositIncreaseRatio.String(),
+		DefaultMinDepositDecreaseRatio.String(),
+		DefaultTargetActiveProposals,
+		DefaultMinInitialDepositFloor,
+		DefaultMinInitialDepositUpdatePeriod,
+		DefaultMinInitialDepositSensitivityTargetDistance,
+		DefaultMinInitialDepositIncreaseRatio.String(),
+		DefaultMinInitialDepositDecreaseRatio.String(),
+		DefaultTargetProposalsInDepositPeriod,
+		DefaultMinGovernorSelfDelegation.String(),
+		DefaultGovernorStatusChangePeriod,
+		DefaultDynamicQuorum,
+		DefaultQuorumRangeMin.String(),
+		DefaultQuorumRangeMax.String(),
+		DefaultConstitutionAmendmentQuorumRangeMin.String(),
+		DefaultConstitutionAmendmentQuorumRangeMax.String(),
+		DefaultLawQuorumRangeMin.String(),
+		DefaultLawQuorumRangeMax.String(),
+		DefaultPersistFinalVotes,
+		DefaultFinalVotesRetentionPeriod,
+		DefaultProposalCancelRatio.String(),
+		DefaultExpeditedVotingPeriod,
+		DefaultExpeditedThreshold.String(),
+		DefaultExpeditedMinDeposit,
+		DefaultExpeditedAllowedMsgTypeURLs,
+		nil,
+		DefaultRecordVoteHistory,
+		DefaultMaxVoteChanges,
+		DefaultMinVoteStakedTokens.String(),
+		DefaultMaxDelegationsChecked,
+		DefaultMinDepositStakedTokens.String(),
+		DefaultExecutionDelay,
+		nil,
+		DefaultMaxProposalExecutionGas,
+		DefaultMaxEndBlockerProposals,
 	)
 }
 
 // ValidateBasic performs basic validation on governance parameters.
 func (p Params) ValidateBasic() error {
-	if minDeposit := sdk.Coins(p.MinDeposit); minDeposit.Empty() || !minDeposit.IsValid() {
-		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
+	if minDeposit := sdk.Coins(p.MinDeposit); !minDeposit.Empty() { //nolint:staticcheck
+		return fmt.Errorf("manually setting min deposit is deprecated in favor of a dynamic min deposit")
 	}
 
 	if p.MaxDepositPeriod == nil {
@@ -225,15 +375,8 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("voting period must be at least %s: %s", minVotingPeriod.String(), p.VotingPeriod.String())
 	}
 
-	minInitialDepositRatio, err := math.LegacyNewDecFromStr(p.MinInitialDepositRatio)
-	if err != nil {
-		return fmt.Errorf("invalid mininum initial deposit ratio of proposal: %w", err)
-	}
-	if minInitialDepositRatio.IsNegative() {
-		return fmt.Errorf("mininum initial deposit ratio of proposal must be positive: %s", minInitialDepositRatio)
-	}
-	if minInitialDepositRatio.GT(math.LegacyOneDec()) {
-		return fmt.Errorf("mininum initial deposit ratio of proposal is too large: %s", minInitialDepositRatio)
+	if p.MinInitialDepositRatio != "" { //nolint:staticcheck
+		return fmt.Errorf("manually setting min initial deposit ratio is deprecated in favor of a dynamic min initial deposit")
 	}
 
 	if p.QuorumCheckCount > 0 {
@@ -256,5 +399,335 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	if p.MinDepositThrottler == nil {
+		return fmt.Errorf("min deposit throttler must not be nil")
+	}
+	if err := p.MinDepositThrottler.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if p.MinInitialDepositThrottler == nil {
+		return fmt.Errorf("min initial deposit throttler must not be nil")
+	}
+	if err := p.MinInitialDepositThrottler.ValidateBasic(); err != nil {
+		return err
+	}
+
+	minGovernorSelfDelegation, ok := math.NewIntFromString(p.MinGovernorSelfDelegation)
+	if !ok {
+		return fmt.Errorf("invalid minimum governor self delegation: %s", p.MinGovernorSelfDelegation)
+	}
+	if minGovernorSelfDelegation.IsNegative() {
+		return fmt.Errorf("minimum governor self delegation must be positive: %s", minGovernorSelfDelegation)
+	}
+
+	if p.GovernorStatusChangePeriod == nil {
+		return fmt.Errorf("governor status change period must not be nil")
+	}
+	if p.GovernorStatusChangePeriod.Seconds() <= 0 {
+		return fmt.Errorf("governor status change period must be positive: %s", p.GovernorStatusChangePeriod)
+	}
+
+	if p.QuorumRange == nil {
+		return fmt.Errorf("quorum range must not be nil")
+	}
+	if err := p.QuorumRange.validate("quorum"); err != nil {
+		return err
+	}
+	if p.ConstitutionAmendmentQuorumRange == nil {
+		return fmt.Errorf("constitution amendment quorum range must not be nil")
+	}
+	if err := p.ConstitutionAmendmentQuorumRange.validate("constitution amendment quorum"); err != nil {
+		return err
+	}
+	if p.LawQuorumRange == nil {
+		return fmt.Errorf("law quorum range must not be nil")
+	}
+	if err := p.LawQuorumRange.validate("law quorum"); err != nil {
+		return err
+	}
+
+	if p.FinalVotesRetentionPeriod == nil {
+		return fmt.Errorf("final votes retention period must not be nil")
+	}
+	if p.FinalVotesRetentionPeriod.Seconds() <= 0 {
+		return fmt.Errorf("final votes retention period must be positive: %s", p.FinalVotesRetentionPeriod)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio must be positive: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
+	if p.ExpeditedVotingPeriod == nil {
+		return fmt.Errorf("expedited voting period must not be nil")
+	}
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+	if p.ExpeditedVotingPeriod.Nanoseconds() < minExpeditedVotingPeriod.Nanoseconds() {
+		return fmt.Errorf("expedited voting period must be at least %s: %s", minExpeditedVotingPeriod, p.ExpeditedVotingPeriod)
+	}
+	if p.ExpeditedVotingPeriod.Nanoseconds() >= p.VotingPeriod.Nanoseconds() {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited threshold too large: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited threshold must be greater than the governance threshold: %s", expeditedThreshold)
+	}
+
+	expeditedMinDeposit := sdk.Coins(p.ExpeditedMinDeposit)
+	if expeditedMinDeposit.Empty() || !expeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", expeditedMinDeposit)
+	}
+	if !expeditedMinDeposit.IsAllGT(p.MinDepositThrottler.FloorValue) {
+		return fmt.Errorf("expedited minimum deposit %s must be greater than the minimum deposit floor value %s", expeditedMinDeposit, sdk.Coins(p.MinDepositThrottler.FloorValue))
+	}
+
+	seenTypeURLs := make(map[string]bool, len(p.ExpeditedAllowedMsgTypeUrls))
+	for _, typeURL := range p.ExpeditedAllowedMsgTypeUrls {
+		if typeURL == "" {
+			return fmt.Errorf("expedited allowed message type URL must not be empty")
+		}
+		if seenTypeURLs[typeURL] {
+			return fmt.Errorf("duplicate expedited allowed message type URL: %s", typeURL)
+		}
+		seenTypeURLs[typeURL] = true
+	}
+
+	seenTypeURLs = make(map[string]bool, len(p.MessageTallyParams))
+	for _, mtp := range p.MessageTallyParams {
+		if mtp.MsgTypeUrl == "" {
+			return fmt.Errorf("message tally params type URL must not be empty")
+		}
+		if seenTypeURLs[mtp.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message tally params type URL: %s", mtp.MsgTypeUrl)
+		}
+		seenTypeURLs[mtp.MsgTypeUrl] = true
+
+		msgQuorum, err := sdk.NewDecFromStr(mtp.Quorum)
+		if err != nil {
+			return fmt.Errorf("invalid quorum string of message %s: %w", mtp.MsgTypeUrl, err)
+		}
+		if msgQuorum.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("quorum of message %s too large: %s", mtp.MsgTypeUrl, msgQuorum)
+		}
+		if msgQuorum.LT(quorum) {
+			return fmt.Errorf("quorum of message %s must be greater than or equal to governance quorum: %s", mtp.MsgTypeUrl, msgQuorum)
+		}
+		// with the dynamic quorum, the governance quorum can be as high as the
+		// max of the quorum range
+		if p.DynamicQuorum && msgQuorum.LT(math.LegacyMustNewDecFromStr(p.QuorumRange.Max)) {
+			return fmt.Errorf("quorum of message %s must be greater than or equal to governance quorum range max: %s", mtp.MsgTypeUrl, msgQuorum)
+		}
+
+		msgThreshold, err := sdk.NewDecFromStr(mtp.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid threshold string of message %s: %w", mtp.MsgTypeUrl, err)
+		}
+		if msgThreshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("threshold of message %s too large: %s", mtp.MsgTypeUrl, msgThreshold)
+		}
+		if msgThreshold.LT(threshold) {
+			return fmt.Errorf("threshold of message %s must be greater than or equal to governance threshold: %s", mtp.MsgTypeUrl, msgThreshold)
+		}
+	}
+
+	minVoteStakedTokens, ok := math.NewIntFromString(p.MinVoteStakedTokens)
+	if !ok {
+		return fmt.Errorf("invalid minimum vote staked tokens: %s", p.MinVoteStakedTokens)
+	}
+	if minVoteStakedTokens.IsNegative() {
+		return fmt.Errorf("minimum vote staked tokens must not be negative: %s", minVoteStakedTokens)
+	}
+
+	if p.MaxDelegationsChecked == 0 {
+		return fmt.Errorf("maximum delegations checked must be positive")
+	}
+
+	minDepositStakedTokens, ok := math.NewIntFromString(p.MinDepositStakedTokens)
+	if !ok {
+		return fmt.Errorf("invalid minimum deposit staked tokens: %s", p.MinDepositStakedTokens)
+	}
+	if minDepositStakedTokens.IsNegative() {
+		return fmt.Errorf("minimum deposit staked tokens must not be negative: %s", minDepositStakedTokens)
+	}
+
+	if p.ExecutionDelay == nil {
+		return fmt.Errorf("execution delay must not be nil")
+	}
+	if *p.ExecutionDelay < 0 {
+		return fmt.Errorf("execution delay must not be negative: %s", p.ExecutionDelay)
+	}
+
+	seenTypeURLs = make(map[string]bool, len(p.MessageExecutionDelays))
+	for _, med := range p.MessageExecutionDelays {
+		if med.MsgTypeUrl == "" {
+			return fmt.Errorf("message execution delay type URL must not be empty")
+		}
+		if seenTypeURLs[med.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message execution delay type URL: %s", med.MsgTypeUrl)
+		}
+		seenTypeURLs[med.MsgTypeUrl] = true
+
+		if med.Delay < 0 {
+			return fmt.Errorf("execution delay of message %s must not be negative: %s", med.MsgTypeUrl, med.Delay)
+		}
+	}
+
+	if p.MaxProposalExecutionGas == 0 {
+		return fmt.Errorf("maximum proposal execution gas must be positive")
+	}
+
+	if p.MaxEndBlockerProposals == 0 {
+		return fmt.Errorf("maximum number of proposals processed per block must be positive")
+	}
+
+	return nil
+}
+
+// FindMessageTallyParams returns the quorum and threshold overrides of the
+// messages with the given type URL, if any.
+func (p Params) FindMessageTallyParams(typeURL string) (MessageTallyParams, bool) {
+	for _, mtp := range p.MessageTallyParams {
+		if mtp.MsgTypeUrl == typeURL {
+			return mtp, true
+		}
+	}
+	return MessageTallyParams{}, false
+}
+
+// ExecutionDelayOf returns the execution delay of a proposal containing
+// messages of the given type URLs, i.e. the longest execution delay of its
+// messages. A proposal without messages has nothing to execute and is not
+// delayed.
+func (p Params) ExecutionDelayOf(typeURLs []string) time.Duration {
+	var delay time.Duration
+	for _, typeURL := range typeURLs {
+		msgDelay := *p.ExecutionDelay
+		for _, med := range p.MessageExecutionDelays {
+			if med.MsgTypeUrl == typeURL {
+				msgDelay = med.Delay
+				break
+			}
+		}
+		delay = max(delay, msgDelay)
+	}
+	return delay
+}
+
+// IsExpeditedAllowedMsgTypeURL returns true if a message with the given type
+// URL can be part of an expedited proposal.
+func (p Params) IsExpeditedAllowedMsgTypeURL(typeURL string) bool {
+	for _, allowed := range p.ExpeditedAllowedMsgTypeUrls {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// validate performs basic validation on a dynamic quorum range, name is used
+// as prefix of the error messages.
+func (r QuorumRange) validate(name string) error {
+	minQuorum, err := sdk.NewDecFromStr(r.Min)
+	if err != nil {
+		return fmt.Errorf("invalid %s range min: %w", name, err)
+	}
+	if minQuorum.IsNegative() {
+		return fmt.Errorf("%s range min must be positive: %s", name, minQuorum)
+	}
+
+	maxQuorum, err := sdk.NewDecFromStr(r.Max)
+	if err != nil {
+		return fmt.Errorf("invalid %s range max: %w", name, err)
+	}
+	if maxQuorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s range max too large: %s", name, maxQuorum)
+	}
+	if minQuorum.GT(maxQuorum) {
+		return fmt.Errorf("%s range min %s must be less than or equal to max %s", name, minQuorum, maxQuorum)
+	}
+
+	return nil
+}
+
+// Compute returns the quorum of the range for the given participation
+// exponential moving average.
+func (r QuorumRange) Compute(participationEMA math.LegacyDec) math.LegacyDec {
+	minQuorum := math.LegacyMustNewDecFromStr(r.Min)
+	maxQuorum := math.LegacyMustNewDecFromStr(r.Max)
+	return minQuorum.Add(maxQuorum.Sub(minQuorum).Mul(participationEMA))
+}
+
+// ValidateBasic performs basic validation on the dynamic min deposit parameters.
+func (t MinDepositThrottler) ValidateBasic() error {
+	return validateDepositThrottler("minimum deposit", t.FloorValue, t.UpdatePeriod,
+		t.IncreaseRatio, t.DecreaseRatio, t.SensitivityTargetDistance)
+}
+
+// ValidateBasic performs basic validation on the dynamic min initial deposit
+// parameters.
+func (t MinInitialDepositThrottler) ValidateBasic() error {
+	return validateDepositThrottler("minimum initial deposit", t.FloorValue, t.UpdatePeriod,
+		t.IncreaseRatio, t.DecreaseRatio, t.SensitivityTargetDistance)
+}
+
+// validateDepositThrottler validates the parameters shared by the deposit
+// throttlers, name is used as prefix of the error messages.
+func validateDepositThrottler(
+	name string, floorValue sdk.Coins, updatePeriod *time.Duration,
+	increaseRatioStr, decreaseRatioStr string, sensitivityTargetDistance uint64,
+) error {
+	if floorValue.Empty() || !floorValue.IsValid() {
+		return fmt.Errorf("invalid %s floor: %s", name, floorValue)
+	}
+
+	if updatePeriod == nil {
+		return fmt.Errorf("%s update period must not be nil", name)
+	}
+	if updatePeriod.Seconds() <= 0 {
+		return fmt.Errorf("%s update period must be positive: %s", name, updatePeriod)
+	}
+
+	increaseRatio, err := sdk.NewDecFromStr(increaseRatioStr)
+	if err != nil {
+		return fmt.Errorf("invalid %s increase ratio: %w", name, err)
+	}
+	if !increaseRatio.IsPositive() {
+		return fmt.Errorf("%s increase ratio must be positive: %s", name, increaseRatio)
+	}
+	if increaseRatio.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("%s increase ratio must be less than 1: %s", name, increaseRatio)
+	}
+
+	decreaseRatio, err := sdk.NewDecFromStr(decreaseRatioStr)
+	if err != nil {
+		return fmt.Errorf("invalid %s decrease ratio: %w", name, err)
+	}
+	if !decreaseRatio.IsPositive() {
+		return fmt.Errorf("%s decrease ratio must be positive: %s", name, decreaseRatio)
+	}
+	if decreaseRatio.GTE(increaseRatio) {
+		return fmt.Errorf("%s decrease ratio %s must be less than the increase ratio %s", name, decreaseRatio, increaseRatio)
+	}
+
+	if sensitivityTargetDistance == 0 {
+		return fmt.Errorf("%s sensitivity target distance must be positive", name)
+	}
+
 	return nil
 }
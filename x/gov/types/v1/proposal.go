@@ -20,10 +20,15 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+
+	StatusPassedPendingExecution = ProposalStatus_PROPOSAL_STATUS_PASSED_PENDING_EXECUTION
+
+	ExecutionPolicyAllOrNothing = ProposalExecutionPolicy_PROPOSAL_EXECUTION_POLICY_ALL_OR_NOTHING
+	ExecutionPolicyBestEffort   = ProposalExecutionPolicy_PROPOSAL_EXECUTION_POLICY_BEST_EFFORT
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, metadata, title, summary string, proposer sdk.AccAddress) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
@@ -42,6 +47,7 @@ func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.
 		Title:            title,
 		Summary:          summary,
 		Proposer:         proposer.String(),
+		Expedited:        expedited,
 	}
 
 	return p, nil
@@ -115,7 +121,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusPassedPendingExecution {
 		return true
 	}
 	return false
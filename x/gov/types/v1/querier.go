@@ -1,7 +1,9 @@
 package v1
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // DONTCOVER
@@ -100,3 +102,25 @@ func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depo
 		ProposalStatus: status,
 	}
 }
+
+var _ codectypes.UnpackInterfacesMessage = &QuerySimulateProposalRequest{}
+
+// NewQuerySimulateProposalRequest creates a new QuerySimulateProposalRequest
+// for the messages of a proposal.
+func NewQuerySimulateProposalRequest(messages []sdk.Msg) (*QuerySimulateProposalRequest, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+	return &QuerySimulateProposalRequest{Messages: anys}, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *QuerySimulateProposalRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QuerySimulateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
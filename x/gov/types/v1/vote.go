@@ -23,6 +23,20 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOption
 	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
+// NewVoteHistoryEntry creates a new VoteHistoryEntry instance
+//
+//nolint:interfacer
+func NewVoteHistoryEntry(proposalID, sequence uint64, voter sdk.AccAddress, options WeightedVoteOptions, height int64) VoteHistoryEntry {
+	return VoteHistoryEntry{ProposalId: proposalID, Sequence: sequence, Voter: voter.String(), Options: options, Height: height}
+}
+
+// NewFinalVote creates a new FinalVote instance
+//
+//nolint:interfacer
+func NewFinalVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions, votingPower math.LegacyDec) FinalVote {
+	return FinalVote{ProposalId: proposalID, Voter: voter.String(), Options: options, VotingPower: votingPower.String()}
+}
+
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
 	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
@@ -44,6 +44,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetConversionRate returns the current conversion rate for converting bond
+// denom to photon, computed from the current bond denom and photon supplies.
+func (k Keeper) GetConversionRate(ctx sdk.Context) sdk.Dec {
+	var (
+		bondDenom       = k.stakingKeeper.BondDenom(ctx)
+		bondDenomSupply = k.bankKeeper.GetSupply(ctx, bondDenom).Amount.ToLegacyDec()
+		uphotonSupply   = k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
+	)
+	return k.conversionRate(ctx, bondDenomSupply, uphotonSupply)
+}
+
 // conversionRate returns the conversion rate for converting bond denom to
 // photon.
 func (k Keeper) conversionRate(_ sdk.Context, bondDenomSupply, uphotonSupply sdk.Dec) sdk.Dec {